@@ -25,46 +25,252 @@ var File_jorgejr568_portfolio_grpc_api_proto protoreflect.FileDescriptor
 
 const file_jorgejr568_portfolio_grpc_api_proto_rawDesc = "" +
 	"\n" +
-	"#jorgejr568/portfolio_grpc/api.proto\x12\x19jorgejr568.portfolio_grpc\x1a\x1cgoogle/api/annotations.proto\x1a&jorgejr568/portfolio_grpc/skills.proto\x1a+jorgejr568/portfolio_grpc/experiences.proto\x1a*jorgejr568/portfolio_grpc/educations.proto2\xe8\x06\n" +
+	"#jorgejr568/portfolio_grpc/api.proto\x12\x19jorgejr568.portfolio_grpc\x1a\x1cgoogle/api/annotations.proto\x1a&jorgejr568/portfolio_grpc/skills.proto\x1a+jorgejr568/portfolio_grpc/experiences.proto\x1a*jorgejr568/portfolio_grpc/educations.proto\x1a.jorgejr568/portfolio_grpc/certifications.proto\x1a'jorgejr568/portfolio_grpc/contact.proto\x1a)jorgejr568/portfolio_grpc/portfolio.proto\x1a'jorgejr568/portfolio_grpc/profile.proto\x1a(jorgejr568/portfolio_grpc/projects.proto\x1a,jorgejr568/portfolio_grpc/publications.proto\x1a)jorgejr568/portfolio_grpc/revisions.proto\x1a&jorgejr568/portfolio_grpc/search.proto\x1a,jorgejr568/portfolio_grpc/technologies.proto\x1a,jorgejr568/portfolio_grpc/testimonials.proto\x1a(jorgejr568/portfolio_grpc/timeline.proto2\xdb9\n" +
 	"\x10PortfolioService\x12\x83\x01\n" +
 	"\fGetAllSkills\x12..jorgejr568.portfolio_grpc.GetAllSkillsRequest\x1a/.jorgejr568.portfolio_grpc.GetAllSkillsResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
 	"/v1/skills\x12|\n" +
-	"\bGetSkill\x12*.jorgejr568.portfolio_grpc.GetSkillRequest\x1a+.jorgejr568.portfolio_grpc.GetSkillResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/skills/{id}\x12\x97\x01\n" +
+	"\bGetSkill\x12*.jorgejr568.portfolio_grpc.GetSkillRequest\x1a+.jorgejr568.portfolio_grpc.GetSkillResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/skills/{id}\x12\x92\x01\n" +
+	"\x0eBatchGetSkills\x120.jorgejr568.portfolio_grpc.BatchGetSkillsRequest\x1a1.jorgejr568.portfolio_grpc.BatchGetSkillsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/skills:batchGet\x12\x87\x01\n" +
+	"\vCreateSkill\x12-.jorgejr568.portfolio_grpc.CreateSkillRequest\x1a..jorgejr568.portfolio_grpc.CreateSkillResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x05skill\"\n" +
+	"/v1/skills\x12\x92\x01\n" +
+	"\vUpdateSkill\x12-.jorgejr568.portfolio_grpc.UpdateSkillRequest\x1a..jorgejr568.portfolio_grpc.UpdateSkillResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x05skill2\x15/v1/skills/{skill.id}\x12\x85\x01\n" +
+	"\vDeleteSkill\x12-.jorgejr568.portfolio_grpc.DeleteSkillRequest\x1a..jorgejr568.portfolio_grpc.DeleteSkillResponse\"\x17\x82\xd3\xe4\x93\x02\x11*\x0f/v1/skills/{id}\x12\x97\x01\n" +
+	"\rUndeleteSkill\x12/.jorgejr568.portfolio_grpc.UndeleteSkillRequest\x1a0.jorgejr568.portfolio_grpc.UndeleteSkillResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/skills/{id}:undelete\x12\xa2\x01\n" +
+	"\x13ListSkillCategories\x125.jorgejr568.portfolio_grpc.ListSkillCategoriesRequest\x1a6.jorgejr568.portfolio_grpc.ListSkillCategoriesResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/skill-categories\x12\x97\x01\n" +
 	"\x11GetAllExperiences\x123.jorgejr568.portfolio_grpc.GetAllExperiencesRequest\x1a4.jorgejr568.portfolio_grpc.GetAllExperiencesResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/experiences\x12\x90\x01\n" +
-	"\rGetExperience\x12/.jorgejr568.portfolio_grpc.GetExperienceRequest\x1a0.jorgejr568.portfolio_grpc.GetExperienceResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/experiences/{id}\x12\x93\x01\n" +
+	"\rGetExperience\x12/.jorgejr568.portfolio_grpc.GetExperienceRequest\x1a0.jorgejr568.portfolio_grpc.GetExperienceResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/experiences/{id}\x12\xa6\x01\n" +
+	"\x13BatchGetExperiences\x125.jorgejr568.portfolio_grpc.BatchGetExperiencesRequest\x1a6.jorgejr568.portfolio_grpc.BatchGetExperiencesResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/experiences:batchGet\x12\xa0\x01\n" +
+	"\x10CreateExperience\x122.jorgejr568.portfolio_grpc.CreateExperienceRequest\x1a3.jorgejr568.portfolio_grpc.CreateExperienceResponse\"#\x82\xd3\xe4\x93\x02\x1d:\n" +
+	"experience\"\x0f/v1/experiences\x12\xb0\x01\n" +
+	"\x10UpdateExperience\x122.jorgejr568.portfolio_grpc.UpdateExperienceRequest\x1a3.jorgejr568.portfolio_grpc.UpdateExperienceResponse\"3\x82\xd3\xe4\x93\x02-:\n" +
+	"experience2\x1f/v1/experiences/{experience.id}\x12\x99\x01\n" +
+	"\x10DeleteExperience\x122.jorgejr568.portfolio_grpc.DeleteExperienceRequest\x1a3.jorgejr568.portfolio_grpc.DeleteExperienceResponse\"\x1c\x82\xd3\xe4\x93\x02\x16*\x14/v1/experiences/{id}\x12\xab\x01\n" +
+	"\x12UndeleteExperience\x124.jorgejr568.portfolio_grpc.UndeleteExperienceRequest\x1a5.jorgejr568.portfolio_grpc.UndeleteExperienceResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/experiences/{id}:undelete\x12\x93\x01\n" +
 	"\x10GetAllEducations\x122.jorgejr568.portfolio_grpc.GetAllEducationsRequest\x1a3.jorgejr568.portfolio_grpc.GetAllEducationsResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/educations\x12\x8c\x01\n" +
-	"\fGetEducation\x12..jorgejr568.portfolio_grpc.GetEducationRequest\x1a/.jorgejr568.portfolio_grpc.GetEducationResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/educations/{id}B\xf1\x01\n" +
+	"\fGetEducation\x12..jorgejr568.portfolio_grpc.GetEducationRequest\x1a/.jorgejr568.portfolio_grpc.GetEducationResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/educations/{id}\x12\xa2\x01\n" +
+	"\x12BatchGetEducations\x124.jorgejr568.portfolio_grpc.BatchGetEducationsRequest\x1a5.jorgejr568.portfolio_grpc.BatchGetEducationsResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/educations:batchGet\x12\x9b\x01\n" +
+	"\x0fCreateEducation\x121.jorgejr568.portfolio_grpc.CreateEducationRequest\x1a2.jorgejr568.portfolio_grpc.CreateEducationResponse\"!\x82\xd3\xe4\x93\x02\x1b:\teducation\"\x0e/v1/educations\x12\xaa\x01\n" +
+	"\x0fUpdateEducation\x121.jorgejr568.portfolio_grpc.UpdateEducationRequest\x1a2.jorgejr568.portfolio_grpc.UpdateEducationResponse\"0\x82\xd3\xe4\x93\x02*:\teducation2\x1d/v1/educations/{education.id}\x12\x95\x01\n" +
+	"\x0fDeleteEducation\x121.jorgejr568.portfolio_grpc.DeleteEducationRequest\x1a2.jorgejr568.portfolio_grpc.DeleteEducationResponse\"\x1b\x82\xd3\xe4\x93\x02\x15*\x13/v1/educations/{id}\x12\xa7\x01\n" +
+	"\x11UndeleteEducation\x123.jorgejr568.portfolio_grpc.UndeleteEducationRequest\x1a4.jorgejr568.portfolio_grpc.UndeleteEducationResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/educations/{id}:undelete\x12\x8c\x01\n" +
+	"\x0fSearchPortfolio\x121.jorgejr568.portfolio_grpc.SearchPortfolioRequest\x1a2.jorgejr568.portfolio_grpc.SearchPortfolioResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
+	"/v1/search\x12\x86\x01\n" +
+	"\fGetPortfolio\x12..jorgejr568.portfolio_grpc.GetPortfolioRequest\x1a/.jorgejr568.portfolio_grpc.GetPortfolioResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/v1/portfolio\x12\x94\x01\n" +
+	"\x0eWatchPortfolio\x120.jorgejr568.portfolio_grpc.WatchPortfolioRequest\x1a1.jorgejr568.portfolio_grpc.WatchPortfolioResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/portfolio:watch0\x01\x12\x8b\x01\n" +
+	"\x0eGetAllProjects\x120.jorgejr568.portfolio_grpc.GetAllProjectsRequest\x1a1.jorgejr568.portfolio_grpc.GetAllProjectsResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/projects\x12\x84\x01\n" +
+	"\n" +
+	"GetProject\x12,.jorgejr568.portfolio_grpc.GetProjectRequest\x1a-.jorgejr568.portfolio_grpc.GetProjectResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/projects/{id}\x12\xa3\x01\n" +
+	"\x14GetAllCertifications\x126.jorgejr568.portfolio_grpc.GetAllCertificationsRequest\x1a7.jorgejr568.portfolio_grpc.GetAllCertificationsResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/certifications\x12\x9c\x01\n" +
+	"\x10GetCertification\x122.jorgejr568.portfolio_grpc.GetCertificationRequest\x1a3.jorgejr568.portfolio_grpc.GetCertificationResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/certifications/{id}\x12\x9b\x01\n" +
+	"\x12GetAllPublications\x124.jorgejr568.portfolio_grpc.GetAllPublicationsRequest\x1a5.jorgejr568.portfolio_grpc.GetAllPublicationsResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/publications\x12\x94\x01\n" +
+	"\x0eGetPublication\x120.jorgejr568.portfolio_grpc.GetPublicationRequest\x1a1.jorgejr568.portfolio_grpc.GetPublicationResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/publications/{id}\x12~\n" +
+	"\n" +
+	"GetProfile\x12,.jorgejr568.portfolio_grpc.GetProfileRequest\x1a-.jorgejr568.portfolio_grpc.GetProfileResponse\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/v1/profile\x12\x90\x01\n" +
+	"\rUpdateProfile\x12/.jorgejr568.portfolio_grpc.UpdateProfileRequest\x1a0.jorgejr568.portfolio_grpc.UpdateProfileResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\aprofile2\v/v1/profile\x12\x9b\x01\n" +
+	"\x12GetAllTestimonials\x124.jorgejr568.portfolio_grpc.GetAllTestimonialsRequest\x1a5.jorgejr568.portfolio_grpc.GetAllTestimonialsResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/testimonials\x12\xa5\x01\n" +
+	"\x11SubmitTestimonial\x123.jorgejr568.portfolio_grpc.SubmitTestimonialRequest\x1a4.jorgejr568.portfolio_grpc.SubmitTestimonialResponse\"%\x82\xd3\xe4\x93\x02\x1f:\vtestimonial\"\x10/v1/testimonials\x12\xab\x01\n" +
+	"\x12ApproveTestimonial\x124.jorgejr568.portfolio_grpc.ApproveTestimonialRequest\x1a5.jorgejr568.portfolio_grpc.ApproveTestimonialResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/testimonials/{id}:approve\x12\xa7\x01\n" +
+	"\x11RejectTestimonial\x123.jorgejr568.portfolio_grpc.RejectTestimonialRequest\x1a4.jorgejr568.portfolio_grpc.RejectTestimonialResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/testimonials/{id}:reject\x12\x9f\x01\n" +
+	"\x14SubmitContactMessage\x126.jorgejr568.portfolio_grpc.SubmitContactMessageRequest\x1a7.jorgejr568.portfolio_grpc.SubmitContactMessageResponse\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/v1/contact\x12\xaa\x01\n" +
+	"\x12ListSkillRevisions\x124.jorgejr568.portfolio_grpc.ListSkillRevisionsRequest\x1a5.jorgejr568.portfolio_grpc.ListSkillRevisionsResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/v1/skills/{skill_id}/revisions\x12\xc9\x01\n" +
+	"\x14RestoreSkillRevision\x126.jorgejr568.portfolio_grpc.RestoreSkillRevisionRequest\x1a7.jorgejr568.portfolio_grpc.RestoreSkillRevisionResponse\"@\x82\xd3\xe4\x93\x02::\x01*\"5/v1/skills/{skill_id}/revisions/{revision_id}:restore\x12\xc3\x01\n" +
+	"\x17ListExperienceRevisions\x129.jorgejr568.portfolio_grpc.ListExperienceRevisionsRequest\x1a:.jorgejr568.portfolio_grpc.ListExperienceRevisionsResponse\"1\x82\xd3\xe4\x93\x02+\x12)/v1/experiences/{experience_id}/revisions\x12\xe2\x01\n" +
+	"\x19RestoreExperienceRevision\x12;.jorgejr568.portfolio_grpc.RestoreExperienceRevisionRequest\x1a<.jorgejr568.portfolio_grpc.RestoreExperienceRevisionResponse\"J\x82\xd3\xe4\x93\x02D:\x01*\"?/v1/experiences/{experience_id}/revisions/{revision_id}:restore\x12\xbe\x01\n" +
+	"\x16ListEducationRevisions\x128.jorgejr568.portfolio_grpc.ListEducationRevisionsRequest\x1a9.jorgejr568.portfolio_grpc.ListEducationRevisionsResponse\"/\x82\xd3\xe4\x93\x02)\x12'/v1/educations/{education_id}/revisions\x12\xdd\x01\n" +
+	"\x18RestoreEducationRevision\x12:.jorgejr568.portfolio_grpc.RestoreEducationRevisionRequest\x1a;.jorgejr568.portfolio_grpc.RestoreEducationRevisionResponse\"H\x82\xd3\xe4\x93\x02B:\x01*\"=/v1/educations/{education_id}/revisions/{revision_id}:restore\x12\x9f\x01\n" +
+	"\x12GetTechnologyStats\x124.jorgejr568.portfolio_grpc.GetTechnologyStatsRequest\x1a5.jorgejr568.portfolio_grpc.GetTechnologyStatsResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/technology-stats\x12\x82\x01\n" +
+	"\vGetTimeline\x12-.jorgejr568.portfolio_grpc.GetTimelineRequest\x1a..jorgejr568.portfolio_grpc.GetTimelineResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/timelineB\xf1\x01\n" +
 	"\x1dcom.jorgejr568.portfolio_grpcB\bApiProtoP\x01ZEgithub.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc\xa2\x02\x03JPX\xaa\x02\x18Jorgejr568.PortfolioGrpc\xca\x02\x18Jorgejr568\\PortfolioGrpc\xe2\x02$Jorgejr568\\PortfolioGrpc\\GPBMetadata\xea\x02\x19Jorgejr568::PortfolioGrpcb\x06proto3"
 
 var file_jorgejr568_portfolio_grpc_api_proto_goTypes = []any{
-	(*GetAllSkillsRequest)(nil),       // 0: jorgejr568.portfolio_grpc.GetAllSkillsRequest
-	(*GetSkillRequest)(nil),           // 1: jorgejr568.portfolio_grpc.GetSkillRequest
-	(*GetAllExperiencesRequest)(nil),  // 2: jorgejr568.portfolio_grpc.GetAllExperiencesRequest
-	(*GetExperienceRequest)(nil),      // 3: jorgejr568.portfolio_grpc.GetExperienceRequest
-	(*GetAllEducationsRequest)(nil),   // 4: jorgejr568.portfolio_grpc.GetAllEducationsRequest
-	(*GetEducationRequest)(nil),       // 5: jorgejr568.portfolio_grpc.GetEducationRequest
-	(*GetAllSkillsResponse)(nil),      // 6: jorgejr568.portfolio_grpc.GetAllSkillsResponse
-	(*GetSkillResponse)(nil),          // 7: jorgejr568.portfolio_grpc.GetSkillResponse
-	(*GetAllExperiencesResponse)(nil), // 8: jorgejr568.portfolio_grpc.GetAllExperiencesResponse
-	(*GetExperienceResponse)(nil),     // 9: jorgejr568.portfolio_grpc.GetExperienceResponse
-	(*GetAllEducationsResponse)(nil),  // 10: jorgejr568.portfolio_grpc.GetAllEducationsResponse
-	(*GetEducationResponse)(nil),      // 11: jorgejr568.portfolio_grpc.GetEducationResponse
+	(*GetAllSkillsRequest)(nil),               // 0: jorgejr568.portfolio_grpc.GetAllSkillsRequest
+	(*GetSkillRequest)(nil),                   // 1: jorgejr568.portfolio_grpc.GetSkillRequest
+	(*BatchGetSkillsRequest)(nil),             // 2: jorgejr568.portfolio_grpc.BatchGetSkillsRequest
+	(*CreateSkillRequest)(nil),                // 3: jorgejr568.portfolio_grpc.CreateSkillRequest
+	(*UpdateSkillRequest)(nil),                // 4: jorgejr568.portfolio_grpc.UpdateSkillRequest
+	(*DeleteSkillRequest)(nil),                // 5: jorgejr568.portfolio_grpc.DeleteSkillRequest
+	(*UndeleteSkillRequest)(nil),              // 6: jorgejr568.portfolio_grpc.UndeleteSkillRequest
+	(*ListSkillCategoriesRequest)(nil),        // 7: jorgejr568.portfolio_grpc.ListSkillCategoriesRequest
+	(*GetAllExperiencesRequest)(nil),          // 8: jorgejr568.portfolio_grpc.GetAllExperiencesRequest
+	(*GetExperienceRequest)(nil),              // 9: jorgejr568.portfolio_grpc.GetExperienceRequest
+	(*BatchGetExperiencesRequest)(nil),        // 10: jorgejr568.portfolio_grpc.BatchGetExperiencesRequest
+	(*CreateExperienceRequest)(nil),           // 11: jorgejr568.portfolio_grpc.CreateExperienceRequest
+	(*UpdateExperienceRequest)(nil),           // 12: jorgejr568.portfolio_grpc.UpdateExperienceRequest
+	(*DeleteExperienceRequest)(nil),           // 13: jorgejr568.portfolio_grpc.DeleteExperienceRequest
+	(*UndeleteExperienceRequest)(nil),         // 14: jorgejr568.portfolio_grpc.UndeleteExperienceRequest
+	(*GetAllEducationsRequest)(nil),           // 15: jorgejr568.portfolio_grpc.GetAllEducationsRequest
+	(*GetEducationRequest)(nil),               // 16: jorgejr568.portfolio_grpc.GetEducationRequest
+	(*BatchGetEducationsRequest)(nil),         // 17: jorgejr568.portfolio_grpc.BatchGetEducationsRequest
+	(*CreateEducationRequest)(nil),            // 18: jorgejr568.portfolio_grpc.CreateEducationRequest
+	(*UpdateEducationRequest)(nil),            // 19: jorgejr568.portfolio_grpc.UpdateEducationRequest
+	(*DeleteEducationRequest)(nil),            // 20: jorgejr568.portfolio_grpc.DeleteEducationRequest
+	(*UndeleteEducationRequest)(nil),          // 21: jorgejr568.portfolio_grpc.UndeleteEducationRequest
+	(*SearchPortfolioRequest)(nil),            // 22: jorgejr568.portfolio_grpc.SearchPortfolioRequest
+	(*GetPortfolioRequest)(nil),               // 23: jorgejr568.portfolio_grpc.GetPortfolioRequest
+	(*WatchPortfolioRequest)(nil),             // 24: jorgejr568.portfolio_grpc.WatchPortfolioRequest
+	(*GetAllProjectsRequest)(nil),             // 25: jorgejr568.portfolio_grpc.GetAllProjectsRequest
+	(*GetProjectRequest)(nil),                 // 26: jorgejr568.portfolio_grpc.GetProjectRequest
+	(*GetAllCertificationsRequest)(nil),       // 27: jorgejr568.portfolio_grpc.GetAllCertificationsRequest
+	(*GetCertificationRequest)(nil),           // 28: jorgejr568.portfolio_grpc.GetCertificationRequest
+	(*GetAllPublicationsRequest)(nil),         // 29: jorgejr568.portfolio_grpc.GetAllPublicationsRequest
+	(*GetPublicationRequest)(nil),             // 30: jorgejr568.portfolio_grpc.GetPublicationRequest
+	(*GetProfileRequest)(nil),                 // 31: jorgejr568.portfolio_grpc.GetProfileRequest
+	(*UpdateProfileRequest)(nil),              // 32: jorgejr568.portfolio_grpc.UpdateProfileRequest
+	(*GetAllTestimonialsRequest)(nil),         // 33: jorgejr568.portfolio_grpc.GetAllTestimonialsRequest
+	(*SubmitTestimonialRequest)(nil),          // 34: jorgejr568.portfolio_grpc.SubmitTestimonialRequest
+	(*ApproveTestimonialRequest)(nil),         // 35: jorgejr568.portfolio_grpc.ApproveTestimonialRequest
+	(*RejectTestimonialRequest)(nil),          // 36: jorgejr568.portfolio_grpc.RejectTestimonialRequest
+	(*SubmitContactMessageRequest)(nil),       // 37: jorgejr568.portfolio_grpc.SubmitContactMessageRequest
+	(*ListSkillRevisionsRequest)(nil),         // 38: jorgejr568.portfolio_grpc.ListSkillRevisionsRequest
+	(*RestoreSkillRevisionRequest)(nil),       // 39: jorgejr568.portfolio_grpc.RestoreSkillRevisionRequest
+	(*ListExperienceRevisionsRequest)(nil),    // 40: jorgejr568.portfolio_grpc.ListExperienceRevisionsRequest
+	(*RestoreExperienceRevisionRequest)(nil),  // 41: jorgejr568.portfolio_grpc.RestoreExperienceRevisionRequest
+	(*ListEducationRevisionsRequest)(nil),     // 42: jorgejr568.portfolio_grpc.ListEducationRevisionsRequest
+	(*RestoreEducationRevisionRequest)(nil),   // 43: jorgejr568.portfolio_grpc.RestoreEducationRevisionRequest
+	(*GetTechnologyStatsRequest)(nil),         // 44: jorgejr568.portfolio_grpc.GetTechnologyStatsRequest
+	(*GetTimelineRequest)(nil),                // 45: jorgejr568.portfolio_grpc.GetTimelineRequest
+	(*GetAllSkillsResponse)(nil),              // 46: jorgejr568.portfolio_grpc.GetAllSkillsResponse
+	(*GetSkillResponse)(nil),                  // 47: jorgejr568.portfolio_grpc.GetSkillResponse
+	(*BatchGetSkillsResponse)(nil),            // 48: jorgejr568.portfolio_grpc.BatchGetSkillsResponse
+	(*CreateSkillResponse)(nil),               // 49: jorgejr568.portfolio_grpc.CreateSkillResponse
+	(*UpdateSkillResponse)(nil),               // 50: jorgejr568.portfolio_grpc.UpdateSkillResponse
+	(*DeleteSkillResponse)(nil),               // 51: jorgejr568.portfolio_grpc.DeleteSkillResponse
+	(*UndeleteSkillResponse)(nil),             // 52: jorgejr568.portfolio_grpc.UndeleteSkillResponse
+	(*ListSkillCategoriesResponse)(nil),       // 53: jorgejr568.portfolio_grpc.ListSkillCategoriesResponse
+	(*GetAllExperiencesResponse)(nil),         // 54: jorgejr568.portfolio_grpc.GetAllExperiencesResponse
+	(*GetExperienceResponse)(nil),             // 55: jorgejr568.portfolio_grpc.GetExperienceResponse
+	(*BatchGetExperiencesResponse)(nil),       // 56: jorgejr568.portfolio_grpc.BatchGetExperiencesResponse
+	(*CreateExperienceResponse)(nil),          // 57: jorgejr568.portfolio_grpc.CreateExperienceResponse
+	(*UpdateExperienceResponse)(nil),          // 58: jorgejr568.portfolio_grpc.UpdateExperienceResponse
+	(*DeleteExperienceResponse)(nil),          // 59: jorgejr568.portfolio_grpc.DeleteExperienceResponse
+	(*UndeleteExperienceResponse)(nil),        // 60: jorgejr568.portfolio_grpc.UndeleteExperienceResponse
+	(*GetAllEducationsResponse)(nil),          // 61: jorgejr568.portfolio_grpc.GetAllEducationsResponse
+	(*GetEducationResponse)(nil),              // 62: jorgejr568.portfolio_grpc.GetEducationResponse
+	(*BatchGetEducationsResponse)(nil),        // 63: jorgejr568.portfolio_grpc.BatchGetEducationsResponse
+	(*CreateEducationResponse)(nil),           // 64: jorgejr568.portfolio_grpc.CreateEducationResponse
+	(*UpdateEducationResponse)(nil),           // 65: jorgejr568.portfolio_grpc.UpdateEducationResponse
+	(*DeleteEducationResponse)(nil),           // 66: jorgejr568.portfolio_grpc.DeleteEducationResponse
+	(*UndeleteEducationResponse)(nil),         // 67: jorgejr568.portfolio_grpc.UndeleteEducationResponse
+	(*SearchPortfolioResponse)(nil),           // 68: jorgejr568.portfolio_grpc.SearchPortfolioResponse
+	(*GetPortfolioResponse)(nil),              // 69: jorgejr568.portfolio_grpc.GetPortfolioResponse
+	(*WatchPortfolioResponse)(nil),            // 70: jorgejr568.portfolio_grpc.WatchPortfolioResponse
+	(*GetAllProjectsResponse)(nil),            // 71: jorgejr568.portfolio_grpc.GetAllProjectsResponse
+	(*GetProjectResponse)(nil),                // 72: jorgejr568.portfolio_grpc.GetProjectResponse
+	(*GetAllCertificationsResponse)(nil),      // 73: jorgejr568.portfolio_grpc.GetAllCertificationsResponse
+	(*GetCertificationResponse)(nil),          // 74: jorgejr568.portfolio_grpc.GetCertificationResponse
+	(*GetAllPublicationsResponse)(nil),        // 75: jorgejr568.portfolio_grpc.GetAllPublicationsResponse
+	(*GetPublicationResponse)(nil),            // 76: jorgejr568.portfolio_grpc.GetPublicationResponse
+	(*GetProfileResponse)(nil),                // 77: jorgejr568.portfolio_grpc.GetProfileResponse
+	(*UpdateProfileResponse)(nil),             // 78: jorgejr568.portfolio_grpc.UpdateProfileResponse
+	(*GetAllTestimonialsResponse)(nil),        // 79: jorgejr568.portfolio_grpc.GetAllTestimonialsResponse
+	(*SubmitTestimonialResponse)(nil),         // 80: jorgejr568.portfolio_grpc.SubmitTestimonialResponse
+	(*ApproveTestimonialResponse)(nil),        // 81: jorgejr568.portfolio_grpc.ApproveTestimonialResponse
+	(*RejectTestimonialResponse)(nil),         // 82: jorgejr568.portfolio_grpc.RejectTestimonialResponse
+	(*SubmitContactMessageResponse)(nil),      // 83: jorgejr568.portfolio_grpc.SubmitContactMessageResponse
+	(*ListSkillRevisionsResponse)(nil),        // 84: jorgejr568.portfolio_grpc.ListSkillRevisionsResponse
+	(*RestoreSkillRevisionResponse)(nil),      // 85: jorgejr568.portfolio_grpc.RestoreSkillRevisionResponse
+	(*ListExperienceRevisionsResponse)(nil),   // 86: jorgejr568.portfolio_grpc.ListExperienceRevisionsResponse
+	(*RestoreExperienceRevisionResponse)(nil), // 87: jorgejr568.portfolio_grpc.RestoreExperienceRevisionResponse
+	(*ListEducationRevisionsResponse)(nil),    // 88: jorgejr568.portfolio_grpc.ListEducationRevisionsResponse
+	(*RestoreEducationRevisionResponse)(nil),  // 89: jorgejr568.portfolio_grpc.RestoreEducationRevisionResponse
+	(*GetTechnologyStatsResponse)(nil),        // 90: jorgejr568.portfolio_grpc.GetTechnologyStatsResponse
+	(*GetTimelineResponse)(nil),               // 91: jorgejr568.portfolio_grpc.GetTimelineResponse
 }
 var file_jorgejr568_portfolio_grpc_api_proto_depIdxs = []int32{
 	0,  // 0: jorgejr568.portfolio_grpc.PortfolioService.GetAllSkills:input_type -> jorgejr568.portfolio_grpc.GetAllSkillsRequest
 	1,  // 1: jorgejr568.portfolio_grpc.PortfolioService.GetSkill:input_type -> jorgejr568.portfolio_grpc.GetSkillRequest
-	2,  // 2: jorgejr568.portfolio_grpc.PortfolioService.GetAllExperiences:input_type -> jorgejr568.portfolio_grpc.GetAllExperiencesRequest
-	3,  // 3: jorgejr568.portfolio_grpc.PortfolioService.GetExperience:input_type -> jorgejr568.portfolio_grpc.GetExperienceRequest
-	4,  // 4: jorgejr568.portfolio_grpc.PortfolioService.GetAllEducations:input_type -> jorgejr568.portfolio_grpc.GetAllEducationsRequest
-	5,  // 5: jorgejr568.portfolio_grpc.PortfolioService.GetEducation:input_type -> jorgejr568.portfolio_grpc.GetEducationRequest
-	6,  // 6: jorgejr568.portfolio_grpc.PortfolioService.GetAllSkills:output_type -> jorgejr568.portfolio_grpc.GetAllSkillsResponse
-	7,  // 7: jorgejr568.portfolio_grpc.PortfolioService.GetSkill:output_type -> jorgejr568.portfolio_grpc.GetSkillResponse
-	8,  // 8: jorgejr568.portfolio_grpc.PortfolioService.GetAllExperiences:output_type -> jorgejr568.portfolio_grpc.GetAllExperiencesResponse
-	9,  // 9: jorgejr568.portfolio_grpc.PortfolioService.GetExperience:output_type -> jorgejr568.portfolio_grpc.GetExperienceResponse
-	10, // 10: jorgejr568.portfolio_grpc.PortfolioService.GetAllEducations:output_type -> jorgejr568.portfolio_grpc.GetAllEducationsResponse
-	11, // 11: jorgejr568.portfolio_grpc.PortfolioService.GetEducation:output_type -> jorgejr568.portfolio_grpc.GetEducationResponse
-	6,  // [6:12] is the sub-list for method output_type
-	0,  // [0:6] is the sub-list for method input_type
+	2,  // 2: jorgejr568.portfolio_grpc.PortfolioService.BatchGetSkills:input_type -> jorgejr568.portfolio_grpc.BatchGetSkillsRequest
+	3,  // 3: jorgejr568.portfolio_grpc.PortfolioService.CreateSkill:input_type -> jorgejr568.portfolio_grpc.CreateSkillRequest
+	4,  // 4: jorgejr568.portfolio_grpc.PortfolioService.UpdateSkill:input_type -> jorgejr568.portfolio_grpc.UpdateSkillRequest
+	5,  // 5: jorgejr568.portfolio_grpc.PortfolioService.DeleteSkill:input_type -> jorgejr568.portfolio_grpc.DeleteSkillRequest
+	6,  // 6: jorgejr568.portfolio_grpc.PortfolioService.UndeleteSkill:input_type -> jorgejr568.portfolio_grpc.UndeleteSkillRequest
+	7,  // 7: jorgejr568.portfolio_grpc.PortfolioService.ListSkillCategories:input_type -> jorgejr568.portfolio_grpc.ListSkillCategoriesRequest
+	8,  // 8: jorgejr568.portfolio_grpc.PortfolioService.GetAllExperiences:input_type -> jorgejr568.portfolio_grpc.GetAllExperiencesRequest
+	9,  // 9: jorgejr568.portfolio_grpc.PortfolioService.GetExperience:input_type -> jorgejr568.portfolio_grpc.GetExperienceRequest
+	10, // 10: jorgejr568.portfolio_grpc.PortfolioService.BatchGetExperiences:input_type -> jorgejr568.portfolio_grpc.BatchGetExperiencesRequest
+	11, // 11: jorgejr568.portfolio_grpc.PortfolioService.CreateExperience:input_type -> jorgejr568.portfolio_grpc.CreateExperienceRequest
+	12, // 12: jorgejr568.portfolio_grpc.PortfolioService.UpdateExperience:input_type -> jorgejr568.portfolio_grpc.UpdateExperienceRequest
+	13, // 13: jorgejr568.portfolio_grpc.PortfolioService.DeleteExperience:input_type -> jorgejr568.portfolio_grpc.DeleteExperienceRequest
+	14, // 14: jorgejr568.portfolio_grpc.PortfolioService.UndeleteExperience:input_type -> jorgejr568.portfolio_grpc.UndeleteExperienceRequest
+	15, // 15: jorgejr568.portfolio_grpc.PortfolioService.GetAllEducations:input_type -> jorgejr568.portfolio_grpc.GetAllEducationsRequest
+	16, // 16: jorgejr568.portfolio_grpc.PortfolioService.GetEducation:input_type -> jorgejr568.portfolio_grpc.GetEducationRequest
+	17, // 17: jorgejr568.portfolio_grpc.PortfolioService.BatchGetEducations:input_type -> jorgejr568.portfolio_grpc.BatchGetEducationsRequest
+	18, // 18: jorgejr568.portfolio_grpc.PortfolioService.CreateEducation:input_type -> jorgejr568.portfolio_grpc.CreateEducationRequest
+	19, // 19: jorgejr568.portfolio_grpc.PortfolioService.UpdateEducation:input_type -> jorgejr568.portfolio_grpc.UpdateEducationRequest
+	20, // 20: jorgejr568.portfolio_grpc.PortfolioService.DeleteEducation:input_type -> jorgejr568.portfolio_grpc.DeleteEducationRequest
+	21, // 21: jorgejr568.portfolio_grpc.PortfolioService.UndeleteEducation:input_type -> jorgejr568.portfolio_grpc.UndeleteEducationRequest
+	22, // 22: jorgejr568.portfolio_grpc.PortfolioService.SearchPortfolio:input_type -> jorgejr568.portfolio_grpc.SearchPortfolioRequest
+	23, // 23: jorgejr568.portfolio_grpc.PortfolioService.GetPortfolio:input_type -> jorgejr568.portfolio_grpc.GetPortfolioRequest
+	24, // 24: jorgejr568.portfolio_grpc.PortfolioService.WatchPortfolio:input_type -> jorgejr568.portfolio_grpc.WatchPortfolioRequest
+	25, // 25: jorgejr568.portfolio_grpc.PortfolioService.GetAllProjects:input_type -> jorgejr568.portfolio_grpc.GetAllProjectsRequest
+	26, // 26: jorgejr568.portfolio_grpc.PortfolioService.GetProject:input_type -> jorgejr568.portfolio_grpc.GetProjectRequest
+	27, // 27: jorgejr568.portfolio_grpc.PortfolioService.GetAllCertifications:input_type -> jorgejr568.portfolio_grpc.GetAllCertificationsRequest
+	28, // 28: jorgejr568.portfolio_grpc.PortfolioService.GetCertification:input_type -> jorgejr568.portfolio_grpc.GetCertificationRequest
+	29, // 29: jorgejr568.portfolio_grpc.PortfolioService.GetAllPublications:input_type -> jorgejr568.portfolio_grpc.GetAllPublicationsRequest
+	30, // 30: jorgejr568.portfolio_grpc.PortfolioService.GetPublication:input_type -> jorgejr568.portfolio_grpc.GetPublicationRequest
+	31, // 31: jorgejr568.portfolio_grpc.PortfolioService.GetProfile:input_type -> jorgejr568.portfolio_grpc.GetProfileRequest
+	32, // 32: jorgejr568.portfolio_grpc.PortfolioService.UpdateProfile:input_type -> jorgejr568.portfolio_grpc.UpdateProfileRequest
+	33, // 33: jorgejr568.portfolio_grpc.PortfolioService.GetAllTestimonials:input_type -> jorgejr568.portfolio_grpc.GetAllTestimonialsRequest
+	34, // 34: jorgejr568.portfolio_grpc.PortfolioService.SubmitTestimonial:input_type -> jorgejr568.portfolio_grpc.SubmitTestimonialRequest
+	35, // 35: jorgejr568.portfolio_grpc.PortfolioService.ApproveTestimonial:input_type -> jorgejr568.portfolio_grpc.ApproveTestimonialRequest
+	36, // 36: jorgejr568.portfolio_grpc.PortfolioService.RejectTestimonial:input_type -> jorgejr568.portfolio_grpc.RejectTestimonialRequest
+	37, // 37: jorgejr568.portfolio_grpc.PortfolioService.SubmitContactMessage:input_type -> jorgejr568.portfolio_grpc.SubmitContactMessageRequest
+	38, // 38: jorgejr568.portfolio_grpc.PortfolioService.ListSkillRevisions:input_type -> jorgejr568.portfolio_grpc.ListSkillRevisionsRequest
+	39, // 39: jorgejr568.portfolio_grpc.PortfolioService.RestoreSkillRevision:input_type -> jorgejr568.portfolio_grpc.RestoreSkillRevisionRequest
+	40, // 40: jorgejr568.portfolio_grpc.PortfolioService.ListExperienceRevisions:input_type -> jorgejr568.portfolio_grpc.ListExperienceRevisionsRequest
+	41, // 41: jorgejr568.portfolio_grpc.PortfolioService.RestoreExperienceRevision:input_type -> jorgejr568.portfolio_grpc.RestoreExperienceRevisionRequest
+	42, // 42: jorgejr568.portfolio_grpc.PortfolioService.ListEducationRevisions:input_type -> jorgejr568.portfolio_grpc.ListEducationRevisionsRequest
+	43, // 43: jorgejr568.portfolio_grpc.PortfolioService.RestoreEducationRevision:input_type -> jorgejr568.portfolio_grpc.RestoreEducationRevisionRequest
+	44, // 44: jorgejr568.portfolio_grpc.PortfolioService.GetTechnologyStats:input_type -> jorgejr568.portfolio_grpc.GetTechnologyStatsRequest
+	45, // 45: jorgejr568.portfolio_grpc.PortfolioService.GetTimeline:input_type -> jorgejr568.portfolio_grpc.GetTimelineRequest
+	46, // 46: jorgejr568.portfolio_grpc.PortfolioService.GetAllSkills:output_type -> jorgejr568.portfolio_grpc.GetAllSkillsResponse
+	47, // 47: jorgejr568.portfolio_grpc.PortfolioService.GetSkill:output_type -> jorgejr568.portfolio_grpc.GetSkillResponse
+	48, // 48: jorgejr568.portfolio_grpc.PortfolioService.BatchGetSkills:output_type -> jorgejr568.portfolio_grpc.BatchGetSkillsResponse
+	49, // 49: jorgejr568.portfolio_grpc.PortfolioService.CreateSkill:output_type -> jorgejr568.portfolio_grpc.CreateSkillResponse
+	50, // 50: jorgejr568.portfolio_grpc.PortfolioService.UpdateSkill:output_type -> jorgejr568.portfolio_grpc.UpdateSkillResponse
+	51, // 51: jorgejr568.portfolio_grpc.PortfolioService.DeleteSkill:output_type -> jorgejr568.portfolio_grpc.DeleteSkillResponse
+	52, // 52: jorgejr568.portfolio_grpc.PortfolioService.UndeleteSkill:output_type -> jorgejr568.portfolio_grpc.UndeleteSkillResponse
+	53, // 53: jorgejr568.portfolio_grpc.PortfolioService.ListSkillCategories:output_type -> jorgejr568.portfolio_grpc.ListSkillCategoriesResponse
+	54, // 54: jorgejr568.portfolio_grpc.PortfolioService.GetAllExperiences:output_type -> jorgejr568.portfolio_grpc.GetAllExperiencesResponse
+	55, // 55: jorgejr568.portfolio_grpc.PortfolioService.GetExperience:output_type -> jorgejr568.portfolio_grpc.GetExperienceResponse
+	56, // 56: jorgejr568.portfolio_grpc.PortfolioService.BatchGetExperiences:output_type -> jorgejr568.portfolio_grpc.BatchGetExperiencesResponse
+	57, // 57: jorgejr568.portfolio_grpc.PortfolioService.CreateExperience:output_type -> jorgejr568.portfolio_grpc.CreateExperienceResponse
+	58, // 58: jorgejr568.portfolio_grpc.PortfolioService.UpdateExperience:output_type -> jorgejr568.portfolio_grpc.UpdateExperienceResponse
+	59, // 59: jorgejr568.portfolio_grpc.PortfolioService.DeleteExperience:output_type -> jorgejr568.portfolio_grpc.DeleteExperienceResponse
+	60, // 60: jorgejr568.portfolio_grpc.PortfolioService.UndeleteExperience:output_type -> jorgejr568.portfolio_grpc.UndeleteExperienceResponse
+	61, // 61: jorgejr568.portfolio_grpc.PortfolioService.GetAllEducations:output_type -> jorgejr568.portfolio_grpc.GetAllEducationsResponse
+	62, // 62: jorgejr568.portfolio_grpc.PortfolioService.GetEducation:output_type -> jorgejr568.portfolio_grpc.GetEducationResponse
+	63, // 63: jorgejr568.portfolio_grpc.PortfolioService.BatchGetEducations:output_type -> jorgejr568.portfolio_grpc.BatchGetEducationsResponse
+	64, // 64: jorgejr568.portfolio_grpc.PortfolioService.CreateEducation:output_type -> jorgejr568.portfolio_grpc.CreateEducationResponse
+	65, // 65: jorgejr568.portfolio_grpc.PortfolioService.UpdateEducation:output_type -> jorgejr568.portfolio_grpc.UpdateEducationResponse
+	66, // 66: jorgejr568.portfolio_grpc.PortfolioService.DeleteEducation:output_type -> jorgejr568.portfolio_grpc.DeleteEducationResponse
+	67, // 67: jorgejr568.portfolio_grpc.PortfolioService.UndeleteEducation:output_type -> jorgejr568.portfolio_grpc.UndeleteEducationResponse
+	68, // 68: jorgejr568.portfolio_grpc.PortfolioService.SearchPortfolio:output_type -> jorgejr568.portfolio_grpc.SearchPortfolioResponse
+	69, // 69: jorgejr568.portfolio_grpc.PortfolioService.GetPortfolio:output_type -> jorgejr568.portfolio_grpc.GetPortfolioResponse
+	70, // 70: jorgejr568.portfolio_grpc.PortfolioService.WatchPortfolio:output_type -> jorgejr568.portfolio_grpc.WatchPortfolioResponse
+	71, // 71: jorgejr568.portfolio_grpc.PortfolioService.GetAllProjects:output_type -> jorgejr568.portfolio_grpc.GetAllProjectsResponse
+	72, // 72: jorgejr568.portfolio_grpc.PortfolioService.GetProject:output_type -> jorgejr568.portfolio_grpc.GetProjectResponse
+	73, // 73: jorgejr568.portfolio_grpc.PortfolioService.GetAllCertifications:output_type -> jorgejr568.portfolio_grpc.GetAllCertificationsResponse
+	74, // 74: jorgejr568.portfolio_grpc.PortfolioService.GetCertification:output_type -> jorgejr568.portfolio_grpc.GetCertificationResponse
+	75, // 75: jorgejr568.portfolio_grpc.PortfolioService.GetAllPublications:output_type -> jorgejr568.portfolio_grpc.GetAllPublicationsResponse
+	76, // 76: jorgejr568.portfolio_grpc.PortfolioService.GetPublication:output_type -> jorgejr568.portfolio_grpc.GetPublicationResponse
+	77, // 77: jorgejr568.portfolio_grpc.PortfolioService.GetProfile:output_type -> jorgejr568.portfolio_grpc.GetProfileResponse
+	78, // 78: jorgejr568.portfolio_grpc.PortfolioService.UpdateProfile:output_type -> jorgejr568.portfolio_grpc.UpdateProfileResponse
+	79, // 79: jorgejr568.portfolio_grpc.PortfolioService.GetAllTestimonials:output_type -> jorgejr568.portfolio_grpc.GetAllTestimonialsResponse
+	80, // 80: jorgejr568.portfolio_grpc.PortfolioService.SubmitTestimonial:output_type -> jorgejr568.portfolio_grpc.SubmitTestimonialResponse
+	81, // 81: jorgejr568.portfolio_grpc.PortfolioService.ApproveTestimonial:output_type -> jorgejr568.portfolio_grpc.ApproveTestimonialResponse
+	82, // 82: jorgejr568.portfolio_grpc.PortfolioService.RejectTestimonial:output_type -> jorgejr568.portfolio_grpc.RejectTestimonialResponse
+	83, // 83: jorgejr568.portfolio_grpc.PortfolioService.SubmitContactMessage:output_type -> jorgejr568.portfolio_grpc.SubmitContactMessageResponse
+	84, // 84: jorgejr568.portfolio_grpc.PortfolioService.ListSkillRevisions:output_type -> jorgejr568.portfolio_grpc.ListSkillRevisionsResponse
+	85, // 85: jorgejr568.portfolio_grpc.PortfolioService.RestoreSkillRevision:output_type -> jorgejr568.portfolio_grpc.RestoreSkillRevisionResponse
+	86, // 86: jorgejr568.portfolio_grpc.PortfolioService.ListExperienceRevisions:output_type -> jorgejr568.portfolio_grpc.ListExperienceRevisionsResponse
+	87, // 87: jorgejr568.portfolio_grpc.PortfolioService.RestoreExperienceRevision:output_type -> jorgejr568.portfolio_grpc.RestoreExperienceRevisionResponse
+	88, // 88: jorgejr568.portfolio_grpc.PortfolioService.ListEducationRevisions:output_type -> jorgejr568.portfolio_grpc.ListEducationRevisionsResponse
+	89, // 89: jorgejr568.portfolio_grpc.PortfolioService.RestoreEducationRevision:output_type -> jorgejr568.portfolio_grpc.RestoreEducationRevisionResponse
+	90, // 90: jorgejr568.portfolio_grpc.PortfolioService.GetTechnologyStats:output_type -> jorgejr568.portfolio_grpc.GetTechnologyStatsResponse
+	91, // 91: jorgejr568.portfolio_grpc.PortfolioService.GetTimeline:output_type -> jorgejr568.portfolio_grpc.GetTimelineResponse
+	46, // [46:92] is the sub-list for method output_type
+	0,  // [0:46] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -78,6 +284,17 @@ func file_jorgejr568_portfolio_grpc_api_proto_init() {
 	file_jorgejr568_portfolio_grpc_skills_proto_init()
 	file_jorgejr568_portfolio_grpc_experiences_proto_init()
 	file_jorgejr568_portfolio_grpc_educations_proto_init()
+	file_jorgejr568_portfolio_grpc_certifications_proto_init()
+	file_jorgejr568_portfolio_grpc_contact_proto_init()
+	file_jorgejr568_portfolio_grpc_portfolio_proto_init()
+	file_jorgejr568_portfolio_grpc_profile_proto_init()
+	file_jorgejr568_portfolio_grpc_projects_proto_init()
+	file_jorgejr568_portfolio_grpc_publications_proto_init()
+	file_jorgejr568_portfolio_grpc_revisions_proto_init()
+	file_jorgejr568_portfolio_grpc_search_proto_init()
+	file_jorgejr568_portfolio_grpc_technologies_proto_init()
+	file_jorgejr568_portfolio_grpc_testimonials_proto_init()
+	file_jorgejr568_portfolio_grpc_timeline_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
@@ -19,12 +19,52 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PortfolioService_GetAllSkills_FullMethodName      = "/jorgejr568.portfolio_grpc.PortfolioService/GetAllSkills"
-	PortfolioService_GetSkill_FullMethodName          = "/jorgejr568.portfolio_grpc.PortfolioService/GetSkill"
-	PortfolioService_GetAllExperiences_FullMethodName = "/jorgejr568.portfolio_grpc.PortfolioService/GetAllExperiences"
-	PortfolioService_GetExperience_FullMethodName     = "/jorgejr568.portfolio_grpc.PortfolioService/GetExperience"
-	PortfolioService_GetAllEducations_FullMethodName  = "/jorgejr568.portfolio_grpc.PortfolioService/GetAllEducations"
-	PortfolioService_GetEducation_FullMethodName      = "/jorgejr568.portfolio_grpc.PortfolioService/GetEducation"
+	PortfolioService_GetAllSkills_FullMethodName              = "/jorgejr568.portfolio_grpc.PortfolioService/GetAllSkills"
+	PortfolioService_GetSkill_FullMethodName                  = "/jorgejr568.portfolio_grpc.PortfolioService/GetSkill"
+	PortfolioService_BatchGetSkills_FullMethodName            = "/jorgejr568.portfolio_grpc.PortfolioService/BatchGetSkills"
+	PortfolioService_CreateSkill_FullMethodName               = "/jorgejr568.portfolio_grpc.PortfolioService/CreateSkill"
+	PortfolioService_UpdateSkill_FullMethodName               = "/jorgejr568.portfolio_grpc.PortfolioService/UpdateSkill"
+	PortfolioService_DeleteSkill_FullMethodName               = "/jorgejr568.portfolio_grpc.PortfolioService/DeleteSkill"
+	PortfolioService_UndeleteSkill_FullMethodName             = "/jorgejr568.portfolio_grpc.PortfolioService/UndeleteSkill"
+	PortfolioService_ListSkillCategories_FullMethodName       = "/jorgejr568.portfolio_grpc.PortfolioService/ListSkillCategories"
+	PortfolioService_GetAllExperiences_FullMethodName         = "/jorgejr568.portfolio_grpc.PortfolioService/GetAllExperiences"
+	PortfolioService_GetExperience_FullMethodName             = "/jorgejr568.portfolio_grpc.PortfolioService/GetExperience"
+	PortfolioService_BatchGetExperiences_FullMethodName       = "/jorgejr568.portfolio_grpc.PortfolioService/BatchGetExperiences"
+	PortfolioService_CreateExperience_FullMethodName          = "/jorgejr568.portfolio_grpc.PortfolioService/CreateExperience"
+	PortfolioService_UpdateExperience_FullMethodName          = "/jorgejr568.portfolio_grpc.PortfolioService/UpdateExperience"
+	PortfolioService_DeleteExperience_FullMethodName          = "/jorgejr568.portfolio_grpc.PortfolioService/DeleteExperience"
+	PortfolioService_UndeleteExperience_FullMethodName        = "/jorgejr568.portfolio_grpc.PortfolioService/UndeleteExperience"
+	PortfolioService_GetAllEducations_FullMethodName          = "/jorgejr568.portfolio_grpc.PortfolioService/GetAllEducations"
+	PortfolioService_GetEducation_FullMethodName              = "/jorgejr568.portfolio_grpc.PortfolioService/GetEducation"
+	PortfolioService_BatchGetEducations_FullMethodName        = "/jorgejr568.portfolio_grpc.PortfolioService/BatchGetEducations"
+	PortfolioService_CreateEducation_FullMethodName           = "/jorgejr568.portfolio_grpc.PortfolioService/CreateEducation"
+	PortfolioService_UpdateEducation_FullMethodName           = "/jorgejr568.portfolio_grpc.PortfolioService/UpdateEducation"
+	PortfolioService_DeleteEducation_FullMethodName           = "/jorgejr568.portfolio_grpc.PortfolioService/DeleteEducation"
+	PortfolioService_UndeleteEducation_FullMethodName         = "/jorgejr568.portfolio_grpc.PortfolioService/UndeleteEducation"
+	PortfolioService_SearchPortfolio_FullMethodName           = "/jorgejr568.portfolio_grpc.PortfolioService/SearchPortfolio"
+	PortfolioService_GetPortfolio_FullMethodName              = "/jorgejr568.portfolio_grpc.PortfolioService/GetPortfolio"
+	PortfolioService_WatchPortfolio_FullMethodName            = "/jorgejr568.portfolio_grpc.PortfolioService/WatchPortfolio"
+	PortfolioService_GetAllProjects_FullMethodName            = "/jorgejr568.portfolio_grpc.PortfolioService/GetAllProjects"
+	PortfolioService_GetProject_FullMethodName                = "/jorgejr568.portfolio_grpc.PortfolioService/GetProject"
+	PortfolioService_GetAllCertifications_FullMethodName      = "/jorgejr568.portfolio_grpc.PortfolioService/GetAllCertifications"
+	PortfolioService_GetCertification_FullMethodName          = "/jorgejr568.portfolio_grpc.PortfolioService/GetCertification"
+	PortfolioService_GetAllPublications_FullMethodName        = "/jorgejr568.portfolio_grpc.PortfolioService/GetAllPublications"
+	PortfolioService_GetPublication_FullMethodName            = "/jorgejr568.portfolio_grpc.PortfolioService/GetPublication"
+	PortfolioService_GetProfile_FullMethodName                = "/jorgejr568.portfolio_grpc.PortfolioService/GetProfile"
+	PortfolioService_UpdateProfile_FullMethodName             = "/jorgejr568.portfolio_grpc.PortfolioService/UpdateProfile"
+	PortfolioService_GetAllTestimonials_FullMethodName        = "/jorgejr568.portfolio_grpc.PortfolioService/GetAllTestimonials"
+	PortfolioService_SubmitTestimonial_FullMethodName         = "/jorgejr568.portfolio_grpc.PortfolioService/SubmitTestimonial"
+	PortfolioService_ApproveTestimonial_FullMethodName        = "/jorgejr568.portfolio_grpc.PortfolioService/ApproveTestimonial"
+	PortfolioService_RejectTestimonial_FullMethodName         = "/jorgejr568.portfolio_grpc.PortfolioService/RejectTestimonial"
+	PortfolioService_SubmitContactMessage_FullMethodName      = "/jorgejr568.portfolio_grpc.PortfolioService/SubmitContactMessage"
+	PortfolioService_ListSkillRevisions_FullMethodName        = "/jorgejr568.portfolio_grpc.PortfolioService/ListSkillRevisions"
+	PortfolioService_RestoreSkillRevision_FullMethodName      = "/jorgejr568.portfolio_grpc.PortfolioService/RestoreSkillRevision"
+	PortfolioService_ListExperienceRevisions_FullMethodName   = "/jorgejr568.portfolio_grpc.PortfolioService/ListExperienceRevisions"
+	PortfolioService_RestoreExperienceRevision_FullMethodName = "/jorgejr568.portfolio_grpc.PortfolioService/RestoreExperienceRevision"
+	PortfolioService_ListEducationRevisions_FullMethodName    = "/jorgejr568.portfolio_grpc.PortfolioService/ListEducationRevisions"
+	PortfolioService_RestoreEducationRevision_FullMethodName  = "/jorgejr568.portfolio_grpc.PortfolioService/RestoreEducationRevision"
+	PortfolioService_GetTechnologyStats_FullMethodName        = "/jorgejr568.portfolio_grpc.PortfolioService/GetTechnologyStats"
+	PortfolioService_GetTimeline_FullMethodName               = "/jorgejr568.portfolio_grpc.PortfolioService/GetTimeline"
 )
 
 // PortfolioServiceClient is the client API for PortfolioService service.
@@ -34,12 +74,87 @@ type PortfolioServiceClient interface {
 	// Skills
 	GetAllSkills(ctx context.Context, in *GetAllSkillsRequest, opts ...grpc.CallOption) (*GetAllSkillsResponse, error)
 	GetSkill(ctx context.Context, in *GetSkillRequest, opts ...grpc.CallOption) (*GetSkillResponse, error)
+	BatchGetSkills(ctx context.Context, in *BatchGetSkillsRequest, opts ...grpc.CallOption) (*BatchGetSkillsResponse, error)
+	// Admin only.
+	CreateSkill(ctx context.Context, in *CreateSkillRequest, opts ...grpc.CallOption) (*CreateSkillResponse, error)
+	// Admin only.
+	UpdateSkill(ctx context.Context, in *UpdateSkillRequest, opts ...grpc.CallOption) (*UpdateSkillResponse, error)
+	// Admin only.
+	DeleteSkill(ctx context.Context, in *DeleteSkillRequest, opts ...grpc.CallOption) (*DeleteSkillResponse, error)
+	// Admin only.
+	UndeleteSkill(ctx context.Context, in *UndeleteSkillRequest, opts ...grpc.CallOption) (*UndeleteSkillResponse, error)
+	ListSkillCategories(ctx context.Context, in *ListSkillCategoriesRequest, opts ...grpc.CallOption) (*ListSkillCategoriesResponse, error)
 	// Experiences
 	GetAllExperiences(ctx context.Context, in *GetAllExperiencesRequest, opts ...grpc.CallOption) (*GetAllExperiencesResponse, error)
 	GetExperience(ctx context.Context, in *GetExperienceRequest, opts ...grpc.CallOption) (*GetExperienceResponse, error)
+	BatchGetExperiences(ctx context.Context, in *BatchGetExperiencesRequest, opts ...grpc.CallOption) (*BatchGetExperiencesResponse, error)
+	// Admin only.
+	CreateExperience(ctx context.Context, in *CreateExperienceRequest, opts ...grpc.CallOption) (*CreateExperienceResponse, error)
+	// Admin only.
+	UpdateExperience(ctx context.Context, in *UpdateExperienceRequest, opts ...grpc.CallOption) (*UpdateExperienceResponse, error)
+	// Admin only.
+	DeleteExperience(ctx context.Context, in *DeleteExperienceRequest, opts ...grpc.CallOption) (*DeleteExperienceResponse, error)
+	// Admin only.
+	UndeleteExperience(ctx context.Context, in *UndeleteExperienceRequest, opts ...grpc.CallOption) (*UndeleteExperienceResponse, error)
 	// Educations
 	GetAllEducations(ctx context.Context, in *GetAllEducationsRequest, opts ...grpc.CallOption) (*GetAllEducationsResponse, error)
 	GetEducation(ctx context.Context, in *GetEducationRequest, opts ...grpc.CallOption) (*GetEducationResponse, error)
+	BatchGetEducations(ctx context.Context, in *BatchGetEducationsRequest, opts ...grpc.CallOption) (*BatchGetEducationsResponse, error)
+	// Admin only.
+	CreateEducation(ctx context.Context, in *CreateEducationRequest, opts ...grpc.CallOption) (*CreateEducationResponse, error)
+	// Admin only.
+	UpdateEducation(ctx context.Context, in *UpdateEducationRequest, opts ...grpc.CallOption) (*UpdateEducationResponse, error)
+	// Admin only.
+	DeleteEducation(ctx context.Context, in *DeleteEducationRequest, opts ...grpc.CallOption) (*DeleteEducationResponse, error)
+	// Admin only.
+	UndeleteEducation(ctx context.Context, in *UndeleteEducationRequest, opts ...grpc.CallOption) (*UndeleteEducationResponse, error)
+	// Search
+	SearchPortfolio(ctx context.Context, in *SearchPortfolioRequest, opts ...grpc.CallOption) (*SearchPortfolioResponse, error)
+	// Portfolio
+	GetPortfolio(ctx context.Context, in *GetPortfolioRequest, opts ...grpc.CallOption) (*GetPortfolioResponse, error)
+	// Streams changes to skills, experiences and educations as they happen.
+	WatchPortfolio(ctx context.Context, in *WatchPortfolioRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchPortfolioResponse], error)
+	// Projects
+	GetAllProjects(ctx context.Context, in *GetAllProjectsRequest, opts ...grpc.CallOption) (*GetAllProjectsResponse, error)
+	GetProject(ctx context.Context, in *GetProjectRequest, opts ...grpc.CallOption) (*GetProjectResponse, error)
+	// Certifications
+	GetAllCertifications(ctx context.Context, in *GetAllCertificationsRequest, opts ...grpc.CallOption) (*GetAllCertificationsResponse, error)
+	GetCertification(ctx context.Context, in *GetCertificationRequest, opts ...grpc.CallOption) (*GetCertificationResponse, error)
+	// Publications
+	GetAllPublications(ctx context.Context, in *GetAllPublicationsRequest, opts ...grpc.CallOption) (*GetAllPublicationsResponse, error)
+	GetPublication(ctx context.Context, in *GetPublicationRequest, opts ...grpc.CallOption) (*GetPublicationResponse, error)
+	// Profile
+	GetProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*GetProfileResponse, error)
+	// Admin only.
+	UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UpdateProfileResponse, error)
+	// Testimonials
+	GetAllTestimonials(ctx context.Context, in *GetAllTestimonialsRequest, opts ...grpc.CallOption) (*GetAllTestimonialsResponse, error)
+	SubmitTestimonial(ctx context.Context, in *SubmitTestimonialRequest, opts ...grpc.CallOption) (*SubmitTestimonialResponse, error)
+	// Admin only.
+	ApproveTestimonial(ctx context.Context, in *ApproveTestimonialRequest, opts ...grpc.CallOption) (*ApproveTestimonialResponse, error)
+	// Admin only.
+	RejectTestimonial(ctx context.Context, in *RejectTestimonialRequest, opts ...grpc.CallOption) (*RejectTestimonialResponse, error)
+	// Contact
+	SubmitContactMessage(ctx context.Context, in *SubmitContactMessageRequest, opts ...grpc.CallOption) (*SubmitContactMessageResponse, error)
+	// Admin only.
+	ListSkillRevisions(ctx context.Context, in *ListSkillRevisionsRequest, opts ...grpc.CallOption) (*ListSkillRevisionsResponse, error)
+	// Admin only.
+	RestoreSkillRevision(ctx context.Context, in *RestoreSkillRevisionRequest, opts ...grpc.CallOption) (*RestoreSkillRevisionResponse, error)
+	// Admin only.
+	ListExperienceRevisions(ctx context.Context, in *ListExperienceRevisionsRequest, opts ...grpc.CallOption) (*ListExperienceRevisionsResponse, error)
+	// Admin only.
+	RestoreExperienceRevision(ctx context.Context, in *RestoreExperienceRevisionRequest, opts ...grpc.CallOption) (*RestoreExperienceRevisionResponse, error)
+	// Admin only.
+	ListEducationRevisions(ctx context.Context, in *ListEducationRevisionsRequest, opts ...grpc.CallOption) (*ListEducationRevisionsResponse, error)
+	// Admin only.
+	RestoreEducationRevision(ctx context.Context, in *RestoreEducationRevisionRequest, opts ...grpc.CallOption) (*RestoreEducationRevisionResponse, error)
+	// Technology Stats
+	// Years of use per technology, computed from the experience date ranges.
+	GetTechnologyStats(ctx context.Context, in *GetTechnologyStatsRequest, opts ...grpc.CallOption) (*GetTechnologyStatsResponse, error)
+	// Timeline
+	// Experiences, educations, projects, certifications and publications in
+	// a single chronological stream.
+	GetTimeline(ctx context.Context, in *GetTimelineRequest, opts ...grpc.CallOption) (*GetTimelineResponse, error)
 }
 
 type portfolioServiceClient struct {
@@ -70,6 +185,66 @@ func (c *portfolioServiceClient) GetSkill(ctx context.Context, in *GetSkillReque
 	return out, nil
 }
 
+func (c *portfolioServiceClient) BatchGetSkills(ctx context.Context, in *BatchGetSkillsRequest, opts ...grpc.CallOption) (*BatchGetSkillsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetSkillsResponse)
+	err := c.cc.Invoke(ctx, PortfolioService_BatchGetSkills_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioServiceClient) CreateSkill(ctx context.Context, in *CreateSkillRequest, opts ...grpc.CallOption) (*CreateSkillResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateSkillResponse)
+	err := c.cc.Invoke(ctx, PortfolioService_CreateSkill_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioServiceClient) UpdateSkill(ctx context.Context, in *UpdateSkillRequest, opts ...grpc.CallOption) (*UpdateSkillResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateSkillResponse)
+	err := c.cc.Invoke(ctx, PortfolioService_UpdateSkill_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioServiceClient) DeleteSkill(ctx context.Context, in *DeleteSkillRequest, opts ...grpc.CallOption) (*DeleteSkillResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteSkillResponse)
+	err := c.cc.Invoke(ctx, PortfolioService_DeleteSkill_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioServiceClient) UndeleteSkill(ctx context.Context, in *UndeleteSkillRequest, opts ...grpc.CallOption) (*UndeleteSkillResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UndeleteSkillResponse)
+	err := c.cc.Invoke(ctx, PortfolioService_UndeleteSkill_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioServiceClient) ListSkillCategories(ctx context.Context, in *ListSkillCategoriesRequest, opts ...grpc.CallOption) (*ListSkillCategoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSkillCategoriesResponse)
+	err := c.cc.Invoke(ctx, PortfolioService_ListSkillCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *portfolioServiceClient) GetAllExperiences(ctx context.Context, in *GetAllExperiencesRequest, opts ...grpc.CallOption) (*GetAllExperiencesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetAllExperiencesResponse)
@@ -77,205 +252,1462 @@ func (c *portfolioServiceClient) GetAllExperiences(ctx context.Context, in *GetA
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *portfolioServiceClient) GetExperience(ctx context.Context, in *GetExperienceRequest, opts ...grpc.CallOption) (*GetExperienceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetExperienceResponse)
+	err := c.cc.Invoke(ctx, PortfolioService_GetExperience_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioServiceClient) BatchGetExperiences(ctx context.Context, in *BatchGetExperiencesRequest, opts ...grpc.CallOption) (*BatchGetExperiencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetExperiencesResponse)
+	err := c.cc.Invoke(ctx, PortfolioService_BatchGetExperiences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioServiceClient) CreateExperience(ctx context.Context, in *CreateExperienceRequest, opts ...grpc.CallOption) (*CreateExperienceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateExperienceResponse)
+	err := c.cc.Invoke(ctx, PortfolioService_CreateExperience_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioServiceClient) UpdateExperience(ctx context.Context, in *UpdateExperienceRequest, opts ...grpc.CallOption) (*UpdateExperienceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateExperienceResponse)
+	err := c.cc.Invoke(ctx, PortfolioService_UpdateExperience_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioServiceClient) DeleteExperience(ctx context.Context, in *DeleteExperienceRequest, opts ...grpc.CallOption) (*DeleteExperienceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteExperienceResponse)
+	err := c.cc.Invoke(ctx, PortfolioService_DeleteExperience_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioServiceClient) UndeleteExperience(ctx context.Context, in *UndeleteExperienceRequest, opts ...grpc.CallOption) (*UndeleteExperienceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UndeleteExperienceResponse)
+	err := c.cc.Invoke(ctx, PortfolioService_UndeleteExperience_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioServiceClient) GetAllEducations(ctx context.Context, in *GetAllEducationsRequest, opts ...grpc.CallOption) (*GetAllEducationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAllEducationsResponse)
+	err := c.cc.Invoke(ctx, PortfolioService_GetAllEducations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioServiceClient) GetEducation(ctx context.Context, in *GetEducationRequest, opts ...grpc.CallOption) (*GetEducationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEducationResponse)
+	err := c.cc.Invoke(ctx, PortfolioService_GetEducation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioServiceClient) BatchGetEducations(ctx context.Context, in *BatchGetEducationsRequest, opts ...grpc.CallOption) (*BatchGetEducationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetEducationsResponse)
+	err := c.cc.Invoke(ctx, PortfolioService_BatchGetEducations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioServiceClient) CreateEducation(ctx context.Context, in *CreateEducationRequest, opts ...grpc.CallOption) (*CreateEducationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateEducationResponse)
+	err := c.cc.Invoke(ctx, PortfolioService_CreateEducation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioServiceClient) UpdateEducation(ctx context.Context, in *UpdateEducationRequest, opts ...grpc.CallOption) (*UpdateEducationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateEducationResponse)
+	err := c.cc.Invoke(ctx, PortfolioService_UpdateEducation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioServiceClient) DeleteEducation(ctx context.Context, in *DeleteEducationRequest, opts ...grpc.CallOption) (*DeleteEducationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteEducationResponse)
+	err := c.cc.Invoke(ctx, PortfolioService_DeleteEducation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioServiceClient) UndeleteEducation(ctx context.Context, in *UndeleteEducationRequest, opts ...grpc.CallOption) (*UndeleteEducationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UndeleteEducationResponse)
+	err := c.cc.Invoke(ctx, PortfolioService_UndeleteEducation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioServiceClient) SearchPortfolio(ctx context.Context, in *SearchPortfolioRequest, opts ...grpc.CallOption) (*SearchPortfolioResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchPortfolioResponse)
+	err := c.cc.Invoke(ctx, PortfolioService_SearchPortfolio_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioServiceClient) GetPortfolio(ctx context.Context, in *GetPortfolioRequest, opts ...grpc.CallOption) (*GetPortfolioResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPortfolioResponse)
+	err := c.cc.Invoke(ctx, PortfolioService_GetPortfolio_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioServiceClient) WatchPortfolio(ctx context.Context, in *WatchPortfolioRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchPortfolioResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PortfolioService_ServiceDesc.Streams[0], PortfolioService_WatchPortfolio_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchPortfolioRequest, WatchPortfolioResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PortfolioService_WatchPortfolioClient = grpc.ServerStreamingClient[WatchPortfolioResponse]
+
+func (c *portfolioServiceClient) GetAllProjects(ctx context.Context, in *GetAllProjectsRequest, opts ...grpc.CallOption) (*GetAllProjectsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAllProjectsResponse)
+	err := c.cc.Invoke(ctx, PortfolioService_GetAllProjects_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioServiceClient) GetProject(ctx context.Context, in *GetProjectRequest, opts ...grpc.CallOption) (*GetProjectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProjectResponse)
+	err := c.cc.Invoke(ctx, PortfolioService_GetProject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioServiceClient) GetAllCertifications(ctx context.Context, in *GetAllCertificationsRequest, opts ...grpc.CallOption) (*GetAllCertificationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAllCertificationsResponse)
+	err := c.cc.Invoke(ctx, PortfolioService_GetAllCertifications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioServiceClient) GetCertification(ctx context.Context, in *GetCertificationRequest, opts ...grpc.CallOption) (*GetCertificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCertificationResponse)
+	err := c.cc.Invoke(ctx, PortfolioService_GetCertification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioServiceClient) GetAllPublications(ctx context.Context, in *GetAllPublicationsRequest, opts ...grpc.CallOption) (*GetAllPublicationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAllPublicationsResponse)
+	err := c.cc.Invoke(ctx, PortfolioService_GetAllPublications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioServiceClient) GetPublication(ctx context.Context, in *GetPublicationRequest, opts ...grpc.CallOption) (*GetPublicationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPublicationResponse)
+	err := c.cc.Invoke(ctx, PortfolioService_GetPublication_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioServiceClient) GetProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*GetProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProfileResponse)
+	err := c.cc.Invoke(ctx, PortfolioService_GetProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioServiceClient) UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UpdateProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateProfileResponse)
+	err := c.cc.Invoke(ctx, PortfolioService_UpdateProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioServiceClient) GetAllTestimonials(ctx context.Context, in *GetAllTestimonialsRequest, opts ...grpc.CallOption) (*GetAllTestimonialsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAllTestimonialsResponse)
+	err := c.cc.Invoke(ctx, PortfolioService_GetAllTestimonials_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioServiceClient) SubmitTestimonial(ctx context.Context, in *SubmitTestimonialRequest, opts ...grpc.CallOption) (*SubmitTestimonialResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitTestimonialResponse)
+	err := c.cc.Invoke(ctx, PortfolioService_SubmitTestimonial_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioServiceClient) ApproveTestimonial(ctx context.Context, in *ApproveTestimonialRequest, opts ...grpc.CallOption) (*ApproveTestimonialResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveTestimonialResponse)
+	err := c.cc.Invoke(ctx, PortfolioService_ApproveTestimonial_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioServiceClient) RejectTestimonial(ctx context.Context, in *RejectTestimonialRequest, opts ...grpc.CallOption) (*RejectTestimonialResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RejectTestimonialResponse)
+	err := c.cc.Invoke(ctx, PortfolioService_RejectTestimonial_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioServiceClient) SubmitContactMessage(ctx context.Context, in *SubmitContactMessageRequest, opts ...grpc.CallOption) (*SubmitContactMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitContactMessageResponse)
+	err := c.cc.Invoke(ctx, PortfolioService_SubmitContactMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioServiceClient) ListSkillRevisions(ctx context.Context, in *ListSkillRevisionsRequest, opts ...grpc.CallOption) (*ListSkillRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSkillRevisionsResponse)
+	err := c.cc.Invoke(ctx, PortfolioService_ListSkillRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioServiceClient) RestoreSkillRevision(ctx context.Context, in *RestoreSkillRevisionRequest, opts ...grpc.CallOption) (*RestoreSkillRevisionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreSkillRevisionResponse)
+	err := c.cc.Invoke(ctx, PortfolioService_RestoreSkillRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioServiceClient) ListExperienceRevisions(ctx context.Context, in *ListExperienceRevisionsRequest, opts ...grpc.CallOption) (*ListExperienceRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListExperienceRevisionsResponse)
+	err := c.cc.Invoke(ctx, PortfolioService_ListExperienceRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioServiceClient) RestoreExperienceRevision(ctx context.Context, in *RestoreExperienceRevisionRequest, opts ...grpc.CallOption) (*RestoreExperienceRevisionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreExperienceRevisionResponse)
+	err := c.cc.Invoke(ctx, PortfolioService_RestoreExperienceRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioServiceClient) ListEducationRevisions(ctx context.Context, in *ListEducationRevisionsRequest, opts ...grpc.CallOption) (*ListEducationRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEducationRevisionsResponse)
+	err := c.cc.Invoke(ctx, PortfolioService_ListEducationRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioServiceClient) RestoreEducationRevision(ctx context.Context, in *RestoreEducationRevisionRequest, opts ...grpc.CallOption) (*RestoreEducationRevisionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreEducationRevisionResponse)
+	err := c.cc.Invoke(ctx, PortfolioService_RestoreEducationRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioServiceClient) GetTechnologyStats(ctx context.Context, in *GetTechnologyStatsRequest, opts ...grpc.CallOption) (*GetTechnologyStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTechnologyStatsResponse)
+	err := c.cc.Invoke(ctx, PortfolioService_GetTechnologyStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioServiceClient) GetTimeline(ctx context.Context, in *GetTimelineRequest, opts ...grpc.CallOption) (*GetTimelineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTimelineResponse)
+	err := c.cc.Invoke(ctx, PortfolioService_GetTimeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PortfolioServiceServer is the server API for PortfolioService service.
+// All implementations must embed UnimplementedPortfolioServiceServer
+// for forward compatibility.
+type PortfolioServiceServer interface {
+	// Skills
+	GetAllSkills(context.Context, *GetAllSkillsRequest) (*GetAllSkillsResponse, error)
+	GetSkill(context.Context, *GetSkillRequest) (*GetSkillResponse, error)
+	BatchGetSkills(context.Context, *BatchGetSkillsRequest) (*BatchGetSkillsResponse, error)
+	// Admin only.
+	CreateSkill(context.Context, *CreateSkillRequest) (*CreateSkillResponse, error)
+	// Admin only.
+	UpdateSkill(context.Context, *UpdateSkillRequest) (*UpdateSkillResponse, error)
+	// Admin only.
+	DeleteSkill(context.Context, *DeleteSkillRequest) (*DeleteSkillResponse, error)
+	// Admin only.
+	UndeleteSkill(context.Context, *UndeleteSkillRequest) (*UndeleteSkillResponse, error)
+	ListSkillCategories(context.Context, *ListSkillCategoriesRequest) (*ListSkillCategoriesResponse, error)
+	// Experiences
+	GetAllExperiences(context.Context, *GetAllExperiencesRequest) (*GetAllExperiencesResponse, error)
+	GetExperience(context.Context, *GetExperienceRequest) (*GetExperienceResponse, error)
+	BatchGetExperiences(context.Context, *BatchGetExperiencesRequest) (*BatchGetExperiencesResponse, error)
+	// Admin only.
+	CreateExperience(context.Context, *CreateExperienceRequest) (*CreateExperienceResponse, error)
+	// Admin only.
+	UpdateExperience(context.Context, *UpdateExperienceRequest) (*UpdateExperienceResponse, error)
+	// Admin only.
+	DeleteExperience(context.Context, *DeleteExperienceRequest) (*DeleteExperienceResponse, error)
+	// Admin only.
+	UndeleteExperience(context.Context, *UndeleteExperienceRequest) (*UndeleteExperienceResponse, error)
+	// Educations
+	GetAllEducations(context.Context, *GetAllEducationsRequest) (*GetAllEducationsResponse, error)
+	GetEducation(context.Context, *GetEducationRequest) (*GetEducationResponse, error)
+	BatchGetEducations(context.Context, *BatchGetEducationsRequest) (*BatchGetEducationsResponse, error)
+	// Admin only.
+	CreateEducation(context.Context, *CreateEducationRequest) (*CreateEducationResponse, error)
+	// Admin only.
+	UpdateEducation(context.Context, *UpdateEducationRequest) (*UpdateEducationResponse, error)
+	// Admin only.
+	DeleteEducation(context.Context, *DeleteEducationRequest) (*DeleteEducationResponse, error)
+	// Admin only.
+	UndeleteEducation(context.Context, *UndeleteEducationRequest) (*UndeleteEducationResponse, error)
+	// Search
+	SearchPortfolio(context.Context, *SearchPortfolioRequest) (*SearchPortfolioResponse, error)
+	// Portfolio
+	GetPortfolio(context.Context, *GetPortfolioRequest) (*GetPortfolioResponse, error)
+	// Streams changes to skills, experiences and educations as they happen.
+	WatchPortfolio(*WatchPortfolioRequest, grpc.ServerStreamingServer[WatchPortfolioResponse]) error
+	// Projects
+	GetAllProjects(context.Context, *GetAllProjectsRequest) (*GetAllProjectsResponse, error)
+	GetProject(context.Context, *GetProjectRequest) (*GetProjectResponse, error)
+	// Certifications
+	GetAllCertifications(context.Context, *GetAllCertificationsRequest) (*GetAllCertificationsResponse, error)
+	GetCertification(context.Context, *GetCertificationRequest) (*GetCertificationResponse, error)
+	// Publications
+	GetAllPublications(context.Context, *GetAllPublicationsRequest) (*GetAllPublicationsResponse, error)
+	GetPublication(context.Context, *GetPublicationRequest) (*GetPublicationResponse, error)
+	// Profile
+	GetProfile(context.Context, *GetProfileRequest) (*GetProfileResponse, error)
+	// Admin only.
+	UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileResponse, error)
+	// Testimonials
+	GetAllTestimonials(context.Context, *GetAllTestimonialsRequest) (*GetAllTestimonialsResponse, error)
+	SubmitTestimonial(context.Context, *SubmitTestimonialRequest) (*SubmitTestimonialResponse, error)
+	// Admin only.
+	ApproveTestimonial(context.Context, *ApproveTestimonialRequest) (*ApproveTestimonialResponse, error)
+	// Admin only.
+	RejectTestimonial(context.Context, *RejectTestimonialRequest) (*RejectTestimonialResponse, error)
+	// Contact
+	SubmitContactMessage(context.Context, *SubmitContactMessageRequest) (*SubmitContactMessageResponse, error)
+	// Admin only.
+	ListSkillRevisions(context.Context, *ListSkillRevisionsRequest) (*ListSkillRevisionsResponse, error)
+	// Admin only.
+	RestoreSkillRevision(context.Context, *RestoreSkillRevisionRequest) (*RestoreSkillRevisionResponse, error)
+	// Admin only.
+	ListExperienceRevisions(context.Context, *ListExperienceRevisionsRequest) (*ListExperienceRevisionsResponse, error)
+	// Admin only.
+	RestoreExperienceRevision(context.Context, *RestoreExperienceRevisionRequest) (*RestoreExperienceRevisionResponse, error)
+	// Admin only.
+	ListEducationRevisions(context.Context, *ListEducationRevisionsRequest) (*ListEducationRevisionsResponse, error)
+	// Admin only.
+	RestoreEducationRevision(context.Context, *RestoreEducationRevisionRequest) (*RestoreEducationRevisionResponse, error)
+	// Technology Stats
+	// Years of use per technology, computed from the experience date ranges.
+	GetTechnologyStats(context.Context, *GetTechnologyStatsRequest) (*GetTechnologyStatsResponse, error)
+	// Timeline
+	// Experiences, educations, projects, certifications and publications in
+	// a single chronological stream.
+	GetTimeline(context.Context, *GetTimelineRequest) (*GetTimelineResponse, error)
+	mustEmbedUnimplementedPortfolioServiceServer()
+}
+
+// UnimplementedPortfolioServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPortfolioServiceServer struct{}
+
+func (UnimplementedPortfolioServiceServer) GetAllSkills(context.Context, *GetAllSkillsRequest) (*GetAllSkillsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllSkills not implemented")
+}
+func (UnimplementedPortfolioServiceServer) GetSkill(context.Context, *GetSkillRequest) (*GetSkillResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSkill not implemented")
+}
+func (UnimplementedPortfolioServiceServer) BatchGetSkills(context.Context, *BatchGetSkillsRequest) (*BatchGetSkillsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetSkills not implemented")
+}
+func (UnimplementedPortfolioServiceServer) CreateSkill(context.Context, *CreateSkillRequest) (*CreateSkillResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSkill not implemented")
+}
+func (UnimplementedPortfolioServiceServer) UpdateSkill(context.Context, *UpdateSkillRequest) (*UpdateSkillResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSkill not implemented")
+}
+func (UnimplementedPortfolioServiceServer) DeleteSkill(context.Context, *DeleteSkillRequest) (*DeleteSkillResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSkill not implemented")
+}
+func (UnimplementedPortfolioServiceServer) UndeleteSkill(context.Context, *UndeleteSkillRequest) (*UndeleteSkillResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndeleteSkill not implemented")
+}
+func (UnimplementedPortfolioServiceServer) ListSkillCategories(context.Context, *ListSkillCategoriesRequest) (*ListSkillCategoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSkillCategories not implemented")
+}
+func (UnimplementedPortfolioServiceServer) GetAllExperiences(context.Context, *GetAllExperiencesRequest) (*GetAllExperiencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllExperiences not implemented")
+}
+func (UnimplementedPortfolioServiceServer) GetExperience(context.Context, *GetExperienceRequest) (*GetExperienceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExperience not implemented")
+}
+func (UnimplementedPortfolioServiceServer) BatchGetExperiences(context.Context, *BatchGetExperiencesRequest) (*BatchGetExperiencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetExperiences not implemented")
+}
+func (UnimplementedPortfolioServiceServer) CreateExperience(context.Context, *CreateExperienceRequest) (*CreateExperienceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateExperience not implemented")
+}
+func (UnimplementedPortfolioServiceServer) UpdateExperience(context.Context, *UpdateExperienceRequest) (*UpdateExperienceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateExperience not implemented")
+}
+func (UnimplementedPortfolioServiceServer) DeleteExperience(context.Context, *DeleteExperienceRequest) (*DeleteExperienceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteExperience not implemented")
+}
+func (UnimplementedPortfolioServiceServer) UndeleteExperience(context.Context, *UndeleteExperienceRequest) (*UndeleteExperienceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndeleteExperience not implemented")
+}
+func (UnimplementedPortfolioServiceServer) GetAllEducations(context.Context, *GetAllEducationsRequest) (*GetAllEducationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllEducations not implemented")
+}
+func (UnimplementedPortfolioServiceServer) GetEducation(context.Context, *GetEducationRequest) (*GetEducationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEducation not implemented")
+}
+func (UnimplementedPortfolioServiceServer) BatchGetEducations(context.Context, *BatchGetEducationsRequest) (*BatchGetEducationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetEducations not implemented")
+}
+func (UnimplementedPortfolioServiceServer) CreateEducation(context.Context, *CreateEducationRequest) (*CreateEducationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEducation not implemented")
+}
+func (UnimplementedPortfolioServiceServer) UpdateEducation(context.Context, *UpdateEducationRequest) (*UpdateEducationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEducation not implemented")
+}
+func (UnimplementedPortfolioServiceServer) DeleteEducation(context.Context, *DeleteEducationRequest) (*DeleteEducationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEducation not implemented")
+}
+func (UnimplementedPortfolioServiceServer) UndeleteEducation(context.Context, *UndeleteEducationRequest) (*UndeleteEducationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndeleteEducation not implemented")
+}
+func (UnimplementedPortfolioServiceServer) SearchPortfolio(context.Context, *SearchPortfolioRequest) (*SearchPortfolioResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchPortfolio not implemented")
+}
+func (UnimplementedPortfolioServiceServer) GetPortfolio(context.Context, *GetPortfolioRequest) (*GetPortfolioResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPortfolio not implemented")
+}
+func (UnimplementedPortfolioServiceServer) WatchPortfolio(*WatchPortfolioRequest, grpc.ServerStreamingServer[WatchPortfolioResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchPortfolio not implemented")
+}
+func (UnimplementedPortfolioServiceServer) GetAllProjects(context.Context, *GetAllProjectsRequest) (*GetAllProjectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllProjects not implemented")
+}
+func (UnimplementedPortfolioServiceServer) GetProject(context.Context, *GetProjectRequest) (*GetProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProject not implemented")
+}
+func (UnimplementedPortfolioServiceServer) GetAllCertifications(context.Context, *GetAllCertificationsRequest) (*GetAllCertificationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllCertifications not implemented")
+}
+func (UnimplementedPortfolioServiceServer) GetCertification(context.Context, *GetCertificationRequest) (*GetCertificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCertification not implemented")
+}
+func (UnimplementedPortfolioServiceServer) GetAllPublications(context.Context, *GetAllPublicationsRequest) (*GetAllPublicationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllPublications not implemented")
+}
+func (UnimplementedPortfolioServiceServer) GetPublication(context.Context, *GetPublicationRequest) (*GetPublicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPublication not implemented")
+}
+func (UnimplementedPortfolioServiceServer) GetProfile(context.Context, *GetProfileRequest) (*GetProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProfile not implemented")
+}
+func (UnimplementedPortfolioServiceServer) UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProfile not implemented")
+}
+func (UnimplementedPortfolioServiceServer) GetAllTestimonials(context.Context, *GetAllTestimonialsRequest) (*GetAllTestimonialsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllTestimonials not implemented")
+}
+func (UnimplementedPortfolioServiceServer) SubmitTestimonial(context.Context, *SubmitTestimonialRequest) (*SubmitTestimonialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitTestimonial not implemented")
+}
+func (UnimplementedPortfolioServiceServer) ApproveTestimonial(context.Context, *ApproveTestimonialRequest) (*ApproveTestimonialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveTestimonial not implemented")
+}
+func (UnimplementedPortfolioServiceServer) RejectTestimonial(context.Context, *RejectTestimonialRequest) (*RejectTestimonialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectTestimonial not implemented")
+}
+func (UnimplementedPortfolioServiceServer) SubmitContactMessage(context.Context, *SubmitContactMessageRequest) (*SubmitContactMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitContactMessage not implemented")
+}
+func (UnimplementedPortfolioServiceServer) ListSkillRevisions(context.Context, *ListSkillRevisionsRequest) (*ListSkillRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSkillRevisions not implemented")
+}
+func (UnimplementedPortfolioServiceServer) RestoreSkillRevision(context.Context, *RestoreSkillRevisionRequest) (*RestoreSkillRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreSkillRevision not implemented")
+}
+func (UnimplementedPortfolioServiceServer) ListExperienceRevisions(context.Context, *ListExperienceRevisionsRequest) (*ListExperienceRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExperienceRevisions not implemented")
+}
+func (UnimplementedPortfolioServiceServer) RestoreExperienceRevision(context.Context, *RestoreExperienceRevisionRequest) (*RestoreExperienceRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreExperienceRevision not implemented")
+}
+func (UnimplementedPortfolioServiceServer) ListEducationRevisions(context.Context, *ListEducationRevisionsRequest) (*ListEducationRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEducationRevisions not implemented")
+}
+func (UnimplementedPortfolioServiceServer) RestoreEducationRevision(context.Context, *RestoreEducationRevisionRequest) (*RestoreEducationRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreEducationRevision not implemented")
+}
+func (UnimplementedPortfolioServiceServer) GetTechnologyStats(context.Context, *GetTechnologyStatsRequest) (*GetTechnologyStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTechnologyStats not implemented")
+}
+func (UnimplementedPortfolioServiceServer) GetTimeline(context.Context, *GetTimelineRequest) (*GetTimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTimeline not implemented")
+}
+func (UnimplementedPortfolioServiceServer) mustEmbedUnimplementedPortfolioServiceServer() {}
+func (UnimplementedPortfolioServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafePortfolioServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PortfolioServiceServer will
+// result in compilation errors.
+type UnsafePortfolioServiceServer interface {
+	mustEmbedUnimplementedPortfolioServiceServer()
+}
+
+func RegisterPortfolioServiceServer(s grpc.ServiceRegistrar, srv PortfolioServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPortfolioServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PortfolioService_ServiceDesc, srv)
+}
+
+func _PortfolioService_GetAllSkills_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllSkillsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioServiceServer).GetAllSkills(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioService_GetAllSkills_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioServiceServer).GetAllSkills(ctx, req.(*GetAllSkillsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortfolioService_GetSkill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSkillRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioServiceServer).GetSkill(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioService_GetSkill_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioServiceServer).GetSkill(ctx, req.(*GetSkillRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortfolioService_BatchGetSkills_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetSkillsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioServiceServer).BatchGetSkills(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioService_BatchGetSkills_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioServiceServer).BatchGetSkills(ctx, req.(*BatchGetSkillsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortfolioService_CreateSkill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSkillRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioServiceServer).CreateSkill(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioService_CreateSkill_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioServiceServer).CreateSkill(ctx, req.(*CreateSkillRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortfolioService_UpdateSkill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSkillRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioServiceServer).UpdateSkill(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioService_UpdateSkill_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioServiceServer).UpdateSkill(ctx, req.(*UpdateSkillRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortfolioService_DeleteSkill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSkillRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioServiceServer).DeleteSkill(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioService_DeleteSkill_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioServiceServer).DeleteSkill(ctx, req.(*DeleteSkillRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortfolioService_UndeleteSkill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndeleteSkillRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioServiceServer).UndeleteSkill(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioService_UndeleteSkill_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioServiceServer).UndeleteSkill(ctx, req.(*UndeleteSkillRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortfolioService_ListSkillCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSkillCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioServiceServer).ListSkillCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioService_ListSkillCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioServiceServer).ListSkillCategories(ctx, req.(*ListSkillCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortfolioService_GetAllExperiences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllExperiencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioServiceServer).GetAllExperiences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioService_GetAllExperiences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioServiceServer).GetAllExperiences(ctx, req.(*GetAllExperiencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortfolioService_GetExperience_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExperienceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioServiceServer).GetExperience(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioService_GetExperience_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioServiceServer).GetExperience(ctx, req.(*GetExperienceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortfolioService_BatchGetExperiences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetExperiencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioServiceServer).BatchGetExperiences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioService_BatchGetExperiences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioServiceServer).BatchGetExperiences(ctx, req.(*BatchGetExperiencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortfolioService_CreateExperience_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateExperienceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioServiceServer).CreateExperience(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioService_CreateExperience_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioServiceServer).CreateExperience(ctx, req.(*CreateExperienceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortfolioService_UpdateExperience_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateExperienceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioServiceServer).UpdateExperience(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioService_UpdateExperience_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioServiceServer).UpdateExperience(ctx, req.(*UpdateExperienceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortfolioService_DeleteExperience_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteExperienceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioServiceServer).DeleteExperience(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioService_DeleteExperience_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioServiceServer).DeleteExperience(ctx, req.(*DeleteExperienceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortfolioService_UndeleteExperience_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndeleteExperienceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioServiceServer).UndeleteExperience(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioService_UndeleteExperience_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioServiceServer).UndeleteExperience(ctx, req.(*UndeleteExperienceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortfolioService_GetAllEducations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllEducationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioServiceServer).GetAllEducations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioService_GetAllEducations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioServiceServer).GetAllEducations(ctx, req.(*GetAllEducationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortfolioService_GetEducation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEducationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioServiceServer).GetEducation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioService_GetEducation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioServiceServer).GetEducation(ctx, req.(*GetEducationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortfolioService_BatchGetEducations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetEducationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioServiceServer).BatchGetEducations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioService_BatchGetEducations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioServiceServer).BatchGetEducations(ctx, req.(*BatchGetEducationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortfolioService_CreateEducation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEducationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioServiceServer).CreateEducation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioService_CreateEducation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioServiceServer).CreateEducation(ctx, req.(*CreateEducationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortfolioService_UpdateEducation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEducationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioServiceServer).UpdateEducation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioService_UpdateEducation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioServiceServer).UpdateEducation(ctx, req.(*UpdateEducationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortfolioService_DeleteEducation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEducationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioServiceServer).DeleteEducation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioService_DeleteEducation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioServiceServer).DeleteEducation(ctx, req.(*DeleteEducationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortfolioService_UndeleteEducation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndeleteEducationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioServiceServer).UndeleteEducation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioService_UndeleteEducation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioServiceServer).UndeleteEducation(ctx, req.(*UndeleteEducationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortfolioService_SearchPortfolio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchPortfolioRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioServiceServer).SearchPortfolio(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioService_SearchPortfolio_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioServiceServer).SearchPortfolio(ctx, req.(*SearchPortfolioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortfolioService_GetPortfolio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPortfolioRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioServiceServer).GetPortfolio(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioService_GetPortfolio_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioServiceServer).GetPortfolio(ctx, req.(*GetPortfolioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortfolioService_WatchPortfolio_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchPortfolioRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PortfolioServiceServer).WatchPortfolio(m, &grpc.GenericServerStream[WatchPortfolioRequest, WatchPortfolioResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PortfolioService_WatchPortfolioServer = grpc.ServerStreamingServer[WatchPortfolioResponse]
+
+func _PortfolioService_GetAllProjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllProjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioServiceServer).GetAllProjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioService_GetAllProjects_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioServiceServer).GetAllProjects(ctx, req.(*GetAllProjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortfolioService_GetProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioServiceServer).GetProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioService_GetProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioServiceServer).GetProject(ctx, req.(*GetProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortfolioService_GetAllCertifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllCertificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioServiceServer).GetAllCertifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioService_GetAllCertifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioServiceServer).GetAllCertifications(ctx, req.(*GetAllCertificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortfolioService_GetCertification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCertificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioServiceServer).GetCertification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioService_GetCertification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioServiceServer).GetCertification(ctx, req.(*GetCertificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortfolioService_GetAllPublications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllPublicationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioServiceServer).GetAllPublications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioService_GetAllPublications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioServiceServer).GetAllPublications(ctx, req.(*GetAllPublicationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortfolioService_GetPublication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPublicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioServiceServer).GetPublication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioService_GetPublication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioServiceServer).GetPublication(ctx, req.(*GetPublicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortfolioService_GetProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioServiceServer).GetProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioService_GetProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioServiceServer).GetProfile(ctx, req.(*GetProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortfolioService_UpdateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioServiceServer).UpdateProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioService_UpdateProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioServiceServer).UpdateProfile(ctx, req.(*UpdateProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *portfolioServiceClient) GetExperience(ctx context.Context, in *GetExperienceRequest, opts ...grpc.CallOption) (*GetExperienceResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetExperienceResponse)
-	err := c.cc.Invoke(ctx, PortfolioService_GetExperience_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _PortfolioService_GetAllTestimonials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllTestimonialsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PortfolioServiceServer).GetAllTestimonials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioService_GetAllTestimonials_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioServiceServer).GetAllTestimonials(ctx, req.(*GetAllTestimonialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *portfolioServiceClient) GetAllEducations(ctx context.Context, in *GetAllEducationsRequest, opts ...grpc.CallOption) (*GetAllEducationsResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetAllEducationsResponse)
-	err := c.cc.Invoke(ctx, PortfolioService_GetAllEducations_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _PortfolioService_SubmitTestimonial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitTestimonialRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PortfolioServiceServer).SubmitTestimonial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioService_SubmitTestimonial_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioServiceServer).SubmitTestimonial(ctx, req.(*SubmitTestimonialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *portfolioServiceClient) GetEducation(ctx context.Context, in *GetEducationRequest, opts ...grpc.CallOption) (*GetEducationResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetEducationResponse)
-	err := c.cc.Invoke(ctx, PortfolioService_GetEducation_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _PortfolioService_ApproveTestimonial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveTestimonialRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PortfolioServiceServer).ApproveTestimonial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioService_ApproveTestimonial_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioServiceServer).ApproveTestimonial(ctx, req.(*ApproveTestimonialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// PortfolioServiceServer is the server API for PortfolioService service.
-// All implementations must embed UnimplementedPortfolioServiceServer
-// for forward compatibility.
-type PortfolioServiceServer interface {
-	// Skills
-	GetAllSkills(context.Context, *GetAllSkillsRequest) (*GetAllSkillsResponse, error)
-	GetSkill(context.Context, *GetSkillRequest) (*GetSkillResponse, error)
-	// Experiences
-	GetAllExperiences(context.Context, *GetAllExperiencesRequest) (*GetAllExperiencesResponse, error)
-	GetExperience(context.Context, *GetExperienceRequest) (*GetExperienceResponse, error)
-	// Educations
-	GetAllEducations(context.Context, *GetAllEducationsRequest) (*GetAllEducationsResponse, error)
-	GetEducation(context.Context, *GetEducationRequest) (*GetEducationResponse, error)
-	mustEmbedUnimplementedPortfolioServiceServer()
+func _PortfolioService_RejectTestimonial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectTestimonialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioServiceServer).RejectTestimonial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioService_RejectTestimonial_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioServiceServer).RejectTestimonial(ctx, req.(*RejectTestimonialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnimplementedPortfolioServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedPortfolioServiceServer struct{}
-
-func (UnimplementedPortfolioServiceServer) GetAllSkills(context.Context, *GetAllSkillsRequest) (*GetAllSkillsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllSkills not implemented")
-}
-func (UnimplementedPortfolioServiceServer) GetSkill(context.Context, *GetSkillRequest) (*GetSkillResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSkill not implemented")
-}
-func (UnimplementedPortfolioServiceServer) GetAllExperiences(context.Context, *GetAllExperiencesRequest) (*GetAllExperiencesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllExperiences not implemented")
-}
-func (UnimplementedPortfolioServiceServer) GetExperience(context.Context, *GetExperienceRequest) (*GetExperienceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetExperience not implemented")
-}
-func (UnimplementedPortfolioServiceServer) GetAllEducations(context.Context, *GetAllEducationsRequest) (*GetAllEducationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllEducations not implemented")
-}
-func (UnimplementedPortfolioServiceServer) GetEducation(context.Context, *GetEducationRequest) (*GetEducationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetEducation not implemented")
+func _PortfolioService_SubmitContactMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitContactMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioServiceServer).SubmitContactMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioService_SubmitContactMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioServiceServer).SubmitContactMessage(ctx, req.(*SubmitContactMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedPortfolioServiceServer) mustEmbedUnimplementedPortfolioServiceServer() {}
-func (UnimplementedPortfolioServiceServer) testEmbeddedByValue()                          {}
 
-// UnsafePortfolioServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to PortfolioServiceServer will
-// result in compilation errors.
-type UnsafePortfolioServiceServer interface {
-	mustEmbedUnimplementedPortfolioServiceServer()
+func _PortfolioService_ListSkillRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSkillRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioServiceServer).ListSkillRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioService_ListSkillRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioServiceServer).ListSkillRevisions(ctx, req.(*ListSkillRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterPortfolioServiceServer(s grpc.ServiceRegistrar, srv PortfolioServiceServer) {
-	// If the following call pancis, it indicates UnimplementedPortfolioServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _PortfolioService_RestoreSkillRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreSkillRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&PortfolioService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(PortfolioServiceServer).RestoreSkillRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioService_RestoreSkillRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioServiceServer).RestoreSkillRevision(ctx, req.(*RestoreSkillRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _PortfolioService_GetAllSkills_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllSkillsRequest)
+func _PortfolioService_ListExperienceRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExperienceRevisionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PortfolioServiceServer).GetAllSkills(ctx, in)
+		return srv.(PortfolioServiceServer).ListExperienceRevisions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PortfolioService_GetAllSkills_FullMethodName,
+		FullMethod: PortfolioService_ListExperienceRevisions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PortfolioServiceServer).GetAllSkills(ctx, req.(*GetAllSkillsRequest))
+		return srv.(PortfolioServiceServer).ListExperienceRevisions(ctx, req.(*ListExperienceRevisionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PortfolioService_GetSkill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSkillRequest)
+func _PortfolioService_RestoreExperienceRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreExperienceRevisionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PortfolioServiceServer).GetSkill(ctx, in)
+		return srv.(PortfolioServiceServer).RestoreExperienceRevision(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PortfolioService_GetSkill_FullMethodName,
+		FullMethod: PortfolioService_RestoreExperienceRevision_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PortfolioServiceServer).GetSkill(ctx, req.(*GetSkillRequest))
+		return srv.(PortfolioServiceServer).RestoreExperienceRevision(ctx, req.(*RestoreExperienceRevisionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PortfolioService_GetAllExperiences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllExperiencesRequest)
+func _PortfolioService_ListEducationRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEducationRevisionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PortfolioServiceServer).GetAllExperiences(ctx, in)
+		return srv.(PortfolioServiceServer).ListEducationRevisions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PortfolioService_GetAllExperiences_FullMethodName,
+		FullMethod: PortfolioService_ListEducationRevisions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PortfolioServiceServer).GetAllExperiences(ctx, req.(*GetAllExperiencesRequest))
+		return srv.(PortfolioServiceServer).ListEducationRevisions(ctx, req.(*ListEducationRevisionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PortfolioService_GetExperience_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetExperienceRequest)
+func _PortfolioService_RestoreEducationRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreEducationRevisionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PortfolioServiceServer).GetExperience(ctx, in)
+		return srv.(PortfolioServiceServer).RestoreEducationRevision(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PortfolioService_GetExperience_FullMethodName,
+		FullMethod: PortfolioService_RestoreEducationRevision_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PortfolioServiceServer).GetExperience(ctx, req.(*GetExperienceRequest))
+		return srv.(PortfolioServiceServer).RestoreEducationRevision(ctx, req.(*RestoreEducationRevisionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PortfolioService_GetAllEducations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllEducationsRequest)
+func _PortfolioService_GetTechnologyStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTechnologyStatsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PortfolioServiceServer).GetAllEducations(ctx, in)
+		return srv.(PortfolioServiceServer).GetTechnologyStats(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PortfolioService_GetAllEducations_FullMethodName,
+		FullMethod: PortfolioService_GetTechnologyStats_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PortfolioServiceServer).GetAllEducations(ctx, req.(*GetAllEducationsRequest))
+		return srv.(PortfolioServiceServer).GetTechnologyStats(ctx, req.(*GetTechnologyStatsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PortfolioService_GetEducation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetEducationRequest)
+func _PortfolioService_GetTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTimelineRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PortfolioServiceServer).GetEducation(ctx, in)
+		return srv.(PortfolioServiceServer).GetTimeline(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PortfolioService_GetEducation_FullMethodName,
+		FullMethod: PortfolioService_GetTimeline_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PortfolioServiceServer).GetEducation(ctx, req.(*GetEducationRequest))
+		return srv.(PortfolioServiceServer).GetTimeline(ctx, req.(*GetTimelineRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -295,6 +1727,30 @@ var PortfolioService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSkill",
 			Handler:    _PortfolioService_GetSkill_Handler,
 		},
+		{
+			MethodName: "BatchGetSkills",
+			Handler:    _PortfolioService_BatchGetSkills_Handler,
+		},
+		{
+			MethodName: "CreateSkill",
+			Handler:    _PortfolioService_CreateSkill_Handler,
+		},
+		{
+			MethodName: "UpdateSkill",
+			Handler:    _PortfolioService_UpdateSkill_Handler,
+		},
+		{
+			MethodName: "DeleteSkill",
+			Handler:    _PortfolioService_DeleteSkill_Handler,
+		},
+		{
+			MethodName: "UndeleteSkill",
+			Handler:    _PortfolioService_UndeleteSkill_Handler,
+		},
+		{
+			MethodName: "ListSkillCategories",
+			Handler:    _PortfolioService_ListSkillCategories_Handler,
+		},
 		{
 			MethodName: "GetAllExperiences",
 			Handler:    _PortfolioService_GetAllExperiences_Handler,
@@ -303,6 +1759,26 @@ var PortfolioService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetExperience",
 			Handler:    _PortfolioService_GetExperience_Handler,
 		},
+		{
+			MethodName: "BatchGetExperiences",
+			Handler:    _PortfolioService_BatchGetExperiences_Handler,
+		},
+		{
+			MethodName: "CreateExperience",
+			Handler:    _PortfolioService_CreateExperience_Handler,
+		},
+		{
+			MethodName: "UpdateExperience",
+			Handler:    _PortfolioService_UpdateExperience_Handler,
+		},
+		{
+			MethodName: "DeleteExperience",
+			Handler:    _PortfolioService_DeleteExperience_Handler,
+		},
+		{
+			MethodName: "UndeleteExperience",
+			Handler:    _PortfolioService_UndeleteExperience_Handler,
+		},
 		{
 			MethodName: "GetAllEducations",
 			Handler:    _PortfolioService_GetAllEducations_Handler,
@@ -311,7 +1787,125 @@ var PortfolioService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetEducation",
 			Handler:    _PortfolioService_GetEducation_Handler,
 		},
+		{
+			MethodName: "BatchGetEducations",
+			Handler:    _PortfolioService_BatchGetEducations_Handler,
+		},
+		{
+			MethodName: "CreateEducation",
+			Handler:    _PortfolioService_CreateEducation_Handler,
+		},
+		{
+			MethodName: "UpdateEducation",
+			Handler:    _PortfolioService_UpdateEducation_Handler,
+		},
+		{
+			MethodName: "DeleteEducation",
+			Handler:    _PortfolioService_DeleteEducation_Handler,
+		},
+		{
+			MethodName: "UndeleteEducation",
+			Handler:    _PortfolioService_UndeleteEducation_Handler,
+		},
+		{
+			MethodName: "SearchPortfolio",
+			Handler:    _PortfolioService_SearchPortfolio_Handler,
+		},
+		{
+			MethodName: "GetPortfolio",
+			Handler:    _PortfolioService_GetPortfolio_Handler,
+		},
+		{
+			MethodName: "GetAllProjects",
+			Handler:    _PortfolioService_GetAllProjects_Handler,
+		},
+		{
+			MethodName: "GetProject",
+			Handler:    _PortfolioService_GetProject_Handler,
+		},
+		{
+			MethodName: "GetAllCertifications",
+			Handler:    _PortfolioService_GetAllCertifications_Handler,
+		},
+		{
+			MethodName: "GetCertification",
+			Handler:    _PortfolioService_GetCertification_Handler,
+		},
+		{
+			MethodName: "GetAllPublications",
+			Handler:    _PortfolioService_GetAllPublications_Handler,
+		},
+		{
+			MethodName: "GetPublication",
+			Handler:    _PortfolioService_GetPublication_Handler,
+		},
+		{
+			MethodName: "GetProfile",
+			Handler:    _PortfolioService_GetProfile_Handler,
+		},
+		{
+			MethodName: "UpdateProfile",
+			Handler:    _PortfolioService_UpdateProfile_Handler,
+		},
+		{
+			MethodName: "GetAllTestimonials",
+			Handler:    _PortfolioService_GetAllTestimonials_Handler,
+		},
+		{
+			MethodName: "SubmitTestimonial",
+			Handler:    _PortfolioService_SubmitTestimonial_Handler,
+		},
+		{
+			MethodName: "ApproveTestimonial",
+			Handler:    _PortfolioService_ApproveTestimonial_Handler,
+		},
+		{
+			MethodName: "RejectTestimonial",
+			Handler:    _PortfolioService_RejectTestimonial_Handler,
+		},
+		{
+			MethodName: "SubmitContactMessage",
+			Handler:    _PortfolioService_SubmitContactMessage_Handler,
+		},
+		{
+			MethodName: "ListSkillRevisions",
+			Handler:    _PortfolioService_ListSkillRevisions_Handler,
+		},
+		{
+			MethodName: "RestoreSkillRevision",
+			Handler:    _PortfolioService_RestoreSkillRevision_Handler,
+		},
+		{
+			MethodName: "ListExperienceRevisions",
+			Handler:    _PortfolioService_ListExperienceRevisions_Handler,
+		},
+		{
+			MethodName: "RestoreExperienceRevision",
+			Handler:    _PortfolioService_RestoreExperienceRevision_Handler,
+		},
+		{
+			MethodName: "ListEducationRevisions",
+			Handler:    _PortfolioService_ListEducationRevisions_Handler,
+		},
+		{
+			MethodName: "RestoreEducationRevision",
+			Handler:    _PortfolioService_RestoreEducationRevision_Handler,
+		},
+		{
+			MethodName: "GetTechnologyStats",
+			Handler:    _PortfolioService_GetTechnologyStats_Handler,
+		},
+		{
+			MethodName: "GetTimeline",
+			Handler:    _PortfolioService_GetTimeline_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchPortfolio",
+			Handler:       _PortfolioService_WatchPortfolio_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "jorgejr568/portfolio_grpc/api.proto",
 }
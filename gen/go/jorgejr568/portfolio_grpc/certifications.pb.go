@@ -0,0 +1,485 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: jorgejr568/portfolio_grpc/certifications.proto
+
+package portfolio_grpc
+
+import (
+	date "google.golang.org/genproto/googleapis/type/date"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Certification struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title           string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Issuer          string                 `protobuf:"bytes,3,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	CredentialId    string                 `protobuf:"bytes,4,opt,name=credential_id,json=credentialId,proto3" json:"credential_id,omitempty"`
+	VerificationUrl string                 `protobuf:"bytes,5,opt,name=verification_url,json=verificationUrl,proto3" json:"verification_url,omitempty"`
+	IssuedAt        *date.Date             `protobuf:"bytes,6,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`
+	// Unset for certifications that do not expire.
+	ExpiresAt *date.Date `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// Output only. Whether the certification expires within the next 90 days.
+	ExpiresSoon   bool                   `protobuf:"varint,8,opt,name=expires_soon,json=expiresSoon,proto3" json:"expires_soon,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Certification) Reset() {
+	*x = Certification{}
+	mi := &file_jorgejr568_portfolio_grpc_certifications_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Certification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Certification) ProtoMessage() {}
+
+func (x *Certification) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_certifications_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Certification.ProtoReflect.Descriptor instead.
+func (*Certification) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_certifications_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Certification) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Certification) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Certification) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *Certification) GetCredentialId() string {
+	if x != nil {
+		return x.CredentialId
+	}
+	return ""
+}
+
+func (x *Certification) GetVerificationUrl() string {
+	if x != nil {
+		return x.VerificationUrl
+	}
+	return ""
+}
+
+func (x *Certification) GetIssuedAt() *date.Date {
+	if x != nil {
+		return x.IssuedAt
+	}
+	return nil
+}
+
+func (x *Certification) GetExpiresAt() *date.Date {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Certification) GetExpiresSoon() bool {
+	if x != nil {
+		return x.ExpiresSoon
+	}
+	return false
+}
+
+func (x *Certification) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Certification) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type GetAllCertificationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Maximum number of results to return. Defaults to 50 and is capped at 1000.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token of a previous response, to fetch the following page.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// AIP-160 filter, e.g. `issuer:"aws"`.
+	Filter string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Comma separated fields to sort by, each optionally followed by "desc",
+	// e.g. `issued_at desc`.
+	OrderBy string `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// Leave out certifications whose expiry date has passed.
+	ExcludeExpired bool `protobuf:"varint,5,opt,name=exclude_expired,json=excludeExpired,proto3" json:"exclude_expired,omitempty"`
+	// Fields of each certification to return. Every field is returned when unset.
+	ReadMask      *fieldmaskpb.FieldMask `protobuf:"bytes,6,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllCertificationsRequest) Reset() {
+	*x = GetAllCertificationsRequest{}
+	mi := &file_jorgejr568_portfolio_grpc_certifications_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllCertificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllCertificationsRequest) ProtoMessage() {}
+
+func (x *GetAllCertificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_certifications_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllCertificationsRequest.ProtoReflect.Descriptor instead.
+func (*GetAllCertificationsRequest) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_certifications_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetAllCertificationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetAllCertificationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetAllCertificationsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *GetAllCertificationsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *GetAllCertificationsRequest) GetExcludeExpired() bool {
+	if x != nil {
+		return x.ExcludeExpired
+	}
+	return false
+}
+
+func (x *GetAllCertificationsRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+type GetAllCertificationsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Certifications []*Certification       `protobuf:"bytes,1,rep,name=certifications,proto3" json:"certifications,omitempty"`
+	// Token for the next page, empty when there are no more results.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllCertificationsResponse) Reset() {
+	*x = GetAllCertificationsResponse{}
+	mi := &file_jorgejr568_portfolio_grpc_certifications_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllCertificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllCertificationsResponse) ProtoMessage() {}
+
+func (x *GetAllCertificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_certifications_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllCertificationsResponse.ProtoReflect.Descriptor instead.
+func (*GetAllCertificationsResponse) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_certifications_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetAllCertificationsResponse) GetCertifications() []*Certification {
+	if x != nil {
+		return x.Certifications
+	}
+	return nil
+}
+
+func (x *GetAllCertificationsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetCertificationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Fields of the certification to return. Every field is returned when unset.
+	ReadMask      *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCertificationRequest) Reset() {
+	*x = GetCertificationRequest{}
+	mi := &file_jorgejr568_portfolio_grpc_certifications_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCertificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCertificationRequest) ProtoMessage() {}
+
+func (x *GetCertificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_certifications_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCertificationRequest.ProtoReflect.Descriptor instead.
+func (*GetCertificationRequest) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_certifications_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetCertificationRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetCertificationRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+type GetCertificationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Certification *Certification         `protobuf:"bytes,1,opt,name=certification,proto3" json:"certification,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCertificationResponse) Reset() {
+	*x = GetCertificationResponse{}
+	mi := &file_jorgejr568_portfolio_grpc_certifications_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCertificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCertificationResponse) ProtoMessage() {}
+
+func (x *GetCertificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_certifications_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCertificationResponse.ProtoReflect.Descriptor instead.
+func (*GetCertificationResponse) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_certifications_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetCertificationResponse) GetCertification() *Certification {
+	if x != nil {
+		return x.Certification
+	}
+	return nil
+}
+
+var File_jorgejr568_portfolio_grpc_certifications_proto protoreflect.FileDescriptor
+
+const file_jorgejr568_portfolio_grpc_certifications_proto_rawDesc = "" +
+	"\n" +
+	".jorgejr568/portfolio_grpc/certifications.proto\x12\x19jorgejr568.portfolio_grpc\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16google/type/date.proto\"\x98\x03\n" +
+	"\rCertification\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x16\n" +
+	"\x06issuer\x18\x03 \x01(\tR\x06issuer\x12#\n" +
+	"\rcredential_id\x18\x04 \x01(\tR\fcredentialId\x12)\n" +
+	"\x10verification_url\x18\x05 \x01(\tR\x0fverificationUrl\x12.\n" +
+	"\tissued_at\x18\x06 \x01(\v2\x11.google.type.DateR\bissuedAt\x120\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\v2\x11.google.type.DateR\texpiresAt\x12!\n" +
+	"\fexpires_soon\x18\b \x01(\bR\vexpiresSoon\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xee\x01\n" +
+	"\x1bGetAllCertificationsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06filter\x18\x03 \x01(\tR\x06filter\x12\x19\n" +
+	"\border_by\x18\x04 \x01(\tR\aorderBy\x12'\n" +
+	"\x0fexclude_expired\x18\x05 \x01(\bR\x0eexcludeExpired\x127\n" +
+	"\tread_mask\x18\x06 \x01(\v2\x1a.google.protobuf.FieldMaskR\breadMask\"\x98\x01\n" +
+	"\x1cGetAllCertificationsResponse\x12P\n" +
+	"\x0ecertifications\x18\x01 \x03(\v2(.jorgejr568.portfolio_grpc.CertificationR\x0ecertifications\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"b\n" +
+	"\x17GetCertificationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x127\n" +
+	"\tread_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\breadMask\"j\n" +
+	"\x18GetCertificationResponse\x12N\n" +
+	"\rcertification\x18\x01 \x01(\v2(.jorgejr568.portfolio_grpc.CertificationR\rcertificationB\xfc\x01\n" +
+	"\x1dcom.jorgejr568.portfolio_grpcB\x13CertificationsProtoP\x01ZEgithub.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc\xa2\x02\x03JPX\xaa\x02\x18Jorgejr568.PortfolioGrpc\xca\x02\x18Jorgejr568\\PortfolioGrpc\xe2\x02$Jorgejr568\\PortfolioGrpc\\GPBMetadata\xea\x02\x19Jorgejr568::PortfolioGrpcb\x06proto3"
+
+var (
+	file_jorgejr568_portfolio_grpc_certifications_proto_rawDescOnce sync.Once
+	file_jorgejr568_portfolio_grpc_certifications_proto_rawDescData []byte
+)
+
+func file_jorgejr568_portfolio_grpc_certifications_proto_rawDescGZIP() []byte {
+	file_jorgejr568_portfolio_grpc_certifications_proto_rawDescOnce.Do(func() {
+		file_jorgejr568_portfolio_grpc_certifications_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_jorgejr568_portfolio_grpc_certifications_proto_rawDesc), len(file_jorgejr568_portfolio_grpc_certifications_proto_rawDesc)))
+	})
+	return file_jorgejr568_portfolio_grpc_certifications_proto_rawDescData
+}
+
+var file_jorgejr568_portfolio_grpc_certifications_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_jorgejr568_portfolio_grpc_certifications_proto_goTypes = []any{
+	(*Certification)(nil),                // 0: jorgejr568.portfolio_grpc.Certification
+	(*GetAllCertificationsRequest)(nil),  // 1: jorgejr568.portfolio_grpc.GetAllCertificationsRequest
+	(*GetAllCertificationsResponse)(nil), // 2: jorgejr568.portfolio_grpc.GetAllCertificationsResponse
+	(*GetCertificationRequest)(nil),      // 3: jorgejr568.portfolio_grpc.GetCertificationRequest
+	(*GetCertificationResponse)(nil),     // 4: jorgejr568.portfolio_grpc.GetCertificationResponse
+	(*date.Date)(nil),                    // 5: google.type.Date
+	(*timestamppb.Timestamp)(nil),        // 6: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),        // 7: google.protobuf.FieldMask
+}
+var file_jorgejr568_portfolio_grpc_certifications_proto_depIdxs = []int32{
+	5, // 0: jorgejr568.portfolio_grpc.Certification.issued_at:type_name -> google.type.Date
+	5, // 1: jorgejr568.portfolio_grpc.Certification.expires_at:type_name -> google.type.Date
+	6, // 2: jorgejr568.portfolio_grpc.Certification.created_at:type_name -> google.protobuf.Timestamp
+	6, // 3: jorgejr568.portfolio_grpc.Certification.updated_at:type_name -> google.protobuf.Timestamp
+	7, // 4: jorgejr568.portfolio_grpc.GetAllCertificationsRequest.read_mask:type_name -> google.protobuf.FieldMask
+	0, // 5: jorgejr568.portfolio_grpc.GetAllCertificationsResponse.certifications:type_name -> jorgejr568.portfolio_grpc.Certification
+	7, // 6: jorgejr568.portfolio_grpc.GetCertificationRequest.read_mask:type_name -> google.protobuf.FieldMask
+	0, // 7: jorgejr568.portfolio_grpc.GetCertificationResponse.certification:type_name -> jorgejr568.portfolio_grpc.Certification
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_jorgejr568_portfolio_grpc_certifications_proto_init() }
+func file_jorgejr568_portfolio_grpc_certifications_proto_init() {
+	if File_jorgejr568_portfolio_grpc_certifications_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_jorgejr568_portfolio_grpc_certifications_proto_rawDesc), len(file_jorgejr568_portfolio_grpc_certifications_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_jorgejr568_portfolio_grpc_certifications_proto_goTypes,
+		DependencyIndexes: file_jorgejr568_portfolio_grpc_certifications_proto_depIdxs,
+		MessageInfos:      file_jorgejr568_portfolio_grpc_certifications_proto_msgTypes,
+	}.Build()
+	File_jorgejr568_portfolio_grpc_certifications_proto = out.File
+	file_jorgejr568_portfolio_grpc_certifications_proto_goTypes = nil
+	file_jorgejr568_portfolio_grpc_certifications_proto_depIdxs = nil
+}
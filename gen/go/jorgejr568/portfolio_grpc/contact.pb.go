@@ -0,0 +1,317 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: jorgejr568/portfolio_grpc/contact.proto
+
+package portfolio_grpc
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ContactMessage is a message left through the contact form. It is stored
+// for the owner and never served back by the API.
+type ContactMessage struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Email     string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Subject   string                 `protobuf:"bytes,4,opt,name=subject,proto3" json:"subject,omitempty"`
+	Message   string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	SenderIp  string                 `protobuf:"bytes,6,opt,name=sender_ip,json=senderIp,proto3" json:"sender_ip,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Unset until the notification email has been accepted by the relay.
+	DeliveredAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=delivered_at,json=deliveredAt,proto3" json:"delivered_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContactMessage) Reset() {
+	*x = ContactMessage{}
+	mi := &file_jorgejr568_portfolio_grpc_contact_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContactMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContactMessage) ProtoMessage() {}
+
+func (x *ContactMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_contact_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContactMessage.ProtoReflect.Descriptor instead.
+func (*ContactMessage) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_contact_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ContactMessage) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ContactMessage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ContactMessage) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ContactMessage) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *ContactMessage) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ContactMessage) GetSenderIp() string {
+	if x != nil {
+		return x.SenderIp
+	}
+	return ""
+}
+
+func (x *ContactMessage) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ContactMessage) GetDeliveredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeliveredAt
+	}
+	return nil
+}
+
+type SubmitContactMessageRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Name    string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Email   string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Subject string                 `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	Message string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	// Honeypot. Rendered as a hidden form field that people leave empty, so a
+	// value means the form was filled in by a bot.
+	Website       string `protobuf:"bytes,5,opt,name=website,proto3" json:"website,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitContactMessageRequest) Reset() {
+	*x = SubmitContactMessageRequest{}
+	mi := &file_jorgejr568_portfolio_grpc_contact_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitContactMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitContactMessageRequest) ProtoMessage() {}
+
+func (x *SubmitContactMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_contact_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitContactMessageRequest.ProtoReflect.Descriptor instead.
+func (*SubmitContactMessageRequest) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_contact_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SubmitContactMessageRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SubmitContactMessageRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *SubmitContactMessageRequest) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *SubmitContactMessageRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SubmitContactMessageRequest) GetWebsite() string {
+	if x != nil {
+		return x.Website
+	}
+	return ""
+}
+
+type SubmitContactMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitContactMessageResponse) Reset() {
+	*x = SubmitContactMessageResponse{}
+	mi := &file_jorgejr568_portfolio_grpc_contact_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitContactMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitContactMessageResponse) ProtoMessage() {}
+
+func (x *SubmitContactMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_contact_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitContactMessageResponse.ProtoReflect.Descriptor instead.
+func (*SubmitContactMessageResponse) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_contact_proto_rawDescGZIP(), []int{2}
+}
+
+var File_jorgejr568_portfolio_grpc_contact_proto protoreflect.FileDescriptor
+
+const file_jorgejr568_portfolio_grpc_contact_proto_rawDesc = "" +
+	"\n" +
+	"'jorgejr568/portfolio_grpc/contact.proto\x12\x19jorgejr568.portfolio_grpc\x1a\x1fgoogle/protobuf/timestamp.proto\"\x95\x02\n" +
+	"\x0eContactMessage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x18\n" +
+	"\asubject\x18\x04 \x01(\tR\asubject\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\x12\x1b\n" +
+	"\tsender_ip\x18\x06 \x01(\tR\bsenderIp\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12=\n" +
+	"\fdelivered_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vdeliveredAt\"\x95\x01\n" +
+	"\x1bSubmitContactMessageRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x18\n" +
+	"\asubject\x18\x03 \x01(\tR\asubject\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\x12\x18\n" +
+	"\awebsite\x18\x05 \x01(\tR\awebsite\"\x1e\n" +
+	"\x1cSubmitContactMessageResponseB\xf5\x01\n" +
+	"\x1dcom.jorgejr568.portfolio_grpcB\fContactProtoP\x01ZEgithub.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc\xa2\x02\x03JPX\xaa\x02\x18Jorgejr568.PortfolioGrpc\xca\x02\x18Jorgejr568\\PortfolioGrpc\xe2\x02$Jorgejr568\\PortfolioGrpc\\GPBMetadata\xea\x02\x19Jorgejr568::PortfolioGrpcb\x06proto3"
+
+var (
+	file_jorgejr568_portfolio_grpc_contact_proto_rawDescOnce sync.Once
+	file_jorgejr568_portfolio_grpc_contact_proto_rawDescData []byte
+)
+
+func file_jorgejr568_portfolio_grpc_contact_proto_rawDescGZIP() []byte {
+	file_jorgejr568_portfolio_grpc_contact_proto_rawDescOnce.Do(func() {
+		file_jorgejr568_portfolio_grpc_contact_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_jorgejr568_portfolio_grpc_contact_proto_rawDesc), len(file_jorgejr568_portfolio_grpc_contact_proto_rawDesc)))
+	})
+	return file_jorgejr568_portfolio_grpc_contact_proto_rawDescData
+}
+
+var file_jorgejr568_portfolio_grpc_contact_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_jorgejr568_portfolio_grpc_contact_proto_goTypes = []any{
+	(*ContactMessage)(nil),               // 0: jorgejr568.portfolio_grpc.ContactMessage
+	(*SubmitContactMessageRequest)(nil),  // 1: jorgejr568.portfolio_grpc.SubmitContactMessageRequest
+	(*SubmitContactMessageResponse)(nil), // 2: jorgejr568.portfolio_grpc.SubmitContactMessageResponse
+	(*timestamppb.Timestamp)(nil),        // 3: google.protobuf.Timestamp
+}
+var file_jorgejr568_portfolio_grpc_contact_proto_depIdxs = []int32{
+	3, // 0: jorgejr568.portfolio_grpc.ContactMessage.created_at:type_name -> google.protobuf.Timestamp
+	3, // 1: jorgejr568.portfolio_grpc.ContactMessage.delivered_at:type_name -> google.protobuf.Timestamp
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_jorgejr568_portfolio_grpc_contact_proto_init() }
+func file_jorgejr568_portfolio_grpc_contact_proto_init() {
+	if File_jorgejr568_portfolio_grpc_contact_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_jorgejr568_portfolio_grpc_contact_proto_rawDesc), len(file_jorgejr568_portfolio_grpc_contact_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_jorgejr568_portfolio_grpc_contact_proto_goTypes,
+		DependencyIndexes: file_jorgejr568_portfolio_grpc_contact_proto_depIdxs,
+		MessageInfos:      file_jorgejr568_portfolio_grpc_contact_proto_msgTypes,
+	}.Build()
+	File_jorgejr568_portfolio_grpc_contact_proto = out.File
+	file_jorgejr568_portfolio_grpc_contact_proto_goTypes = nil
+	file_jorgejr568_portfolio_grpc_contact_proto_depIdxs = nil
+}
@@ -10,6 +10,7 @@ import (
 	date "google.golang.org/genproto/googleapis/type/date"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -24,14 +25,24 @@ const (
 )
 
 type Education struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Institution   *Education_Institution `protobuf:"bytes,3,opt,name=institution,proto3" json:"institution,omitempty"`
-	StartedAt     *date.Date             `protobuf:"bytes,4,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
-	EndedAt       *date.Date             `protobuf:"bytes,5,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title       string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Institution *Education_Institution `protobuf:"bytes,3,opt,name=institution,proto3" json:"institution,omitempty"`
+	StartedAt   *date.Date             `protobuf:"bytes,4,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	EndedAt     *date.Date             `protobuf:"bytes,5,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Output only. Locales the translatable fields are available in. The
+	// fields are served in the one that best matches the Accept-Language of
+	// the request.
+	AvailableLocales []string `protobuf:"bytes,8,rep,name=available_locales,json=availableLocales,proto3" json:"available_locales,omitempty"`
+	// Output only. When the education was deleted; unset unless it is deleted.
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	// Whether the education is public. Defaults to VISIBILITY_PUBLISHED.
+	Visibility Visibility `protobuf:"varint,10,opt,name=visibility,proto3,enum=jorgejr568.portfolio_grpc.Visibility" json:"visibility,omitempty"`
+	// When set, a published education only becomes public at this time.
+	PublishAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -115,10 +126,56 @@ func (x *Education) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Education) GetAvailableLocales() []string {
+	if x != nil {
+		return x.AvailableLocales
+	}
+	return nil
+}
+
+func (x *Education) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Education) GetVisibility() Visibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return Visibility_VISIBILITY_UNSPECIFIED
+}
+
+func (x *Education) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
 type GetAllEducationsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Maximum number of results to return. Defaults to 50 and is capped at 1000.
+	// Requests without it used to return all educations and now only return
+	// the first page, so follow next_page_token to read the rest.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token of a previous response, to fetch the following page.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// AIP-160 filter, e.g. `institution.name:"university"`.
+	Filter string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Comma separated fields to sort by, each optionally followed by "desc",
+	// e.g. `started_at desc`.
+	OrderBy string `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// Fields of each education to return. Every field is returned when unset.
+	ReadMask *fieldmaskpb.FieldMask `protobuf:"bytes,5,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	// Also return deleted educations. Admin only.
+	ShowDeleted bool `protobuf:"varint,6,opt,name=show_deleted,json=showDeleted,proto3" json:"show_deleted,omitempty"`
+	// Also return drafts, archived educations and educations scheduled for later.
+	// Admin only.
+	ShowUnpublished bool `protobuf:"varint,7,opt,name=show_unpublished,json=showUnpublished,proto3" json:"show_unpublished,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *GetAllEducationsRequest) Reset() {
@@ -151,9 +208,60 @@ func (*GetAllEducationsRequest) Descriptor() ([]byte, []int) {
 	return file_jorgejr568_portfolio_grpc_educations_proto_rawDescGZIP(), []int{1}
 }
 
+func (x *GetAllEducationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetAllEducationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetAllEducationsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *GetAllEducationsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *GetAllEducationsRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+func (x *GetAllEducationsRequest) GetShowDeleted() bool {
+	if x != nil {
+		return x.ShowDeleted
+	}
+	return false
+}
+
+func (x *GetAllEducationsRequest) GetShowUnpublished() bool {
+	if x != nil {
+		return x.ShowUnpublished
+	}
+	return false
+}
+
 type GetAllEducationsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Educations    []*Education           `protobuf:"bytes,1,rep,name=educations,proto3" json:"educations,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Educations []*Education           `protobuf:"bytes,1,rep,name=educations,proto3" json:"educations,omitempty"`
+	// Token for the next page, empty when there are no more results.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -195,11 +303,24 @@ func (x *GetAllEducationsResponse) GetEducations() []*Education {
 	return nil
 }
 
+func (x *GetAllEducationsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type GetEducationRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Fields of the education to return. Every field is returned when unset.
+	ReadMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	// Return the education even if it is deleted. Admin only.
+	ShowDeleted bool `protobuf:"varint,3,opt,name=show_deleted,json=showDeleted,proto3" json:"show_deleted,omitempty"`
+	// Return the education even if it is not public. Admin only.
+	ShowUnpublished bool `protobuf:"varint,4,opt,name=show_unpublished,json=showUnpublished,proto3" json:"show_unpublished,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *GetEducationRequest) Reset() {
@@ -239,6 +360,27 @@ func (x *GetEducationRequest) GetId() int64 {
 	return 0
 }
 
+func (x *GetEducationRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+func (x *GetEducationRequest) GetShowDeleted() bool {
+	if x != nil {
+		return x.ShowDeleted
+	}
+	return false
+}
+
+func (x *GetEducationRequest) GetShowUnpublished() bool {
+	if x != nil {
+		return x.ShowUnpublished
+	}
+	return false
+}
+
 type GetEducationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Education     *Education             `protobuf:"bytes,1,opt,name=education,proto3" json:"education,omitempty"`
@@ -283,6 +425,469 @@ func (x *GetEducationResponse) GetEducation() *Education {
 	return nil
 }
 
+type BatchGetEducationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Ids of the educations to return, at most 1000.
+	Ids []int64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	// Fields of each education to return. Every field is returned when unset.
+	ReadMask      *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetEducationsRequest) Reset() {
+	*x = BatchGetEducationsRequest{}
+	mi := &file_jorgejr568_portfolio_grpc_educations_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetEducationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetEducationsRequest) ProtoMessage() {}
+
+func (x *BatchGetEducationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_educations_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetEducationsRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetEducationsRequest) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_educations_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BatchGetEducationsRequest) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *BatchGetEducationsRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+type BatchGetEducationsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The educations found, in the order of the requested ids.
+	Educations []*Education `protobuf:"bytes,1,rep,name=educations,proto3" json:"educations,omitempty"`
+	// Requested ids without a matching education.
+	MissingIds    []int64 `protobuf:"varint,2,rep,packed,name=missing_ids,json=missingIds,proto3" json:"missing_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetEducationsResponse) Reset() {
+	*x = BatchGetEducationsResponse{}
+	mi := &file_jorgejr568_portfolio_grpc_educations_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetEducationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetEducationsResponse) ProtoMessage() {}
+
+func (x *BatchGetEducationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_educations_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetEducationsResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetEducationsResponse) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_educations_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BatchGetEducationsResponse) GetEducations() []*Education {
+	if x != nil {
+		return x.Educations
+	}
+	return nil
+}
+
+func (x *BatchGetEducationsResponse) GetMissingIds() []int64 {
+	if x != nil {
+		return x.MissingIds
+	}
+	return nil
+}
+
+type CreateEducationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Education     *Education             `protobuf:"bytes,1,opt,name=education,proto3" json:"education,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateEducationRequest) Reset() {
+	*x = CreateEducationRequest{}
+	mi := &file_jorgejr568_portfolio_grpc_educations_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEducationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEducationRequest) ProtoMessage() {}
+
+func (x *CreateEducationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_educations_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEducationRequest.ProtoReflect.Descriptor instead.
+func (*CreateEducationRequest) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_educations_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateEducationRequest) GetEducation() *Education {
+	if x != nil {
+		return x.Education
+	}
+	return nil
+}
+
+type CreateEducationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Education     *Education             `protobuf:"bytes,1,opt,name=education,proto3" json:"education,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateEducationResponse) Reset() {
+	*x = CreateEducationResponse{}
+	mi := &file_jorgejr568_portfolio_grpc_educations_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEducationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEducationResponse) ProtoMessage() {}
+
+func (x *CreateEducationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_educations_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEducationResponse.ProtoReflect.Descriptor instead.
+func (*CreateEducationResponse) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_educations_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateEducationResponse) GetEducation() *Education {
+	if x != nil {
+		return x.Education
+	}
+	return nil
+}
+
+type UpdateEducationRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Education *Education             `protobuf:"bytes,1,opt,name=education,proto3" json:"education,omitempty"`
+	// Fields of education to update. An empty mask updates every mutable field.
+	// The "institution" path replaces the whole institution, while
+	// "institution.name" and "institution.url" update a single field.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateEducationRequest) Reset() {
+	*x = UpdateEducationRequest{}
+	mi := &file_jorgejr568_portfolio_grpc_educations_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEducationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEducationRequest) ProtoMessage() {}
+
+func (x *UpdateEducationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_educations_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEducationRequest.ProtoReflect.Descriptor instead.
+func (*UpdateEducationRequest) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_educations_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateEducationRequest) GetEducation() *Education {
+	if x != nil {
+		return x.Education
+	}
+	return nil
+}
+
+func (x *UpdateEducationRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type UpdateEducationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Education     *Education             `protobuf:"bytes,1,opt,name=education,proto3" json:"education,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateEducationResponse) Reset() {
+	*x = UpdateEducationResponse{}
+	mi := &file_jorgejr568_portfolio_grpc_educations_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEducationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEducationResponse) ProtoMessage() {}
+
+func (x *UpdateEducationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_educations_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEducationResponse.ProtoReflect.Descriptor instead.
+func (*UpdateEducationResponse) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_educations_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateEducationResponse) GetEducation() *Education {
+	if x != nil {
+		return x.Education
+	}
+	return nil
+}
+
+type DeleteEducationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEducationRequest) Reset() {
+	*x = DeleteEducationRequest{}
+	mi := &file_jorgejr568_portfolio_grpc_educations_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEducationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEducationRequest) ProtoMessage() {}
+
+func (x *DeleteEducationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_educations_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEducationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEducationRequest) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_educations_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteEducationRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteEducationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEducationResponse) Reset() {
+	*x = DeleteEducationResponse{}
+	mi := &file_jorgejr568_portfolio_grpc_educations_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEducationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEducationResponse) ProtoMessage() {}
+
+func (x *DeleteEducationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_educations_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEducationResponse.ProtoReflect.Descriptor instead.
+func (*DeleteEducationResponse) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_educations_proto_rawDescGZIP(), []int{12}
+}
+
+type UndeleteEducationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UndeleteEducationRequest) Reset() {
+	*x = UndeleteEducationRequest{}
+	mi := &file_jorgejr568_portfolio_grpc_educations_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UndeleteEducationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndeleteEducationRequest) ProtoMessage() {}
+
+func (x *UndeleteEducationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_educations_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndeleteEducationRequest.ProtoReflect.Descriptor instead.
+func (*UndeleteEducationRequest) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_educations_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UndeleteEducationRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type UndeleteEducationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Education     *Education             `protobuf:"bytes,1,opt,name=education,proto3" json:"education,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UndeleteEducationResponse) Reset() {
+	*x = UndeleteEducationResponse{}
+	mi := &file_jorgejr568_portfolio_grpc_educations_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UndeleteEducationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndeleteEducationResponse) ProtoMessage() {}
+
+func (x *UndeleteEducationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_educations_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndeleteEducationResponse.ProtoReflect.Descriptor instead.
+func (*UndeleteEducationResponse) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_educations_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UndeleteEducationResponse) GetEducation() *Education {
+	if x != nil {
+		return x.Education
+	}
+	return nil
+}
+
 type Education_Institution struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -293,7 +898,7 @@ type Education_Institution struct {
 
 func (x *Education_Institution) Reset() {
 	*x = Education_Institution{}
-	mi := &file_jorgejr568_portfolio_grpc_educations_proto_msgTypes[5]
+	mi := &file_jorgejr568_portfolio_grpc_educations_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -305,7 +910,7 @@ func (x *Education_Institution) String() string {
 func (*Education_Institution) ProtoMessage() {}
 
 func (x *Education_Institution) ProtoReflect() protoreflect.Message {
-	mi := &file_jorgejr568_portfolio_grpc_educations_proto_msgTypes[5]
+	mi := &file_jorgejr568_portfolio_grpc_educations_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -339,7 +944,7 @@ var File_jorgejr568_portfolio_grpc_educations_proto protoreflect.FileDescriptor
 
 const file_jorgejr568_portfolio_grpc_educations_proto_rawDesc = "" +
 	"\n" +
-	"*jorgejr568/portfolio_grpc/educations.proto\x12\x19jorgejr568.portfolio_grpc\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16google/type/date.proto\"\x90\x03\n" +
+	"*jorgejr568/portfolio_grpc/educations.proto\x12\x19jorgejr568.portfolio_grpc\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16google/type/date.proto\x1a*jorgejr568/portfolio_grpc/visibility.proto\"\xfa\x04\n" +
 	"\tEducation\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12R\n" +
@@ -350,18 +955,65 @@ const file_jorgejr568_portfolio_grpc_educations_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x1a3\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12+\n" +
+	"\x11available_locales\x18\b \x03(\tR\x10availableLocales\x129\n" +
+	"\n" +
+	"deleted_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12E\n" +
+	"\n" +
+	"visibility\x18\n" +
+	" \x01(\x0e2%.jorgejr568.portfolio_grpc.VisibilityR\n" +
+	"visibility\x129\n" +
+	"\n" +
+	"publish_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tpublishAt\x1a3\n" +
 	"\vInstitution\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
-	"\x03url\x18\x02 \x01(\tR\x03url\"\x19\n" +
-	"\x17GetAllEducationsRequest\"`\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\"\x8f\x02\n" +
+	"\x17GetAllEducationsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06filter\x18\x03 \x01(\tR\x06filter\x12\x19\n" +
+	"\border_by\x18\x04 \x01(\tR\aorderBy\x127\n" +
+	"\tread_mask\x18\x05 \x01(\v2\x1a.google.protobuf.FieldMaskR\breadMask\x12!\n" +
+	"\fshow_deleted\x18\x06 \x01(\bR\vshowDeleted\x12)\n" +
+	"\x10show_unpublished\x18\a \x01(\bR\x0fshowUnpublished\"\x88\x01\n" +
 	"\x18GetAllEducationsResponse\x12D\n" +
 	"\n" +
 	"educations\x18\x01 \x03(\v2$.jorgejr568.portfolio_grpc.EducationR\n" +
-	"educations\"%\n" +
+	"educations\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xac\x01\n" +
 	"\x13GetEducationRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"Z\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x127\n" +
+	"\tread_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\breadMask\x12!\n" +
+	"\fshow_deleted\x18\x03 \x01(\bR\vshowDeleted\x12)\n" +
+	"\x10show_unpublished\x18\x04 \x01(\bR\x0fshowUnpublished\"Z\n" +
 	"\x14GetEducationResponse\x12B\n" +
+	"\teducation\x18\x01 \x01(\v2$.jorgejr568.portfolio_grpc.EducationR\teducation\"f\n" +
+	"\x19BatchGetEducationsRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\x03R\x03ids\x127\n" +
+	"\tread_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\breadMask\"\x83\x01\n" +
+	"\x1aBatchGetEducationsResponse\x12D\n" +
+	"\n" +
+	"educations\x18\x01 \x03(\v2$.jorgejr568.portfolio_grpc.EducationR\n" +
+	"educations\x12\x1f\n" +
+	"\vmissing_ids\x18\x02 \x03(\x03R\n" +
+	"missingIds\"\\\n" +
+	"\x16CreateEducationRequest\x12B\n" +
+	"\teducation\x18\x01 \x01(\v2$.jorgejr568.portfolio_grpc.EducationR\teducation\"]\n" +
+	"\x17CreateEducationResponse\x12B\n" +
+	"\teducation\x18\x01 \x01(\v2$.jorgejr568.portfolio_grpc.EducationR\teducation\"\x99\x01\n" +
+	"\x16UpdateEducationRequest\x12B\n" +
+	"\teducation\x18\x01 \x01(\v2$.jorgejr568.portfolio_grpc.EducationR\teducation\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"]\n" +
+	"\x17UpdateEducationResponse\x12B\n" +
+	"\teducation\x18\x01 \x01(\v2$.jorgejr568.portfolio_grpc.EducationR\teducation\"(\n" +
+	"\x16DeleteEducationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x19\n" +
+	"\x17DeleteEducationResponse\"*\n" +
+	"\x18UndeleteEducationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"_\n" +
+	"\x19UndeleteEducationResponse\x12B\n" +
 	"\teducation\x18\x01 \x01(\v2$.jorgejr568.portfolio_grpc.EducationR\teducationB\xf8\x01\n" +
 	"\x1dcom.jorgejr568.portfolio_grpcB\x0fEducationsProtoP\x01ZEgithub.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc\xa2\x02\x03JPX\xaa\x02\x18Jorgejr568.PortfolioGrpc\xca\x02\x18Jorgejr568\\PortfolioGrpc\xe2\x02$Jorgejr568\\PortfolioGrpc\\GPBMetadata\xea\x02\x19Jorgejr568::PortfolioGrpcb\x06proto3"
 
@@ -377,30 +1029,55 @@ func file_jorgejr568_portfolio_grpc_educations_proto_rawDescGZIP() []byte {
 	return file_jorgejr568_portfolio_grpc_educations_proto_rawDescData
 }
 
-var file_jorgejr568_portfolio_grpc_educations_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_jorgejr568_portfolio_grpc_educations_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_jorgejr568_portfolio_grpc_educations_proto_goTypes = []any{
-	(*Education)(nil),                // 0: jorgejr568.portfolio_grpc.Education
-	(*GetAllEducationsRequest)(nil),  // 1: jorgejr568.portfolio_grpc.GetAllEducationsRequest
-	(*GetAllEducationsResponse)(nil), // 2: jorgejr568.portfolio_grpc.GetAllEducationsResponse
-	(*GetEducationRequest)(nil),      // 3: jorgejr568.portfolio_grpc.GetEducationRequest
-	(*GetEducationResponse)(nil),     // 4: jorgejr568.portfolio_grpc.GetEducationResponse
-	(*Education_Institution)(nil),    // 5: jorgejr568.portfolio_grpc.Education.Institution
-	(*date.Date)(nil),                // 6: google.type.Date
-	(*timestamppb.Timestamp)(nil),    // 7: google.protobuf.Timestamp
+	(*Education)(nil),                  // 0: jorgejr568.portfolio_grpc.Education
+	(*GetAllEducationsRequest)(nil),    // 1: jorgejr568.portfolio_grpc.GetAllEducationsRequest
+	(*GetAllEducationsResponse)(nil),   // 2: jorgejr568.portfolio_grpc.GetAllEducationsResponse
+	(*GetEducationRequest)(nil),        // 3: jorgejr568.portfolio_grpc.GetEducationRequest
+	(*GetEducationResponse)(nil),       // 4: jorgejr568.portfolio_grpc.GetEducationResponse
+	(*BatchGetEducationsRequest)(nil),  // 5: jorgejr568.portfolio_grpc.BatchGetEducationsRequest
+	(*BatchGetEducationsResponse)(nil), // 6: jorgejr568.portfolio_grpc.BatchGetEducationsResponse
+	(*CreateEducationRequest)(nil),     // 7: jorgejr568.portfolio_grpc.CreateEducationRequest
+	(*CreateEducationResponse)(nil),    // 8: jorgejr568.portfolio_grpc.CreateEducationResponse
+	(*UpdateEducationRequest)(nil),     // 9: jorgejr568.portfolio_grpc.UpdateEducationRequest
+	(*UpdateEducationResponse)(nil),    // 10: jorgejr568.portfolio_grpc.UpdateEducationResponse
+	(*DeleteEducationRequest)(nil),     // 11: jorgejr568.portfolio_grpc.DeleteEducationRequest
+	(*DeleteEducationResponse)(nil),    // 12: jorgejr568.portfolio_grpc.DeleteEducationResponse
+	(*UndeleteEducationRequest)(nil),   // 13: jorgejr568.portfolio_grpc.UndeleteEducationRequest
+	(*UndeleteEducationResponse)(nil),  // 14: jorgejr568.portfolio_grpc.UndeleteEducationResponse
+	(*Education_Institution)(nil),      // 15: jorgejr568.portfolio_grpc.Education.Institution
+	(*date.Date)(nil),                  // 16: google.type.Date
+	(*timestamppb.Timestamp)(nil),      // 17: google.protobuf.Timestamp
+	(Visibility)(0),                    // 18: jorgejr568.portfolio_grpc.Visibility
+	(*fieldmaskpb.FieldMask)(nil),      // 19: google.protobuf.FieldMask
 }
 var file_jorgejr568_portfolio_grpc_educations_proto_depIdxs = []int32{
-	5, // 0: jorgejr568.portfolio_grpc.Education.institution:type_name -> jorgejr568.portfolio_grpc.Education.Institution
-	6, // 1: jorgejr568.portfolio_grpc.Education.started_at:type_name -> google.type.Date
-	6, // 2: jorgejr568.portfolio_grpc.Education.ended_at:type_name -> google.type.Date
-	7, // 3: jorgejr568.portfolio_grpc.Education.created_at:type_name -> google.protobuf.Timestamp
-	7, // 4: jorgejr568.portfolio_grpc.Education.updated_at:type_name -> google.protobuf.Timestamp
-	0, // 5: jorgejr568.portfolio_grpc.GetAllEducationsResponse.educations:type_name -> jorgejr568.portfolio_grpc.Education
-	0, // 6: jorgejr568.portfolio_grpc.GetEducationResponse.education:type_name -> jorgejr568.portfolio_grpc.Education
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	15, // 0: jorgejr568.portfolio_grpc.Education.institution:type_name -> jorgejr568.portfolio_grpc.Education.Institution
+	16, // 1: jorgejr568.portfolio_grpc.Education.started_at:type_name -> google.type.Date
+	16, // 2: jorgejr568.portfolio_grpc.Education.ended_at:type_name -> google.type.Date
+	17, // 3: jorgejr568.portfolio_grpc.Education.created_at:type_name -> google.protobuf.Timestamp
+	17, // 4: jorgejr568.portfolio_grpc.Education.updated_at:type_name -> google.protobuf.Timestamp
+	17, // 5: jorgejr568.portfolio_grpc.Education.deleted_at:type_name -> google.protobuf.Timestamp
+	18, // 6: jorgejr568.portfolio_grpc.Education.visibility:type_name -> jorgejr568.portfolio_grpc.Visibility
+	17, // 7: jorgejr568.portfolio_grpc.Education.publish_at:type_name -> google.protobuf.Timestamp
+	19, // 8: jorgejr568.portfolio_grpc.GetAllEducationsRequest.read_mask:type_name -> google.protobuf.FieldMask
+	0,  // 9: jorgejr568.portfolio_grpc.GetAllEducationsResponse.educations:type_name -> jorgejr568.portfolio_grpc.Education
+	19, // 10: jorgejr568.portfolio_grpc.GetEducationRequest.read_mask:type_name -> google.protobuf.FieldMask
+	0,  // 11: jorgejr568.portfolio_grpc.GetEducationResponse.education:type_name -> jorgejr568.portfolio_grpc.Education
+	19, // 12: jorgejr568.portfolio_grpc.BatchGetEducationsRequest.read_mask:type_name -> google.protobuf.FieldMask
+	0,  // 13: jorgejr568.portfolio_grpc.BatchGetEducationsResponse.educations:type_name -> jorgejr568.portfolio_grpc.Education
+	0,  // 14: jorgejr568.portfolio_grpc.CreateEducationRequest.education:type_name -> jorgejr568.portfolio_grpc.Education
+	0,  // 15: jorgejr568.portfolio_grpc.CreateEducationResponse.education:type_name -> jorgejr568.portfolio_grpc.Education
+	0,  // 16: jorgejr568.portfolio_grpc.UpdateEducationRequest.education:type_name -> jorgejr568.portfolio_grpc.Education
+	19, // 17: jorgejr568.portfolio_grpc.UpdateEducationRequest.update_mask:type_name -> google.protobuf.FieldMask
+	0,  // 18: jorgejr568.portfolio_grpc.UpdateEducationResponse.education:type_name -> jorgejr568.portfolio_grpc.Education
+	0,  // 19: jorgejr568.portfolio_grpc.UndeleteEducationResponse.education:type_name -> jorgejr568.portfolio_grpc.Education
+	20, // [20:20] is the sub-list for method output_type
+	20, // [20:20] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_jorgejr568_portfolio_grpc_educations_proto_init() }
@@ -408,13 +1085,14 @@ func file_jorgejr568_portfolio_grpc_educations_proto_init() {
 	if File_jorgejr568_portfolio_grpc_educations_proto != nil {
 		return
 	}
+	file_jorgejr568_portfolio_grpc_visibility_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_jorgejr568_portfolio_grpc_educations_proto_rawDesc), len(file_jorgejr568_portfolio_grpc_educations_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
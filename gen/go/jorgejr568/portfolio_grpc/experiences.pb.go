@@ -10,6 +10,7 @@ import (
 	date "google.golang.org/genproto/googleapis/type/date"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -23,17 +24,84 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type Experience_Technology_Kind int32
+
+const (
+	Experience_Technology_KIND_UNSPECIFIED Experience_Technology_Kind = 0
+	Experience_Technology_KIND_LANGUAGE    Experience_Technology_Kind = 1
+	Experience_Technology_KIND_FRAMEWORK   Experience_Technology_Kind = 2
+	Experience_Technology_KIND_TOOL        Experience_Technology_Kind = 3
+)
+
+// Enum value maps for Experience_Technology_Kind.
+var (
+	Experience_Technology_Kind_name = map[int32]string{
+		0: "KIND_UNSPECIFIED",
+		1: "KIND_LANGUAGE",
+		2: "KIND_FRAMEWORK",
+		3: "KIND_TOOL",
+	}
+	Experience_Technology_Kind_value = map[string]int32{
+		"KIND_UNSPECIFIED": 0,
+		"KIND_LANGUAGE":    1,
+		"KIND_FRAMEWORK":   2,
+		"KIND_TOOL":        3,
+	}
+)
+
+func (x Experience_Technology_Kind) Enum() *Experience_Technology_Kind {
+	p := new(Experience_Technology_Kind)
+	*p = x
+	return p
+}
+
+func (x Experience_Technology_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Experience_Technology_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_jorgejr568_portfolio_grpc_experiences_proto_enumTypes[0].Descriptor()
+}
+
+func (Experience_Technology_Kind) Type() protoreflect.EnumType {
+	return &file_jorgejr568_portfolio_grpc_experiences_proto_enumTypes[0]
+}
+
+func (x Experience_Technology_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Experience_Technology_Kind.Descriptor instead.
+func (Experience_Technology_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_experiences_proto_rawDescGZIP(), []int{0, 1, 0}
+}
+
 type Experience struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Company       *Experience_Company    `protobuf:"bytes,4,opt,name=company,proto3" json:"company,omitempty"`
-	Technologies  []string               `protobuf:"bytes,5,rep,name=technologies,proto3" json:"technologies,omitempty"`
-	StartedAt     *date.Date             `protobuf:"bytes,6,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
-	EndedAt       *date.Date             `protobuf:"bytes,7,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title       string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Company     *Experience_Company    `protobuf:"bytes,4,opt,name=company,proto3" json:"company,omitempty"`
+	// Names of every technology in technology_details, kept for older clients.
+	// On writes it is only used when technology_details is empty, in which case
+	// names the experience already had keep their kind and new names are stored
+	// as languages.
+	Technologies      []string                 `protobuf:"bytes,5,rep,name=technologies,proto3" json:"technologies,omitempty"`
+	StartedAt         *date.Date               `protobuf:"bytes,6,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	EndedAt           *date.Date               `protobuf:"bytes,7,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
+	CreatedAt         *timestamppb.Timestamp   `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt         *timestamppb.Timestamp   `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	TechnologyDetails []*Experience_Technology `protobuf:"bytes,10,rep,name=technology_details,json=technologyDetails,proto3" json:"technology_details,omitempty"`
+	// Output only. Locales the translatable fields are available in. The
+	// fields are served in the one that best matches the Accept-Language of
+	// the request.
+	AvailableLocales []string `protobuf:"bytes,11,rep,name=available_locales,json=availableLocales,proto3" json:"available_locales,omitempty"`
+	// Output only. When the experience was deleted; unset unless it is deleted.
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	// Whether the experience is public. Defaults to VISIBILITY_PUBLISHED.
+	Visibility Visibility `protobuf:"varint,13,opt,name=visibility,proto3,enum=jorgejr568.portfolio_grpc.Visibility" json:"visibility,omitempty"`
+	// When set, a published experience only becomes public at this time.
+	PublishAt     *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -131,10 +199,64 @@ func (x *Experience) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Experience) GetTechnologyDetails() []*Experience_Technology {
+	if x != nil {
+		return x.TechnologyDetails
+	}
+	return nil
+}
+
+func (x *Experience) GetAvailableLocales() []string {
+	if x != nil {
+		return x.AvailableLocales
+	}
+	return nil
+}
+
+func (x *Experience) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Experience) GetVisibility() Visibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return Visibility_VISIBILITY_UNSPECIFIED
+}
+
+func (x *Experience) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
 type GetAllExperiencesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Maximum number of results to return. Defaults to 50 and is capped at 1000.
+	// Requests without it used to return all experiences and now only return
+	// the first page, so follow next_page_token to read the rest.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token of a previous response, to fetch the following page.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// AIP-160 filter, e.g. `technologies:"Go" AND started_at >= 2020-01-01`.
+	Filter string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Comma separated fields to sort by, each optionally followed by "desc",
+	// e.g. `started_at desc`.
+	OrderBy string `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// Fields of each experience to return, e.g. `id,title,company.name`.
+	// Every field is returned when unset.
+	ReadMask *fieldmaskpb.FieldMask `protobuf:"bytes,5,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	// Also return deleted experiences. Admin only.
+	ShowDeleted bool `protobuf:"varint,6,opt,name=show_deleted,json=showDeleted,proto3" json:"show_deleted,omitempty"`
+	// Also return drafts, archived experiences and experiences scheduled for later.
+	// Admin only.
+	ShowUnpublished bool `protobuf:"varint,7,opt,name=show_unpublished,json=showUnpublished,proto3" json:"show_unpublished,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *GetAllExperiencesRequest) Reset() {
@@ -167,9 +289,60 @@ func (*GetAllExperiencesRequest) Descriptor() ([]byte, []int) {
 	return file_jorgejr568_portfolio_grpc_experiences_proto_rawDescGZIP(), []int{1}
 }
 
+func (x *GetAllExperiencesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetAllExperiencesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetAllExperiencesRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *GetAllExperiencesRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *GetAllExperiencesRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+func (x *GetAllExperiencesRequest) GetShowDeleted() bool {
+	if x != nil {
+		return x.ShowDeleted
+	}
+	return false
+}
+
+func (x *GetAllExperiencesRequest) GetShowUnpublished() bool {
+	if x != nil {
+		return x.ShowUnpublished
+	}
+	return false
+}
+
 type GetAllExperiencesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Experiences   []*Experience          `protobuf:"bytes,1,rep,name=experiences,proto3" json:"experiences,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Experiences []*Experience          `protobuf:"bytes,1,rep,name=experiences,proto3" json:"experiences,omitempty"`
+	// Token for the next page, empty when there are no more results.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -211,11 +384,25 @@ func (x *GetAllExperiencesResponse) GetExperiences() []*Experience {
 	return nil
 }
 
+func (x *GetAllExperiencesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type GetExperienceRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Fields of the experience to return. Every field is returned when unset.
+	// The skills of the response are only returned when unset.
+	ReadMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	// Return the experience even if it is deleted. Admin only.
+	ShowDeleted bool `protobuf:"varint,3,opt,name=show_deleted,json=showDeleted,proto3" json:"show_deleted,omitempty"`
+	// Return the experience even if it is not public. Admin only.
+	ShowUnpublished bool `protobuf:"varint,4,opt,name=show_unpublished,json=showUnpublished,proto3" json:"show_unpublished,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *GetExperienceRequest) Reset() {
@@ -255,9 +442,32 @@ func (x *GetExperienceRequest) GetId() int64 {
 	return 0
 }
 
+func (x *GetExperienceRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+func (x *GetExperienceRequest) GetShowDeleted() bool {
+	if x != nil {
+		return x.ShowDeleted
+	}
+	return false
+}
+
+func (x *GetExperienceRequest) GetShowUnpublished() bool {
+	if x != nil {
+		return x.ShowUnpublished
+	}
+	return false
+}
+
 type GetExperienceResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Experience    *Experience            `protobuf:"bytes,1,opt,name=experience,proto3" json:"experience,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Experience *Experience            `protobuf:"bytes,1,opt,name=experience,proto3" json:"experience,omitempty"`
+	// Skills among the technologies of the experience.
+	Skills        []*Skill `protobuf:"bytes,2,rep,name=skills,proto3" json:"skills,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -299,6 +509,474 @@ func (x *GetExperienceResponse) GetExperience() *Experience {
 	return nil
 }
 
+func (x *GetExperienceResponse) GetSkills() []*Skill {
+	if x != nil {
+		return x.Skills
+	}
+	return nil
+}
+
+type BatchGetExperiencesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Ids of the experiences to return, at most 1000.
+	Ids []int64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	// Fields of each experience to return. Every field is returned when unset.
+	ReadMask      *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetExperiencesRequest) Reset() {
+	*x = BatchGetExperiencesRequest{}
+	mi := &file_jorgejr568_portfolio_grpc_experiences_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetExperiencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetExperiencesRequest) ProtoMessage() {}
+
+func (x *BatchGetExperiencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_experiences_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetExperiencesRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetExperiencesRequest) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_experiences_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BatchGetExperiencesRequest) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *BatchGetExperiencesRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+type BatchGetExperiencesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The experiences found, in the order of the requested ids.
+	Experiences []*Experience `protobuf:"bytes,1,rep,name=experiences,proto3" json:"experiences,omitempty"`
+	// Requested ids without a matching experience.
+	MissingIds    []int64 `protobuf:"varint,2,rep,packed,name=missing_ids,json=missingIds,proto3" json:"missing_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetExperiencesResponse) Reset() {
+	*x = BatchGetExperiencesResponse{}
+	mi := &file_jorgejr568_portfolio_grpc_experiences_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetExperiencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetExperiencesResponse) ProtoMessage() {}
+
+func (x *BatchGetExperiencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_experiences_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetExperiencesResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetExperiencesResponse) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_experiences_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BatchGetExperiencesResponse) GetExperiences() []*Experience {
+	if x != nil {
+		return x.Experiences
+	}
+	return nil
+}
+
+func (x *BatchGetExperiencesResponse) GetMissingIds() []int64 {
+	if x != nil {
+		return x.MissingIds
+	}
+	return nil
+}
+
+type CreateExperienceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Experience    *Experience            `protobuf:"bytes,1,opt,name=experience,proto3" json:"experience,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateExperienceRequest) Reset() {
+	*x = CreateExperienceRequest{}
+	mi := &file_jorgejr568_portfolio_grpc_experiences_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateExperienceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateExperienceRequest) ProtoMessage() {}
+
+func (x *CreateExperienceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_experiences_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateExperienceRequest.ProtoReflect.Descriptor instead.
+func (*CreateExperienceRequest) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_experiences_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateExperienceRequest) GetExperience() *Experience {
+	if x != nil {
+		return x.Experience
+	}
+	return nil
+}
+
+type CreateExperienceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Experience    *Experience            `protobuf:"bytes,1,opt,name=experience,proto3" json:"experience,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateExperienceResponse) Reset() {
+	*x = CreateExperienceResponse{}
+	mi := &file_jorgejr568_portfolio_grpc_experiences_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateExperienceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateExperienceResponse) ProtoMessage() {}
+
+func (x *CreateExperienceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_experiences_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateExperienceResponse.ProtoReflect.Descriptor instead.
+func (*CreateExperienceResponse) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_experiences_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateExperienceResponse) GetExperience() *Experience {
+	if x != nil {
+		return x.Experience
+	}
+	return nil
+}
+
+type UpdateExperienceRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Experience *Experience            `protobuf:"bytes,1,opt,name=experience,proto3" json:"experience,omitempty"`
+	// Fields of experience to update. An empty mask updates every mutable field.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateExperienceRequest) Reset() {
+	*x = UpdateExperienceRequest{}
+	mi := &file_jorgejr568_portfolio_grpc_experiences_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateExperienceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateExperienceRequest) ProtoMessage() {}
+
+func (x *UpdateExperienceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_experiences_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateExperienceRequest.ProtoReflect.Descriptor instead.
+func (*UpdateExperienceRequest) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_experiences_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateExperienceRequest) GetExperience() *Experience {
+	if x != nil {
+		return x.Experience
+	}
+	return nil
+}
+
+func (x *UpdateExperienceRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type UpdateExperienceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Experience    *Experience            `protobuf:"bytes,1,opt,name=experience,proto3" json:"experience,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateExperienceResponse) Reset() {
+	*x = UpdateExperienceResponse{}
+	mi := &file_jorgejr568_portfolio_grpc_experiences_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateExperienceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateExperienceResponse) ProtoMessage() {}
+
+func (x *UpdateExperienceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_experiences_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateExperienceResponse.ProtoReflect.Descriptor instead.
+func (*UpdateExperienceResponse) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_experiences_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateExperienceResponse) GetExperience() *Experience {
+	if x != nil {
+		return x.Experience
+	}
+	return nil
+}
+
+type DeleteExperienceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteExperienceRequest) Reset() {
+	*x = DeleteExperienceRequest{}
+	mi := &file_jorgejr568_portfolio_grpc_experiences_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteExperienceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteExperienceRequest) ProtoMessage() {}
+
+func (x *DeleteExperienceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_experiences_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteExperienceRequest.ProtoReflect.Descriptor instead.
+func (*DeleteExperienceRequest) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_experiences_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteExperienceRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteExperienceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteExperienceResponse) Reset() {
+	*x = DeleteExperienceResponse{}
+	mi := &file_jorgejr568_portfolio_grpc_experiences_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteExperienceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteExperienceResponse) ProtoMessage() {}
+
+func (x *DeleteExperienceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_experiences_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteExperienceResponse.ProtoReflect.Descriptor instead.
+func (*DeleteExperienceResponse) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_experiences_proto_rawDescGZIP(), []int{12}
+}
+
+type UndeleteExperienceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UndeleteExperienceRequest) Reset() {
+	*x = UndeleteExperienceRequest{}
+	mi := &file_jorgejr568_portfolio_grpc_experiences_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UndeleteExperienceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndeleteExperienceRequest) ProtoMessage() {}
+
+func (x *UndeleteExperienceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_experiences_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndeleteExperienceRequest.ProtoReflect.Descriptor instead.
+func (*UndeleteExperienceRequest) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_experiences_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UndeleteExperienceRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type UndeleteExperienceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Experience    *Experience            `protobuf:"bytes,1,opt,name=experience,proto3" json:"experience,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UndeleteExperienceResponse) Reset() {
+	*x = UndeleteExperienceResponse{}
+	mi := &file_jorgejr568_portfolio_grpc_experiences_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UndeleteExperienceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndeleteExperienceResponse) ProtoMessage() {}
+
+func (x *UndeleteExperienceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_experiences_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndeleteExperienceResponse.ProtoReflect.Descriptor instead.
+func (*UndeleteExperienceResponse) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_experiences_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UndeleteExperienceResponse) GetExperience() *Experience {
+	if x != nil {
+		return x.Experience
+	}
+	return nil
+}
+
 type Experience_Company struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -310,7 +988,7 @@ type Experience_Company struct {
 
 func (x *Experience_Company) Reset() {
 	*x = Experience_Company{}
-	mi := &file_jorgejr568_portfolio_grpc_experiences_proto_msgTypes[5]
+	mi := &file_jorgejr568_portfolio_grpc_experiences_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -322,7 +1000,7 @@ func (x *Experience_Company) String() string {
 func (*Experience_Company) ProtoMessage() {}
 
 func (x *Experience_Company) ProtoReflect() protoreflect.Message {
-	mi := &file_jorgejr568_portfolio_grpc_experiences_proto_msgTypes[5]
+	mi := &file_jorgejr568_portfolio_grpc_experiences_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -359,11 +1037,63 @@ func (x *Experience_Company) GetLogoUrl() string {
 	return ""
 }
 
+type Experience_Technology struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Name          string                     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Kind          Experience_Technology_Kind `protobuf:"varint,2,opt,name=kind,proto3,enum=jorgejr568.portfolio_grpc.Experience_Technology_Kind" json:"kind,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Experience_Technology) Reset() {
+	*x = Experience_Technology{}
+	mi := &file_jorgejr568_portfolio_grpc_experiences_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Experience_Technology) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Experience_Technology) ProtoMessage() {}
+
+func (x *Experience_Technology) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_experiences_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Experience_Technology.ProtoReflect.Descriptor instead.
+func (*Experience_Technology) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_experiences_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *Experience_Technology) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Experience_Technology) GetKind() Experience_Technology_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return Experience_Technology_KIND_UNSPECIFIED
+}
+
 var File_jorgejr568_portfolio_grpc_experiences_proto protoreflect.FileDescriptor
 
 const file_jorgejr568_portfolio_grpc_experiences_proto_rawDesc = "" +
 	"\n" +
-	"+jorgejr568/portfolio_grpc/experiences.proto\x12\x19jorgejr568.portfolio_grpc\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16google/type/date.proto\"\xe3\x03\n" +
+	"+jorgejr568/portfolio_grpc/experiences.proto\x12\x19jorgejr568.portfolio_grpc\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16google/type/date.proto\x1a&jorgejr568/portfolio_grpc/skills.proto\x1a*jorgejr568/portfolio_grpc/visibility.proto\"\xf0\a\n" +
 	"\n" +
 	"Experience\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
@@ -377,19 +1107,85 @@ const file_jorgejr568_portfolio_grpc_experiences_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x1aJ\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12_\n" +
+	"\x12technology_details\x18\n" +
+	" \x03(\v20.jorgejr568.portfolio_grpc.Experience.TechnologyR\x11technologyDetails\x12+\n" +
+	"\x11available_locales\x18\v \x03(\tR\x10availableLocales\x129\n" +
+	"\n" +
+	"deleted_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12E\n" +
+	"\n" +
+	"visibility\x18\r \x01(\x0e2%.jorgejr568.portfolio_grpc.VisibilityR\n" +
+	"visibility\x129\n" +
+	"\n" +
+	"publish_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tpublishAt\x1aJ\n" +
 	"\aCompany\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
 	"\x03url\x18\x02 \x01(\tR\x03url\x12\x19\n" +
-	"\blogo_url\x18\x03 \x01(\tR\alogoUrl\"\x1a\n" +
-	"\x18GetAllExperiencesRequest\"d\n" +
+	"\blogo_url\x18\x03 \x01(\tR\alogoUrl\x1a\xbf\x01\n" +
+	"\n" +
+	"Technology\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12I\n" +
+	"\x04kind\x18\x02 \x01(\x0e25.jorgejr568.portfolio_grpc.Experience.Technology.KindR\x04kind\"R\n" +
+	"\x04Kind\x12\x14\n" +
+	"\x10KIND_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\rKIND_LANGUAGE\x10\x01\x12\x12\n" +
+	"\x0eKIND_FRAMEWORK\x10\x02\x12\r\n" +
+	"\tKIND_TOOL\x10\x03\"\x90\x02\n" +
+	"\x18GetAllExperiencesRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06filter\x18\x03 \x01(\tR\x06filter\x12\x19\n" +
+	"\border_by\x18\x04 \x01(\tR\aorderBy\x127\n" +
+	"\tread_mask\x18\x05 \x01(\v2\x1a.google.protobuf.FieldMaskR\breadMask\x12!\n" +
+	"\fshow_deleted\x18\x06 \x01(\bR\vshowDeleted\x12)\n" +
+	"\x10show_unpublished\x18\a \x01(\bR\x0fshowUnpublished\"\x8c\x01\n" +
 	"\x19GetAllExperiencesResponse\x12G\n" +
-	"\vexperiences\x18\x01 \x03(\v2%.jorgejr568.portfolio_grpc.ExperienceR\vexperiences\"&\n" +
+	"\vexperiences\x18\x01 \x03(\v2%.jorgejr568.portfolio_grpc.ExperienceR\vexperiences\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xad\x01\n" +
 	"\x14GetExperienceRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"^\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x127\n" +
+	"\tread_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\breadMask\x12!\n" +
+	"\fshow_deleted\x18\x03 \x01(\bR\vshowDeleted\x12)\n" +
+	"\x10show_unpublished\x18\x04 \x01(\bR\x0fshowUnpublished\"\x98\x01\n" +
 	"\x15GetExperienceResponse\x12E\n" +
 	"\n" +
 	"experience\x18\x01 \x01(\v2%.jorgejr568.portfolio_grpc.ExperienceR\n" +
+	"experience\x128\n" +
+	"\x06skills\x18\x02 \x03(\v2 .jorgejr568.portfolio_grpc.SkillR\x06skills\"g\n" +
+	"\x1aBatchGetExperiencesRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\x03R\x03ids\x127\n" +
+	"\tread_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\breadMask\"\x87\x01\n" +
+	"\x1bBatchGetExperiencesResponse\x12G\n" +
+	"\vexperiences\x18\x01 \x03(\v2%.jorgejr568.portfolio_grpc.ExperienceR\vexperiences\x12\x1f\n" +
+	"\vmissing_ids\x18\x02 \x03(\x03R\n" +
+	"missingIds\"`\n" +
+	"\x17CreateExperienceRequest\x12E\n" +
+	"\n" +
+	"experience\x18\x01 \x01(\v2%.jorgejr568.portfolio_grpc.ExperienceR\n" +
+	"experience\"a\n" +
+	"\x18CreateExperienceResponse\x12E\n" +
+	"\n" +
+	"experience\x18\x01 \x01(\v2%.jorgejr568.portfolio_grpc.ExperienceR\n" +
+	"experience\"\x9d\x01\n" +
+	"\x17UpdateExperienceRequest\x12E\n" +
+	"\n" +
+	"experience\x18\x01 \x01(\v2%.jorgejr568.portfolio_grpc.ExperienceR\n" +
+	"experience\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"a\n" +
+	"\x18UpdateExperienceResponse\x12E\n" +
+	"\n" +
+	"experience\x18\x01 \x01(\v2%.jorgejr568.portfolio_grpc.ExperienceR\n" +
+	"experience\")\n" +
+	"\x17DeleteExperienceRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x1a\n" +
+	"\x18DeleteExperienceResponse\"+\n" +
+	"\x19UndeleteExperienceRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"c\n" +
+	"\x1aUndeleteExperienceResponse\x12E\n" +
+	"\n" +
+	"experience\x18\x01 \x01(\v2%.jorgejr568.portfolio_grpc.ExperienceR\n" +
 	"experienceB\xf9\x01\n" +
 	"\x1dcom.jorgejr568.portfolio_grpcB\x10ExperiencesProtoP\x01ZEgithub.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc\xa2\x02\x03JPX\xaa\x02\x18Jorgejr568.PortfolioGrpc\xca\x02\x18Jorgejr568\\PortfolioGrpc\xe2\x02$Jorgejr568\\PortfolioGrpc\\GPBMetadata\xea\x02\x19Jorgejr568::PortfolioGrpcb\x06proto3"
 
@@ -405,30 +1201,62 @@ func file_jorgejr568_portfolio_grpc_experiences_proto_rawDescGZIP() []byte {
 	return file_jorgejr568_portfolio_grpc_experiences_proto_rawDescData
 }
 
-var file_jorgejr568_portfolio_grpc_experiences_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_jorgejr568_portfolio_grpc_experiences_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_jorgejr568_portfolio_grpc_experiences_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_jorgejr568_portfolio_grpc_experiences_proto_goTypes = []any{
-	(*Experience)(nil),                // 0: jorgejr568.portfolio_grpc.Experience
-	(*GetAllExperiencesRequest)(nil),  // 1: jorgejr568.portfolio_grpc.GetAllExperiencesRequest
-	(*GetAllExperiencesResponse)(nil), // 2: jorgejr568.portfolio_grpc.GetAllExperiencesResponse
-	(*GetExperienceRequest)(nil),      // 3: jorgejr568.portfolio_grpc.GetExperienceRequest
-	(*GetExperienceResponse)(nil),     // 4: jorgejr568.portfolio_grpc.GetExperienceResponse
-	(*Experience_Company)(nil),        // 5: jorgejr568.portfolio_grpc.Experience.Company
-	(*date.Date)(nil),                 // 6: google.type.Date
-	(*timestamppb.Timestamp)(nil),     // 7: google.protobuf.Timestamp
+	(Experience_Technology_Kind)(0),     // 0: jorgejr568.portfolio_grpc.Experience.Technology.Kind
+	(*Experience)(nil),                  // 1: jorgejr568.portfolio_grpc.Experience
+	(*GetAllExperiencesRequest)(nil),    // 2: jorgejr568.portfolio_grpc.GetAllExperiencesRequest
+	(*GetAllExperiencesResponse)(nil),   // 3: jorgejr568.portfolio_grpc.GetAllExperiencesResponse
+	(*GetExperienceRequest)(nil),        // 4: jorgejr568.portfolio_grpc.GetExperienceRequest
+	(*GetExperienceResponse)(nil),       // 5: jorgejr568.portfolio_grpc.GetExperienceResponse
+	(*BatchGetExperiencesRequest)(nil),  // 6: jorgejr568.portfolio_grpc.BatchGetExperiencesRequest
+	(*BatchGetExperiencesResponse)(nil), // 7: jorgejr568.portfolio_grpc.BatchGetExperiencesResponse
+	(*CreateExperienceRequest)(nil),     // 8: jorgejr568.portfolio_grpc.CreateExperienceRequest
+	(*CreateExperienceResponse)(nil),    // 9: jorgejr568.portfolio_grpc.CreateExperienceResponse
+	(*UpdateExperienceRequest)(nil),     // 10: jorgejr568.portfolio_grpc.UpdateExperienceRequest
+	(*UpdateExperienceResponse)(nil),    // 11: jorgejr568.portfolio_grpc.UpdateExperienceResponse
+	(*DeleteExperienceRequest)(nil),     // 12: jorgejr568.portfolio_grpc.DeleteExperienceRequest
+	(*DeleteExperienceResponse)(nil),    // 13: jorgejr568.portfolio_grpc.DeleteExperienceResponse
+	(*UndeleteExperienceRequest)(nil),   // 14: jorgejr568.portfolio_grpc.UndeleteExperienceRequest
+	(*UndeleteExperienceResponse)(nil),  // 15: jorgejr568.portfolio_grpc.UndeleteExperienceResponse
+	(*Experience_Company)(nil),          // 16: jorgejr568.portfolio_grpc.Experience.Company
+	(*Experience_Technology)(nil),       // 17: jorgejr568.portfolio_grpc.Experience.Technology
+	(*date.Date)(nil),                   // 18: google.type.Date
+	(*timestamppb.Timestamp)(nil),       // 19: google.protobuf.Timestamp
+	(Visibility)(0),                     // 20: jorgejr568.portfolio_grpc.Visibility
+	(*fieldmaskpb.FieldMask)(nil),       // 21: google.protobuf.FieldMask
+	(*Skill)(nil),                       // 22: jorgejr568.portfolio_grpc.Skill
 }
 var file_jorgejr568_portfolio_grpc_experiences_proto_depIdxs = []int32{
-	5, // 0: jorgejr568.portfolio_grpc.Experience.company:type_name -> jorgejr568.portfolio_grpc.Experience.Company
-	6, // 1: jorgejr568.portfolio_grpc.Experience.started_at:type_name -> google.type.Date
-	6, // 2: jorgejr568.portfolio_grpc.Experience.ended_at:type_name -> google.type.Date
-	7, // 3: jorgejr568.portfolio_grpc.Experience.created_at:type_name -> google.protobuf.Timestamp
-	7, // 4: jorgejr568.portfolio_grpc.Experience.updated_at:type_name -> google.protobuf.Timestamp
-	0, // 5: jorgejr568.portfolio_grpc.GetAllExperiencesResponse.experiences:type_name -> jorgejr568.portfolio_grpc.Experience
-	0, // 6: jorgejr568.portfolio_grpc.GetExperienceResponse.experience:type_name -> jorgejr568.portfolio_grpc.Experience
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	16, // 0: jorgejr568.portfolio_grpc.Experience.company:type_name -> jorgejr568.portfolio_grpc.Experience.Company
+	18, // 1: jorgejr568.portfolio_grpc.Experience.started_at:type_name -> google.type.Date
+	18, // 2: jorgejr568.portfolio_grpc.Experience.ended_at:type_name -> google.type.Date
+	19, // 3: jorgejr568.portfolio_grpc.Experience.created_at:type_name -> google.protobuf.Timestamp
+	19, // 4: jorgejr568.portfolio_grpc.Experience.updated_at:type_name -> google.protobuf.Timestamp
+	17, // 5: jorgejr568.portfolio_grpc.Experience.technology_details:type_name -> jorgejr568.portfolio_grpc.Experience.Technology
+	19, // 6: jorgejr568.portfolio_grpc.Experience.deleted_at:type_name -> google.protobuf.Timestamp
+	20, // 7: jorgejr568.portfolio_grpc.Experience.visibility:type_name -> jorgejr568.portfolio_grpc.Visibility
+	19, // 8: jorgejr568.portfolio_grpc.Experience.publish_at:type_name -> google.protobuf.Timestamp
+	21, // 9: jorgejr568.portfolio_grpc.GetAllExperiencesRequest.read_mask:type_name -> google.protobuf.FieldMask
+	1,  // 10: jorgejr568.portfolio_grpc.GetAllExperiencesResponse.experiences:type_name -> jorgejr568.portfolio_grpc.Experience
+	21, // 11: jorgejr568.portfolio_grpc.GetExperienceRequest.read_mask:type_name -> google.protobuf.FieldMask
+	1,  // 12: jorgejr568.portfolio_grpc.GetExperienceResponse.experience:type_name -> jorgejr568.portfolio_grpc.Experience
+	22, // 13: jorgejr568.portfolio_grpc.GetExperienceResponse.skills:type_name -> jorgejr568.portfolio_grpc.Skill
+	21, // 14: jorgejr568.portfolio_grpc.BatchGetExperiencesRequest.read_mask:type_name -> google.protobuf.FieldMask
+	1,  // 15: jorgejr568.portfolio_grpc.BatchGetExperiencesResponse.experiences:type_name -> jorgejr568.portfolio_grpc.Experience
+	1,  // 16: jorgejr568.portfolio_grpc.CreateExperienceRequest.experience:type_name -> jorgejr568.portfolio_grpc.Experience
+	1,  // 17: jorgejr568.portfolio_grpc.CreateExperienceResponse.experience:type_name -> jorgejr568.portfolio_grpc.Experience
+	1,  // 18: jorgejr568.portfolio_grpc.UpdateExperienceRequest.experience:type_name -> jorgejr568.portfolio_grpc.Experience
+	21, // 19: jorgejr568.portfolio_grpc.UpdateExperienceRequest.update_mask:type_name -> google.protobuf.FieldMask
+	1,  // 20: jorgejr568.portfolio_grpc.UpdateExperienceResponse.experience:type_name -> jorgejr568.portfolio_grpc.Experience
+	1,  // 21: jorgejr568.portfolio_grpc.UndeleteExperienceResponse.experience:type_name -> jorgejr568.portfolio_grpc.Experience
+	0,  // 22: jorgejr568.portfolio_grpc.Experience.Technology.kind:type_name -> jorgejr568.portfolio_grpc.Experience.Technology.Kind
+	23, // [23:23] is the sub-list for method output_type
+	23, // [23:23] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_jorgejr568_portfolio_grpc_experiences_proto_init() }
@@ -436,18 +1264,21 @@ func file_jorgejr568_portfolio_grpc_experiences_proto_init() {
 	if File_jorgejr568_portfolio_grpc_experiences_proto != nil {
 		return
 	}
+	file_jorgejr568_portfolio_grpc_skills_proto_init()
+	file_jorgejr568_portfolio_grpc_visibility_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_jorgejr568_portfolio_grpc_experiences_proto_rawDesc), len(file_jorgejr568_portfolio_grpc_experiences_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   6,
+			NumEnums:      1,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_jorgejr568_portfolio_grpc_experiences_proto_goTypes,
 		DependencyIndexes: file_jorgejr568_portfolio_grpc_experiences_proto_depIdxs,
+		EnumInfos:         file_jorgejr568_portfolio_grpc_experiences_proto_enumTypes,
 		MessageInfos:      file_jorgejr568_portfolio_grpc_experiences_proto_msgTypes,
 	}.Build()
 	File_jorgejr568_portfolio_grpc_experiences_proto = out.File
@@ -0,0 +1,692 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: jorgejr568/portfolio_grpc/portfolio.proto
+
+package portfolio_grpc
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetPortfolioResponse_Section int32
+
+const (
+	GetPortfolioResponse_SECTION_UNSPECIFIED GetPortfolioResponse_Section = 0
+	GetPortfolioResponse_SECTION_SKILLS      GetPortfolioResponse_Section = 1
+	GetPortfolioResponse_SECTION_EXPERIENCES GetPortfolioResponse_Section = 2
+	GetPortfolioResponse_SECTION_EDUCATIONS  GetPortfolioResponse_Section = 3
+)
+
+// Enum value maps for GetPortfolioResponse_Section.
+var (
+	GetPortfolioResponse_Section_name = map[int32]string{
+		0: "SECTION_UNSPECIFIED",
+		1: "SECTION_SKILLS",
+		2: "SECTION_EXPERIENCES",
+		3: "SECTION_EDUCATIONS",
+	}
+	GetPortfolioResponse_Section_value = map[string]int32{
+		"SECTION_UNSPECIFIED": 0,
+		"SECTION_SKILLS":      1,
+		"SECTION_EXPERIENCES": 2,
+		"SECTION_EDUCATIONS":  3,
+	}
+)
+
+func (x GetPortfolioResponse_Section) Enum() *GetPortfolioResponse_Section {
+	p := new(GetPortfolioResponse_Section)
+	*p = x
+	return p
+}
+
+func (x GetPortfolioResponse_Section) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetPortfolioResponse_Section) Descriptor() protoreflect.EnumDescriptor {
+	return file_jorgejr568_portfolio_grpc_portfolio_proto_enumTypes[0].Descriptor()
+}
+
+func (GetPortfolioResponse_Section) Type() protoreflect.EnumType {
+	return &file_jorgejr568_portfolio_grpc_portfolio_proto_enumTypes[0]
+}
+
+func (x GetPortfolioResponse_Section) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetPortfolioResponse_Section.Descriptor instead.
+func (GetPortfolioResponse_Section) EnumDescriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_portfolio_proto_rawDescGZIP(), []int{1, 0}
+}
+
+type PortfolioChange_EntityType int32
+
+const (
+	PortfolioChange_ENTITY_TYPE_UNSPECIFIED PortfolioChange_EntityType = 0
+	PortfolioChange_ENTITY_TYPE_SKILL       PortfolioChange_EntityType = 1
+	PortfolioChange_ENTITY_TYPE_EXPERIENCE  PortfolioChange_EntityType = 2
+	PortfolioChange_ENTITY_TYPE_EDUCATION   PortfolioChange_EntityType = 3
+)
+
+// Enum value maps for PortfolioChange_EntityType.
+var (
+	PortfolioChange_EntityType_name = map[int32]string{
+		0: "ENTITY_TYPE_UNSPECIFIED",
+		1: "ENTITY_TYPE_SKILL",
+		2: "ENTITY_TYPE_EXPERIENCE",
+		3: "ENTITY_TYPE_EDUCATION",
+	}
+	PortfolioChange_EntityType_value = map[string]int32{
+		"ENTITY_TYPE_UNSPECIFIED": 0,
+		"ENTITY_TYPE_SKILL":       1,
+		"ENTITY_TYPE_EXPERIENCE":  2,
+		"ENTITY_TYPE_EDUCATION":   3,
+	}
+)
+
+func (x PortfolioChange_EntityType) Enum() *PortfolioChange_EntityType {
+	p := new(PortfolioChange_EntityType)
+	*p = x
+	return p
+}
+
+func (x PortfolioChange_EntityType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PortfolioChange_EntityType) Descriptor() protoreflect.EnumDescriptor {
+	return file_jorgejr568_portfolio_grpc_portfolio_proto_enumTypes[1].Descriptor()
+}
+
+func (PortfolioChange_EntityType) Type() protoreflect.EnumType {
+	return &file_jorgejr568_portfolio_grpc_portfolio_proto_enumTypes[1]
+}
+
+func (x PortfolioChange_EntityType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PortfolioChange_EntityType.Descriptor instead.
+func (PortfolioChange_EntityType) EnumDescriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_portfolio_proto_rawDescGZIP(), []int{2, 0}
+}
+
+type PortfolioChange_Operation int32
+
+const (
+	PortfolioChange_OPERATION_UNSPECIFIED PortfolioChange_Operation = 0
+	PortfolioChange_OPERATION_INSERT      PortfolioChange_Operation = 1
+	PortfolioChange_OPERATION_UPDATE      PortfolioChange_Operation = 2
+	PortfolioChange_OPERATION_DELETE      PortfolioChange_Operation = 3
+)
+
+// Enum value maps for PortfolioChange_Operation.
+var (
+	PortfolioChange_Operation_name = map[int32]string{
+		0: "OPERATION_UNSPECIFIED",
+		1: "OPERATION_INSERT",
+		2: "OPERATION_UPDATE",
+		3: "OPERATION_DELETE",
+	}
+	PortfolioChange_Operation_value = map[string]int32{
+		"OPERATION_UNSPECIFIED": 0,
+		"OPERATION_INSERT":      1,
+		"OPERATION_UPDATE":      2,
+		"OPERATION_DELETE":      3,
+	}
+)
+
+func (x PortfolioChange_Operation) Enum() *PortfolioChange_Operation {
+	p := new(PortfolioChange_Operation)
+	*p = x
+	return p
+}
+
+func (x PortfolioChange_Operation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PortfolioChange_Operation) Descriptor() protoreflect.EnumDescriptor {
+	return file_jorgejr568_portfolio_grpc_portfolio_proto_enumTypes[2].Descriptor()
+}
+
+func (PortfolioChange_Operation) Type() protoreflect.EnumType {
+	return &file_jorgejr568_portfolio_grpc_portfolio_proto_enumTypes[2]
+}
+
+func (x PortfolioChange_Operation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PortfolioChange_Operation.Descriptor instead.
+func (PortfolioChange_Operation) EnumDescriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_portfolio_proto_rawDescGZIP(), []int{2, 1}
+}
+
+type GetPortfolioRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPortfolioRequest) Reset() {
+	*x = GetPortfolioRequest{}
+	mi := &file_jorgejr568_portfolio_grpc_portfolio_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPortfolioRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPortfolioRequest) ProtoMessage() {}
+
+func (x *GetPortfolioRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_portfolio_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPortfolioRequest.ProtoReflect.Descriptor instead.
+func (*GetPortfolioRequest) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_portfolio_proto_rawDescGZIP(), []int{0}
+}
+
+type GetPortfolioResponse struct {
+	state         protoimpl.MessageState               `protogen:"open.v1"`
+	Skills        []*Skill                             `protobuf:"bytes,1,rep,name=skills,proto3" json:"skills,omitempty"`
+	Experiences   []*Experience                        `protobuf:"bytes,2,rep,name=experiences,proto3" json:"experiences,omitempty"`
+	Educations    []*Education                         `protobuf:"bytes,3,rep,name=educations,proto3" json:"educations,omitempty"`
+	Errors        []*GetPortfolioResponse_SectionError `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPortfolioResponse) Reset() {
+	*x = GetPortfolioResponse{}
+	mi := &file_jorgejr568_portfolio_grpc_portfolio_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPortfolioResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPortfolioResponse) ProtoMessage() {}
+
+func (x *GetPortfolioResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_portfolio_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPortfolioResponse.ProtoReflect.Descriptor instead.
+func (*GetPortfolioResponse) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_portfolio_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetPortfolioResponse) GetSkills() []*Skill {
+	if x != nil {
+		return x.Skills
+	}
+	return nil
+}
+
+func (x *GetPortfolioResponse) GetExperiences() []*Experience {
+	if x != nil {
+		return x.Experiences
+	}
+	return nil
+}
+
+func (x *GetPortfolioResponse) GetEducations() []*Education {
+	if x != nil {
+		return x.Educations
+	}
+	return nil
+}
+
+func (x *GetPortfolioResponse) GetErrors() []*GetPortfolioResponse_SectionError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// PortfolioChange describes a skill, experience or education that was
+// created, updated or deleted.
+type PortfolioChange struct {
+	state      protoimpl.MessageState     `protogen:"open.v1"`
+	EntityType PortfolioChange_EntityType `protobuf:"varint,1,opt,name=entity_type,json=entityType,proto3,enum=jorgejr568.portfolio_grpc.PortfolioChange_EntityType" json:"entity_type,omitempty"`
+	Id         int64                      `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Operation  PortfolioChange_Operation  `protobuf:"varint,3,opt,name=operation,proto3,enum=jorgejr568.portfolio_grpc.PortfolioChange_Operation" json:"operation,omitempty"`
+	// The entity as of the change. Unset for deletes.
+	//
+	// Types that are valid to be assigned to Value:
+	//
+	//	*PortfolioChange_Skill
+	//	*PortfolioChange_Experience
+	//	*PortfolioChange_Education
+	Value         isPortfolioChange_Value `protobuf_oneof:"value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PortfolioChange) Reset() {
+	*x = PortfolioChange{}
+	mi := &file_jorgejr568_portfolio_grpc_portfolio_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PortfolioChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortfolioChange) ProtoMessage() {}
+
+func (x *PortfolioChange) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_portfolio_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortfolioChange.ProtoReflect.Descriptor instead.
+func (*PortfolioChange) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_portfolio_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PortfolioChange) GetEntityType() PortfolioChange_EntityType {
+	if x != nil {
+		return x.EntityType
+	}
+	return PortfolioChange_ENTITY_TYPE_UNSPECIFIED
+}
+
+func (x *PortfolioChange) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PortfolioChange) GetOperation() PortfolioChange_Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return PortfolioChange_OPERATION_UNSPECIFIED
+}
+
+func (x *PortfolioChange) GetValue() isPortfolioChange_Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *PortfolioChange) GetSkill() *Skill {
+	if x != nil {
+		if x, ok := x.Value.(*PortfolioChange_Skill); ok {
+			return x.Skill
+		}
+	}
+	return nil
+}
+
+func (x *PortfolioChange) GetExperience() *Experience {
+	if x != nil {
+		if x, ok := x.Value.(*PortfolioChange_Experience); ok {
+			return x.Experience
+		}
+	}
+	return nil
+}
+
+func (x *PortfolioChange) GetEducation() *Education {
+	if x != nil {
+		if x, ok := x.Value.(*PortfolioChange_Education); ok {
+			return x.Education
+		}
+	}
+	return nil
+}
+
+type isPortfolioChange_Value interface {
+	isPortfolioChange_Value()
+}
+
+type PortfolioChange_Skill struct {
+	Skill *Skill `protobuf:"bytes,4,opt,name=skill,proto3,oneof"`
+}
+
+type PortfolioChange_Experience struct {
+	Experience *Experience `protobuf:"bytes,5,opt,name=experience,proto3,oneof"`
+}
+
+type PortfolioChange_Education struct {
+	Education *Education `protobuf:"bytes,6,opt,name=education,proto3,oneof"`
+}
+
+func (*PortfolioChange_Skill) isPortfolioChange_Value() {}
+
+func (*PortfolioChange_Experience) isPortfolioChange_Value() {}
+
+func (*PortfolioChange_Education) isPortfolioChange_Value() {}
+
+type WatchPortfolioRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only stream changes of these entity types. Every type is streamed when
+	// empty.
+	EntityTypes   []PortfolioChange_EntityType `protobuf:"varint,1,rep,packed,name=entity_types,json=entityTypes,proto3,enum=jorgejr568.portfolio_grpc.PortfolioChange_EntityType" json:"entity_types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchPortfolioRequest) Reset() {
+	*x = WatchPortfolioRequest{}
+	mi := &file_jorgejr568_portfolio_grpc_portfolio_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchPortfolioRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchPortfolioRequest) ProtoMessage() {}
+
+func (x *WatchPortfolioRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_portfolio_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchPortfolioRequest.ProtoReflect.Descriptor instead.
+func (*WatchPortfolioRequest) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_portfolio_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *WatchPortfolioRequest) GetEntityTypes() []PortfolioChange_EntityType {
+	if x != nil {
+		return x.EntityTypes
+	}
+	return nil
+}
+
+type WatchPortfolioResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Change        *PortfolioChange       `protobuf:"bytes,1,opt,name=change,proto3" json:"change,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchPortfolioResponse) Reset() {
+	*x = WatchPortfolioResponse{}
+	mi := &file_jorgejr568_portfolio_grpc_portfolio_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchPortfolioResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchPortfolioResponse) ProtoMessage() {}
+
+func (x *WatchPortfolioResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_portfolio_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchPortfolioResponse.ProtoReflect.Descriptor instead.
+func (*WatchPortfolioResponse) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_portfolio_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *WatchPortfolioResponse) GetChange() *PortfolioChange {
+	if x != nil {
+		return x.Change
+	}
+	return nil
+}
+
+// SectionError reports a section that could not be loaded. The other
+// sections are still returned.
+type GetPortfolioResponse_SectionError struct {
+	state   protoimpl.MessageState       `protogen:"open.v1"`
+	Section GetPortfolioResponse_Section `protobuf:"varint,1,opt,name=section,proto3,enum=jorgejr568.portfolio_grpc.GetPortfolioResponse_Section" json:"section,omitempty"`
+	// A google.rpc.Code value, such as 4 for DEADLINE_EXCEEDED.
+	Code          int32  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPortfolioResponse_SectionError) Reset() {
+	*x = GetPortfolioResponse_SectionError{}
+	mi := &file_jorgejr568_portfolio_grpc_portfolio_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPortfolioResponse_SectionError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPortfolioResponse_SectionError) ProtoMessage() {}
+
+func (x *GetPortfolioResponse_SectionError) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_portfolio_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPortfolioResponse_SectionError.ProtoReflect.Descriptor instead.
+func (*GetPortfolioResponse_SectionError) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_portfolio_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *GetPortfolioResponse_SectionError) GetSection() GetPortfolioResponse_Section {
+	if x != nil {
+		return x.Section
+	}
+	return GetPortfolioResponse_SECTION_UNSPECIFIED
+}
+
+func (x *GetPortfolioResponse_SectionError) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetPortfolioResponse_SectionError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_jorgejr568_portfolio_grpc_portfolio_proto protoreflect.FileDescriptor
+
+const file_jorgejr568_portfolio_grpc_portfolio_proto_rawDesc = "" +
+	"\n" +
+	")jorgejr568/portfolio_grpc/portfolio.proto\x12\x19jorgejr568.portfolio_grpc\x1a*jorgejr568/portfolio_grpc/educations.proto\x1a+jorgejr568/portfolio_grpc/experiences.proto\x1a&jorgejr568/portfolio_grpc/skills.proto\"\x15\n" +
+	"\x13GetPortfolioRequest\"\xb0\x04\n" +
+	"\x14GetPortfolioResponse\x128\n" +
+	"\x06skills\x18\x01 \x03(\v2 .jorgejr568.portfolio_grpc.SkillR\x06skills\x12G\n" +
+	"\vexperiences\x18\x02 \x03(\v2%.jorgejr568.portfolio_grpc.ExperienceR\vexperiences\x12D\n" +
+	"\n" +
+	"educations\x18\x03 \x03(\v2$.jorgejr568.portfolio_grpc.EducationR\n" +
+	"educations\x12T\n" +
+	"\x06errors\x18\x04 \x03(\v2<.jorgejr568.portfolio_grpc.GetPortfolioResponse.SectionErrorR\x06errors\x1a\x8f\x01\n" +
+	"\fSectionError\x12Q\n" +
+	"\asection\x18\x01 \x01(\x0e27.jorgejr568.portfolio_grpc.GetPortfolioResponse.SectionR\asection\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"g\n" +
+	"\aSection\x12\x17\n" +
+	"\x13SECTION_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eSECTION_SKILLS\x10\x01\x12\x17\n" +
+	"\x13SECTION_EXPERIENCES\x10\x02\x12\x16\n" +
+	"\x12SECTION_EDUCATIONS\x10\x03\"\x82\x05\n" +
+	"\x0fPortfolioChange\x12V\n" +
+	"\ventity_type\x18\x01 \x01(\x0e25.jorgejr568.portfolio_grpc.PortfolioChange.EntityTypeR\n" +
+	"entityType\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\x12R\n" +
+	"\toperation\x18\x03 \x01(\x0e24.jorgejr568.portfolio_grpc.PortfolioChange.OperationR\toperation\x128\n" +
+	"\x05skill\x18\x04 \x01(\v2 .jorgejr568.portfolio_grpc.SkillH\x00R\x05skill\x12G\n" +
+	"\n" +
+	"experience\x18\x05 \x01(\v2%.jorgejr568.portfolio_grpc.ExperienceH\x00R\n" +
+	"experience\x12D\n" +
+	"\teducation\x18\x06 \x01(\v2$.jorgejr568.portfolio_grpc.EducationH\x00R\teducation\"w\n" +
+	"\n" +
+	"EntityType\x12\x1b\n" +
+	"\x17ENTITY_TYPE_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11ENTITY_TYPE_SKILL\x10\x01\x12\x1a\n" +
+	"\x16ENTITY_TYPE_EXPERIENCE\x10\x02\x12\x19\n" +
+	"\x15ENTITY_TYPE_EDUCATION\x10\x03\"h\n" +
+	"\tOperation\x12\x19\n" +
+	"\x15OPERATION_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10OPERATION_INSERT\x10\x01\x12\x14\n" +
+	"\x10OPERATION_UPDATE\x10\x02\x12\x14\n" +
+	"\x10OPERATION_DELETE\x10\x03B\a\n" +
+	"\x05value\"q\n" +
+	"\x15WatchPortfolioRequest\x12X\n" +
+	"\fentity_types\x18\x01 \x03(\x0e25.jorgejr568.portfolio_grpc.PortfolioChange.EntityTypeR\ventityTypes\"\\\n" +
+	"\x16WatchPortfolioResponse\x12B\n" +
+	"\x06change\x18\x01 \x01(\v2*.jorgejr568.portfolio_grpc.PortfolioChangeR\x06changeB\xf7\x01\n" +
+	"\x1dcom.jorgejr568.portfolio_grpcB\x0ePortfolioProtoP\x01ZEgithub.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc\xa2\x02\x03JPX\xaa\x02\x18Jorgejr568.PortfolioGrpc\xca\x02\x18Jorgejr568\\PortfolioGrpc\xe2\x02$Jorgejr568\\PortfolioGrpc\\GPBMetadata\xea\x02\x19Jorgejr568::PortfolioGrpcb\x06proto3"
+
+var (
+	file_jorgejr568_portfolio_grpc_portfolio_proto_rawDescOnce sync.Once
+	file_jorgejr568_portfolio_grpc_portfolio_proto_rawDescData []byte
+)
+
+func file_jorgejr568_portfolio_grpc_portfolio_proto_rawDescGZIP() []byte {
+	file_jorgejr568_portfolio_grpc_portfolio_proto_rawDescOnce.Do(func() {
+		file_jorgejr568_portfolio_grpc_portfolio_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_jorgejr568_portfolio_grpc_portfolio_proto_rawDesc), len(file_jorgejr568_portfolio_grpc_portfolio_proto_rawDesc)))
+	})
+	return file_jorgejr568_portfolio_grpc_portfolio_proto_rawDescData
+}
+
+var file_jorgejr568_portfolio_grpc_portfolio_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_jorgejr568_portfolio_grpc_portfolio_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_jorgejr568_portfolio_grpc_portfolio_proto_goTypes = []any{
+	(GetPortfolioResponse_Section)(0),         // 0: jorgejr568.portfolio_grpc.GetPortfolioResponse.Section
+	(PortfolioChange_EntityType)(0),           // 1: jorgejr568.portfolio_grpc.PortfolioChange.EntityType
+	(PortfolioChange_Operation)(0),            // 2: jorgejr568.portfolio_grpc.PortfolioChange.Operation
+	(*GetPortfolioRequest)(nil),               // 3: jorgejr568.portfolio_grpc.GetPortfolioRequest
+	(*GetPortfolioResponse)(nil),              // 4: jorgejr568.portfolio_grpc.GetPortfolioResponse
+	(*PortfolioChange)(nil),                   // 5: jorgejr568.portfolio_grpc.PortfolioChange
+	(*WatchPortfolioRequest)(nil),             // 6: jorgejr568.portfolio_grpc.WatchPortfolioRequest
+	(*WatchPortfolioResponse)(nil),            // 7: jorgejr568.portfolio_grpc.WatchPortfolioResponse
+	(*GetPortfolioResponse_SectionError)(nil), // 8: jorgejr568.portfolio_grpc.GetPortfolioResponse.SectionError
+	(*Skill)(nil),                             // 9: jorgejr568.portfolio_grpc.Skill
+	(*Experience)(nil),                        // 10: jorgejr568.portfolio_grpc.Experience
+	(*Education)(nil),                         // 11: jorgejr568.portfolio_grpc.Education
+}
+var file_jorgejr568_portfolio_grpc_portfolio_proto_depIdxs = []int32{
+	9,  // 0: jorgejr568.portfolio_grpc.GetPortfolioResponse.skills:type_name -> jorgejr568.portfolio_grpc.Skill
+	10, // 1: jorgejr568.portfolio_grpc.GetPortfolioResponse.experiences:type_name -> jorgejr568.portfolio_grpc.Experience
+	11, // 2: jorgejr568.portfolio_grpc.GetPortfolioResponse.educations:type_name -> jorgejr568.portfolio_grpc.Education
+	8,  // 3: jorgejr568.portfolio_grpc.GetPortfolioResponse.errors:type_name -> jorgejr568.portfolio_grpc.GetPortfolioResponse.SectionError
+	1,  // 4: jorgejr568.portfolio_grpc.PortfolioChange.entity_type:type_name -> jorgejr568.portfolio_grpc.PortfolioChange.EntityType
+	2,  // 5: jorgejr568.portfolio_grpc.PortfolioChange.operation:type_name -> jorgejr568.portfolio_grpc.PortfolioChange.Operation
+	9,  // 6: jorgejr568.portfolio_grpc.PortfolioChange.skill:type_name -> jorgejr568.portfolio_grpc.Skill
+	10, // 7: jorgejr568.portfolio_grpc.PortfolioChange.experience:type_name -> jorgejr568.portfolio_grpc.Experience
+	11, // 8: jorgejr568.portfolio_grpc.PortfolioChange.education:type_name -> jorgejr568.portfolio_grpc.Education
+	1,  // 9: jorgejr568.portfolio_grpc.WatchPortfolioRequest.entity_types:type_name -> jorgejr568.portfolio_grpc.PortfolioChange.EntityType
+	5,  // 10: jorgejr568.portfolio_grpc.WatchPortfolioResponse.change:type_name -> jorgejr568.portfolio_grpc.PortfolioChange
+	0,  // 11: jorgejr568.portfolio_grpc.GetPortfolioResponse.SectionError.section:type_name -> jorgejr568.portfolio_grpc.GetPortfolioResponse.Section
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_jorgejr568_portfolio_grpc_portfolio_proto_init() }
+func file_jorgejr568_portfolio_grpc_portfolio_proto_init() {
+	if File_jorgejr568_portfolio_grpc_portfolio_proto != nil {
+		return
+	}
+	file_jorgejr568_portfolio_grpc_educations_proto_init()
+	file_jorgejr568_portfolio_grpc_experiences_proto_init()
+	file_jorgejr568_portfolio_grpc_skills_proto_init()
+	file_jorgejr568_portfolio_grpc_portfolio_proto_msgTypes[2].OneofWrappers = []any{
+		(*PortfolioChange_Skill)(nil),
+		(*PortfolioChange_Experience)(nil),
+		(*PortfolioChange_Education)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_jorgejr568_portfolio_grpc_portfolio_proto_rawDesc), len(file_jorgejr568_portfolio_grpc_portfolio_proto_rawDesc)),
+			NumEnums:      3,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_jorgejr568_portfolio_grpc_portfolio_proto_goTypes,
+		DependencyIndexes: file_jorgejr568_portfolio_grpc_portfolio_proto_depIdxs,
+		EnumInfos:         file_jorgejr568_portfolio_grpc_portfolio_proto_enumTypes,
+		MessageInfos:      file_jorgejr568_portfolio_grpc_portfolio_proto_msgTypes,
+	}.Build()
+	File_jorgejr568_portfolio_grpc_portfolio_proto = out.File
+	file_jorgejr568_portfolio_grpc_portfolio_proto_goTypes = nil
+	file_jorgejr568_portfolio_grpc_portfolio_proto_depIdxs = nil
+}
@@ -0,0 +1,541 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: jorgejr568/portfolio_grpc/profile.proto
+
+package portfolio_grpc
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Profile_SocialLink_Kind int32
+
+const (
+	Profile_SocialLink_KIND_UNSPECIFIED Profile_SocialLink_Kind = 0
+	Profile_SocialLink_KIND_GITHUB      Profile_SocialLink_Kind = 1
+	Profile_SocialLink_KIND_LINKEDIN    Profile_SocialLink_Kind = 2
+	Profile_SocialLink_KIND_MASTODON    Profile_SocialLink_Kind = 3
+	Profile_SocialLink_KIND_TWITTER     Profile_SocialLink_Kind = 4
+	Profile_SocialLink_KIND_WEBSITE     Profile_SocialLink_Kind = 5
+	Profile_SocialLink_KIND_OTHER       Profile_SocialLink_Kind = 6
+)
+
+// Enum value maps for Profile_SocialLink_Kind.
+var (
+	Profile_SocialLink_Kind_name = map[int32]string{
+		0: "KIND_UNSPECIFIED",
+		1: "KIND_GITHUB",
+		2: "KIND_LINKEDIN",
+		3: "KIND_MASTODON",
+		4: "KIND_TWITTER",
+		5: "KIND_WEBSITE",
+		6: "KIND_OTHER",
+	}
+	Profile_SocialLink_Kind_value = map[string]int32{
+		"KIND_UNSPECIFIED": 0,
+		"KIND_GITHUB":      1,
+		"KIND_LINKEDIN":    2,
+		"KIND_MASTODON":    3,
+		"KIND_TWITTER":     4,
+		"KIND_WEBSITE":     5,
+		"KIND_OTHER":       6,
+	}
+)
+
+func (x Profile_SocialLink_Kind) Enum() *Profile_SocialLink_Kind {
+	p := new(Profile_SocialLink_Kind)
+	*p = x
+	return p
+}
+
+func (x Profile_SocialLink_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Profile_SocialLink_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_jorgejr568_portfolio_grpc_profile_proto_enumTypes[0].Descriptor()
+}
+
+func (Profile_SocialLink_Kind) Type() protoreflect.EnumType {
+	return &file_jorgejr568_portfolio_grpc_profile_proto_enumTypes[0]
+}
+
+func (x Profile_SocialLink_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Profile_SocialLink_Kind.Descriptor instead.
+func (Profile_SocialLink_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_profile_proto_rawDescGZIP(), []int{0, 0, 0}
+}
+
+// Profile describes the owner of the portfolio. There is exactly one.
+type Profile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Headline      string                 `protobuf:"bytes,2,opt,name=headline,proto3" json:"headline,omitempty"`
+	Bio           string                 `protobuf:"bytes,3,opt,name=bio,proto3" json:"bio,omitempty"`
+	AvatarUrl     string                 `protobuf:"bytes,4,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	Location      string                 `protobuf:"bytes,5,opt,name=location,proto3" json:"location,omitempty"`
+	ContactEmail  string                 `protobuf:"bytes,6,opt,name=contact_email,json=contactEmail,proto3" json:"contact_email,omitempty"`
+	SocialLinks   []*Profile_SocialLink  `protobuf:"bytes,7,rep,name=social_links,json=socialLinks,proto3" json:"social_links,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Profile) Reset() {
+	*x = Profile{}
+	mi := &file_jorgejr568_portfolio_grpc_profile_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Profile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Profile) ProtoMessage() {}
+
+func (x *Profile) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_profile_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Profile.ProtoReflect.Descriptor instead.
+func (*Profile) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_profile_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Profile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Profile) GetHeadline() string {
+	if x != nil {
+		return x.Headline
+	}
+	return ""
+}
+
+func (x *Profile) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *Profile) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *Profile) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *Profile) GetContactEmail() string {
+	if x != nil {
+		return x.ContactEmail
+	}
+	return ""
+}
+
+func (x *Profile) GetSocialLinks() []*Profile_SocialLink {
+	if x != nil {
+		return x.SocialLinks
+	}
+	return nil
+}
+
+func (x *Profile) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type GetProfileRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Fields of the profile to return. Every field is returned when unset.
+	ReadMask      *fieldmaskpb.FieldMask `protobuf:"bytes,1,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProfileRequest) Reset() {
+	*x = GetProfileRequest{}
+	mi := &file_jorgejr568_portfolio_grpc_profile_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfileRequest) ProtoMessage() {}
+
+func (x *GetProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_profile_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetProfileRequest) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_profile_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetProfileRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+type GetProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *Profile               `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProfileResponse) Reset() {
+	*x = GetProfileResponse{}
+	mi := &file_jorgejr568_portfolio_grpc_profile_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfileResponse) ProtoMessage() {}
+
+func (x *GetProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_profile_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfileResponse.ProtoReflect.Descriptor instead.
+func (*GetProfileResponse) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_profile_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetProfileResponse) GetProfile() *Profile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type UpdateProfileRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Profile *Profile               `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	// Fields of profile to update. An empty mask updates every mutable field.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProfileRequest) Reset() {
+	*x = UpdateProfileRequest{}
+	mi := &file_jorgejr568_portfolio_grpc_profile_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileRequest) ProtoMessage() {}
+
+func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_profile_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProfileRequest) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_profile_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateProfileRequest) GetProfile() *Profile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+func (x *UpdateProfileRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type UpdateProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *Profile               `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProfileResponse) Reset() {
+	*x = UpdateProfileResponse{}
+	mi := &file_jorgejr568_portfolio_grpc_profile_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileResponse) ProtoMessage() {}
+
+func (x *UpdateProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_profile_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProfileResponse) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_profile_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateProfileResponse) GetProfile() *Profile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type Profile_SocialLink struct {
+	state protoimpl.MessageState  `protogen:"open.v1"`
+	Kind  Profile_SocialLink_Kind `protobuf:"varint,1,opt,name=kind,proto3,enum=jorgejr568.portfolio_grpc.Profile_SocialLink_Kind" json:"kind,omitempty"`
+	Url   string                  `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// Optional display text, such as the handle.
+	Label         string `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Profile_SocialLink) Reset() {
+	*x = Profile_SocialLink{}
+	mi := &file_jorgejr568_portfolio_grpc_profile_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Profile_SocialLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Profile_SocialLink) ProtoMessage() {}
+
+func (x *Profile_SocialLink) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_profile_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Profile_SocialLink.ProtoReflect.Descriptor instead.
+func (*Profile_SocialLink) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_profile_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Profile_SocialLink) GetKind() Profile_SocialLink_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return Profile_SocialLink_KIND_UNSPECIFIED
+}
+
+func (x *Profile_SocialLink) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Profile_SocialLink) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+var File_jorgejr568_portfolio_grpc_profile_proto protoreflect.FileDescriptor
+
+const file_jorgejr568_portfolio_grpc_profile_proto_rawDesc = "" +
+	"\n" +
+	"'jorgejr568/portfolio_grpc/profile.proto\x12\x19jorgejr568.portfolio_grpc\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc1\x04\n" +
+	"\aProfile\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\bheadline\x18\x02 \x01(\tR\bheadline\x12\x10\n" +
+	"\x03bio\x18\x03 \x01(\tR\x03bio\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x04 \x01(\tR\tavatarUrl\x12\x1a\n" +
+	"\blocation\x18\x05 \x01(\tR\blocation\x12#\n" +
+	"\rcontact_email\x18\x06 \x01(\tR\fcontactEmail\x12P\n" +
+	"\fsocial_links\x18\a \x03(\v2-.jorgejr568.portfolio_grpc.Profile.SocialLinkR\vsocialLinks\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x1a\x86\x02\n" +
+	"\n" +
+	"SocialLink\x12F\n" +
+	"\x04kind\x18\x01 \x01(\x0e22.jorgejr568.portfolio_grpc.Profile.SocialLink.KindR\x04kind\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x14\n" +
+	"\x05label\x18\x03 \x01(\tR\x05label\"\x87\x01\n" +
+	"\x04Kind\x12\x14\n" +
+	"\x10KIND_UNSPECIFIED\x10\x00\x12\x0f\n" +
+	"\vKIND_GITHUB\x10\x01\x12\x11\n" +
+	"\rKIND_LINKEDIN\x10\x02\x12\x11\n" +
+	"\rKIND_MASTODON\x10\x03\x12\x10\n" +
+	"\fKIND_TWITTER\x10\x04\x12\x10\n" +
+	"\fKIND_WEBSITE\x10\x05\x12\x0e\n" +
+	"\n" +
+	"KIND_OTHER\x10\x06\"L\n" +
+	"\x11GetProfileRequest\x127\n" +
+	"\tread_mask\x18\x01 \x01(\v2\x1a.google.protobuf.FieldMaskR\breadMask\"R\n" +
+	"\x12GetProfileResponse\x12<\n" +
+	"\aprofile\x18\x01 \x01(\v2\".jorgejr568.portfolio_grpc.ProfileR\aprofile\"\x91\x01\n" +
+	"\x14UpdateProfileRequest\x12<\n" +
+	"\aprofile\x18\x01 \x01(\v2\".jorgejr568.portfolio_grpc.ProfileR\aprofile\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"U\n" +
+	"\x15UpdateProfileResponse\x12<\n" +
+	"\aprofile\x18\x01 \x01(\v2\".jorgejr568.portfolio_grpc.ProfileR\aprofileB\xf5\x01\n" +
+	"\x1dcom.jorgejr568.portfolio_grpcB\fProfileProtoP\x01ZEgithub.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc\xa2\x02\x03JPX\xaa\x02\x18Jorgejr568.PortfolioGrpc\xca\x02\x18Jorgejr568\\PortfolioGrpc\xe2\x02$Jorgejr568\\PortfolioGrpc\\GPBMetadata\xea\x02\x19Jorgejr568::PortfolioGrpcb\x06proto3"
+
+var (
+	file_jorgejr568_portfolio_grpc_profile_proto_rawDescOnce sync.Once
+	file_jorgejr568_portfolio_grpc_profile_proto_rawDescData []byte
+)
+
+func file_jorgejr568_portfolio_grpc_profile_proto_rawDescGZIP() []byte {
+	file_jorgejr568_portfolio_grpc_profile_proto_rawDescOnce.Do(func() {
+		file_jorgejr568_portfolio_grpc_profile_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_jorgejr568_portfolio_grpc_profile_proto_rawDesc), len(file_jorgejr568_portfolio_grpc_profile_proto_rawDesc)))
+	})
+	return file_jorgejr568_portfolio_grpc_profile_proto_rawDescData
+}
+
+var file_jorgejr568_portfolio_grpc_profile_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_jorgejr568_portfolio_grpc_profile_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_jorgejr568_portfolio_grpc_profile_proto_goTypes = []any{
+	(Profile_SocialLink_Kind)(0),  // 0: jorgejr568.portfolio_grpc.Profile.SocialLink.Kind
+	(*Profile)(nil),               // 1: jorgejr568.portfolio_grpc.Profile
+	(*GetProfileRequest)(nil),     // 2: jorgejr568.portfolio_grpc.GetProfileRequest
+	(*GetProfileResponse)(nil),    // 3: jorgejr568.portfolio_grpc.GetProfileResponse
+	(*UpdateProfileRequest)(nil),  // 4: jorgejr568.portfolio_grpc.UpdateProfileRequest
+	(*UpdateProfileResponse)(nil), // 5: jorgejr568.portfolio_grpc.UpdateProfileResponse
+	(*Profile_SocialLink)(nil),    // 6: jorgejr568.portfolio_grpc.Profile.SocialLink
+	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil), // 8: google.protobuf.FieldMask
+}
+var file_jorgejr568_portfolio_grpc_profile_proto_depIdxs = []int32{
+	6, // 0: jorgejr568.portfolio_grpc.Profile.social_links:type_name -> jorgejr568.portfolio_grpc.Profile.SocialLink
+	7, // 1: jorgejr568.portfolio_grpc.Profile.updated_at:type_name -> google.protobuf.Timestamp
+	8, // 2: jorgejr568.portfolio_grpc.GetProfileRequest.read_mask:type_name -> google.protobuf.FieldMask
+	1, // 3: jorgejr568.portfolio_grpc.GetProfileResponse.profile:type_name -> jorgejr568.portfolio_grpc.Profile
+	1, // 4: jorgejr568.portfolio_grpc.UpdateProfileRequest.profile:type_name -> jorgejr568.portfolio_grpc.Profile
+	8, // 5: jorgejr568.portfolio_grpc.UpdateProfileRequest.update_mask:type_name -> google.protobuf.FieldMask
+	1, // 6: jorgejr568.portfolio_grpc.UpdateProfileResponse.profile:type_name -> jorgejr568.portfolio_grpc.Profile
+	0, // 7: jorgejr568.portfolio_grpc.Profile.SocialLink.kind:type_name -> jorgejr568.portfolio_grpc.Profile.SocialLink.Kind
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_jorgejr568_portfolio_grpc_profile_proto_init() }
+func file_jorgejr568_portfolio_grpc_profile_proto_init() {
+	if File_jorgejr568_portfolio_grpc_profile_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_jorgejr568_portfolio_grpc_profile_proto_rawDesc), len(file_jorgejr568_portfolio_grpc_profile_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_jorgejr568_portfolio_grpc_profile_proto_goTypes,
+		DependencyIndexes: file_jorgejr568_portfolio_grpc_profile_proto_depIdxs,
+		EnumInfos:         file_jorgejr568_portfolio_grpc_profile_proto_enumTypes,
+		MessageInfos:      file_jorgejr568_portfolio_grpc_profile_proto_msgTypes,
+	}.Build()
+	File_jorgejr568_portfolio_grpc_profile_proto = out.File
+	file_jorgejr568_portfolio_grpc_profile_proto_goTypes = nil
+	file_jorgejr568_portfolio_grpc_profile_proto_depIdxs = nil
+}
@@ -0,0 +1,482 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: jorgejr568/portfolio_grpc/projects.proto
+
+package portfolio_grpc
+
+import (
+	date "google.golang.org/genproto/googleapis/type/date"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Project struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	RepoUrl       string                 `protobuf:"bytes,4,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"`
+	DemoUrl       string                 `protobuf:"bytes,5,opt,name=demo_url,json=demoUrl,proto3" json:"demo_url,omitempty"`
+	CoverImageUrl string                 `protobuf:"bytes,6,opt,name=cover_image_url,json=coverImageUrl,proto3" json:"cover_image_url,omitempty"`
+	Technologies  []string               `protobuf:"bytes,7,rep,name=technologies,proto3" json:"technologies,omitempty"`
+	StartedAt     *date.Date             `protobuf:"bytes,8,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	EndedAt       *date.Date             `protobuf:"bytes,9,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Project) Reset() {
+	*x = Project{}
+	mi := &file_jorgejr568_portfolio_grpc_projects_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Project) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Project) ProtoMessage() {}
+
+func (x *Project) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_projects_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Project.ProtoReflect.Descriptor instead.
+func (*Project) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_projects_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Project) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Project) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Project) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Project) GetRepoUrl() string {
+	if x != nil {
+		return x.RepoUrl
+	}
+	return ""
+}
+
+func (x *Project) GetDemoUrl() string {
+	if x != nil {
+		return x.DemoUrl
+	}
+	return ""
+}
+
+func (x *Project) GetCoverImageUrl() string {
+	if x != nil {
+		return x.CoverImageUrl
+	}
+	return ""
+}
+
+func (x *Project) GetTechnologies() []string {
+	if x != nil {
+		return x.Technologies
+	}
+	return nil
+}
+
+func (x *Project) GetStartedAt() *date.Date {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *Project) GetEndedAt() *date.Date {
+	if x != nil {
+		return x.EndedAt
+	}
+	return nil
+}
+
+func (x *Project) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Project) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type GetAllProjectsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Maximum number of results to return. Defaults to 50 and is capped at 1000.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token of a previous response, to fetch the following page.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// AIP-160 filter, e.g. `technologies:"Go"`.
+	Filter string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Comma separated fields to sort by, each optionally followed by "desc",
+	// e.g. `started_at desc`.
+	OrderBy string `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// Fields of each project to return. Every field is returned when unset.
+	ReadMask      *fieldmaskpb.FieldMask `protobuf:"bytes,5,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllProjectsRequest) Reset() {
+	*x = GetAllProjectsRequest{}
+	mi := &file_jorgejr568_portfolio_grpc_projects_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllProjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllProjectsRequest) ProtoMessage() {}
+
+func (x *GetAllProjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_projects_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllProjectsRequest.ProtoReflect.Descriptor instead.
+func (*GetAllProjectsRequest) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_projects_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetAllProjectsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetAllProjectsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetAllProjectsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *GetAllProjectsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *GetAllProjectsRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+type GetAllProjectsResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Projects []*Project             `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
+	// Token for the next page, empty when there are no more results.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllProjectsResponse) Reset() {
+	*x = GetAllProjectsResponse{}
+	mi := &file_jorgejr568_portfolio_grpc_projects_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllProjectsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllProjectsResponse) ProtoMessage() {}
+
+func (x *GetAllProjectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_projects_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllProjectsResponse.ProtoReflect.Descriptor instead.
+func (*GetAllProjectsResponse) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_projects_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetAllProjectsResponse) GetProjects() []*Project {
+	if x != nil {
+		return x.Projects
+	}
+	return nil
+}
+
+func (x *GetAllProjectsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetProjectRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Fields of the project to return. Every field is returned when unset.
+	ReadMask      *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProjectRequest) Reset() {
+	*x = GetProjectRequest{}
+	mi := &file_jorgejr568_portfolio_grpc_projects_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectRequest) ProtoMessage() {}
+
+func (x *GetProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_projects_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectRequest.ProtoReflect.Descriptor instead.
+func (*GetProjectRequest) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_projects_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetProjectRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetProjectRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+type GetProjectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Project       *Project               `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProjectResponse) Reset() {
+	*x = GetProjectResponse{}
+	mi := &file_jorgejr568_portfolio_grpc_projects_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectResponse) ProtoMessage() {}
+
+func (x *GetProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_projects_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectResponse.ProtoReflect.Descriptor instead.
+func (*GetProjectResponse) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_projects_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetProjectResponse) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+var File_jorgejr568_portfolio_grpc_projects_proto protoreflect.FileDescriptor
+
+const file_jorgejr568_portfolio_grpc_projects_proto_rawDesc = "" +
+	"\n" +
+	"(jorgejr568/portfolio_grpc/projects.proto\x12\x19jorgejr568.portfolio_grpc\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16google/type/date.proto\"\xa9\x03\n" +
+	"\aProject\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x19\n" +
+	"\brepo_url\x18\x04 \x01(\tR\arepoUrl\x12\x19\n" +
+	"\bdemo_url\x18\x05 \x01(\tR\ademoUrl\x12&\n" +
+	"\x0fcover_image_url\x18\x06 \x01(\tR\rcoverImageUrl\x12\"\n" +
+	"\ftechnologies\x18\a \x03(\tR\ftechnologies\x120\n" +
+	"\n" +
+	"started_at\x18\b \x01(\v2\x11.google.type.DateR\tstartedAt\x12,\n" +
+	"\bended_at\x18\t \x01(\v2\x11.google.type.DateR\aendedAt\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xbf\x01\n" +
+	"\x15GetAllProjectsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06filter\x18\x03 \x01(\tR\x06filter\x12\x19\n" +
+	"\border_by\x18\x04 \x01(\tR\aorderBy\x127\n" +
+	"\tread_mask\x18\x05 \x01(\v2\x1a.google.protobuf.FieldMaskR\breadMask\"\x80\x01\n" +
+	"\x16GetAllProjectsResponse\x12>\n" +
+	"\bprojects\x18\x01 \x03(\v2\".jorgejr568.portfolio_grpc.ProjectR\bprojects\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\\\n" +
+	"\x11GetProjectRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x127\n" +
+	"\tread_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\breadMask\"R\n" +
+	"\x12GetProjectResponse\x12<\n" +
+	"\aproject\x18\x01 \x01(\v2\".jorgejr568.portfolio_grpc.ProjectR\aprojectB\xf6\x01\n" +
+	"\x1dcom.jorgejr568.portfolio_grpcB\rProjectsProtoP\x01ZEgithub.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc\xa2\x02\x03JPX\xaa\x02\x18Jorgejr568.PortfolioGrpc\xca\x02\x18Jorgejr568\\PortfolioGrpc\xe2\x02$Jorgejr568\\PortfolioGrpc\\GPBMetadata\xea\x02\x19Jorgejr568::PortfolioGrpcb\x06proto3"
+
+var (
+	file_jorgejr568_portfolio_grpc_projects_proto_rawDescOnce sync.Once
+	file_jorgejr568_portfolio_grpc_projects_proto_rawDescData []byte
+)
+
+func file_jorgejr568_portfolio_grpc_projects_proto_rawDescGZIP() []byte {
+	file_jorgejr568_portfolio_grpc_projects_proto_rawDescOnce.Do(func() {
+		file_jorgejr568_portfolio_grpc_projects_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_jorgejr568_portfolio_grpc_projects_proto_rawDesc), len(file_jorgejr568_portfolio_grpc_projects_proto_rawDesc)))
+	})
+	return file_jorgejr568_portfolio_grpc_projects_proto_rawDescData
+}
+
+var file_jorgejr568_portfolio_grpc_projects_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_jorgejr568_portfolio_grpc_projects_proto_goTypes = []any{
+	(*Project)(nil),                // 0: jorgejr568.portfolio_grpc.Project
+	(*GetAllProjectsRequest)(nil),  // 1: jorgejr568.portfolio_grpc.GetAllProjectsRequest
+	(*GetAllProjectsResponse)(nil), // 2: jorgejr568.portfolio_grpc.GetAllProjectsResponse
+	(*GetProjectRequest)(nil),      // 3: jorgejr568.portfolio_grpc.GetProjectRequest
+	(*GetProjectResponse)(nil),     // 4: jorgejr568.portfolio_grpc.GetProjectResponse
+	(*date.Date)(nil),              // 5: google.type.Date
+	(*timestamppb.Timestamp)(nil),  // 6: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),  // 7: google.protobuf.FieldMask
+}
+var file_jorgejr568_portfolio_grpc_projects_proto_depIdxs = []int32{
+	5, // 0: jorgejr568.portfolio_grpc.Project.started_at:type_name -> google.type.Date
+	5, // 1: jorgejr568.portfolio_grpc.Project.ended_at:type_name -> google.type.Date
+	6, // 2: jorgejr568.portfolio_grpc.Project.created_at:type_name -> google.protobuf.Timestamp
+	6, // 3: jorgejr568.portfolio_grpc.Project.updated_at:type_name -> google.protobuf.Timestamp
+	7, // 4: jorgejr568.portfolio_grpc.GetAllProjectsRequest.read_mask:type_name -> google.protobuf.FieldMask
+	0, // 5: jorgejr568.portfolio_grpc.GetAllProjectsResponse.projects:type_name -> jorgejr568.portfolio_grpc.Project
+	7, // 6: jorgejr568.portfolio_grpc.GetProjectRequest.read_mask:type_name -> google.protobuf.FieldMask
+	0, // 7: jorgejr568.portfolio_grpc.GetProjectResponse.project:type_name -> jorgejr568.portfolio_grpc.Project
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_jorgejr568_portfolio_grpc_projects_proto_init() }
+func file_jorgejr568_portfolio_grpc_projects_proto_init() {
+	if File_jorgejr568_portfolio_grpc_projects_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_jorgejr568_portfolio_grpc_projects_proto_rawDesc), len(file_jorgejr568_portfolio_grpc_projects_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_jorgejr568_portfolio_grpc_projects_proto_goTypes,
+		DependencyIndexes: file_jorgejr568_portfolio_grpc_projects_proto_depIdxs,
+		MessageInfos:      file_jorgejr568_portfolio_grpc_projects_proto_msgTypes,
+	}.Build()
+	File_jorgejr568_portfolio_grpc_projects_proto = out.File
+	file_jorgejr568_portfolio_grpc_projects_proto_goTypes = nil
+	file_jorgejr568_portfolio_grpc_projects_proto_depIdxs = nil
+}
@@ -0,0 +1,546 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: jorgejr568/portfolio_grpc/publications.proto
+
+package portfolio_grpc
+
+import (
+	date "google.golang.org/genproto/googleapis/type/date"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Publication_Kind int32
+
+const (
+	Publication_KIND_UNSPECIFIED Publication_Kind = 0
+	Publication_KIND_TALK        Publication_Kind = 1
+	Publication_KIND_ARTICLE     Publication_Kind = 2
+	Publication_KIND_PAPER       Publication_Kind = 3
+)
+
+// Enum value maps for Publication_Kind.
+var (
+	Publication_Kind_name = map[int32]string{
+		0: "KIND_UNSPECIFIED",
+		1: "KIND_TALK",
+		2: "KIND_ARTICLE",
+		3: "KIND_PAPER",
+	}
+	Publication_Kind_value = map[string]int32{
+		"KIND_UNSPECIFIED": 0,
+		"KIND_TALK":        1,
+		"KIND_ARTICLE":     2,
+		"KIND_PAPER":       3,
+	}
+)
+
+func (x Publication_Kind) Enum() *Publication_Kind {
+	p := new(Publication_Kind)
+	*p = x
+	return p
+}
+
+func (x Publication_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Publication_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_jorgejr568_portfolio_grpc_publications_proto_enumTypes[0].Descriptor()
+}
+
+func (Publication_Kind) Type() protoreflect.EnumType {
+	return &file_jorgejr568_portfolio_grpc_publications_proto_enumTypes[0]
+}
+
+func (x Publication_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Publication_Kind.Descriptor instead.
+func (Publication_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_publications_proto_rawDescGZIP(), []int{0, 0}
+}
+
+// Publication is a conference talk or a written publication.
+type Publication struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind        Publication_Kind       `protobuf:"varint,2,opt,name=kind,proto3,enum=jorgejr568.portfolio_grpc.Publication_Kind" json:"kind,omitempty"`
+	Title       string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// Conference, meetup or journal the publication was presented at.
+	Venue         string                 `protobuf:"bytes,5,opt,name=venue,proto3" json:"venue,omitempty"`
+	PublishedAt   *date.Date             `protobuf:"bytes,6,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
+	SlidesUrl     string                 `protobuf:"bytes,7,opt,name=slides_url,json=slidesUrl,proto3" json:"slides_url,omitempty"`
+	VideoUrl      string                 `protobuf:"bytes,8,opt,name=video_url,json=videoUrl,proto3" json:"video_url,omitempty"`
+	CoAuthors     []string               `protobuf:"bytes,9,rep,name=co_authors,json=coAuthors,proto3" json:"co_authors,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Publication) Reset() {
+	*x = Publication{}
+	mi := &file_jorgejr568_portfolio_grpc_publications_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Publication) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Publication) ProtoMessage() {}
+
+func (x *Publication) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_publications_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Publication.ProtoReflect.Descriptor instead.
+func (*Publication) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_publications_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Publication) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Publication) GetKind() Publication_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return Publication_KIND_UNSPECIFIED
+}
+
+func (x *Publication) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Publication) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Publication) GetVenue() string {
+	if x != nil {
+		return x.Venue
+	}
+	return ""
+}
+
+func (x *Publication) GetPublishedAt() *date.Date {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return nil
+}
+
+func (x *Publication) GetSlidesUrl() string {
+	if x != nil {
+		return x.SlidesUrl
+	}
+	return ""
+}
+
+func (x *Publication) GetVideoUrl() string {
+	if x != nil {
+		return x.VideoUrl
+	}
+	return ""
+}
+
+func (x *Publication) GetCoAuthors() []string {
+	if x != nil {
+		return x.CoAuthors
+	}
+	return nil
+}
+
+func (x *Publication) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Publication) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type GetAllPublicationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Maximum number of results to return. Defaults to 50 and is capped at 1000.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token of a previous response, to fetch the following page.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// AIP-160 filter, e.g. `kind = "talk" AND venue:"gophercon"`.
+	Filter string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Comma separated fields to sort by, each optionally followed by "desc",
+	// e.g. `published_at desc`.
+	OrderBy string `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// Fields of each publication to return. Every field is returned when unset.
+	ReadMask      *fieldmaskpb.FieldMask `protobuf:"bytes,5,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllPublicationsRequest) Reset() {
+	*x = GetAllPublicationsRequest{}
+	mi := &file_jorgejr568_portfolio_grpc_publications_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllPublicationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllPublicationsRequest) ProtoMessage() {}
+
+func (x *GetAllPublicationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_publications_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllPublicationsRequest.ProtoReflect.Descriptor instead.
+func (*GetAllPublicationsRequest) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_publications_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetAllPublicationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetAllPublicationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetAllPublicationsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *GetAllPublicationsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *GetAllPublicationsRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+type GetAllPublicationsResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Publications []*Publication         `protobuf:"bytes,1,rep,name=publications,proto3" json:"publications,omitempty"`
+	// Token for the next page, empty when there are no more results.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllPublicationsResponse) Reset() {
+	*x = GetAllPublicationsResponse{}
+	mi := &file_jorgejr568_portfolio_grpc_publications_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllPublicationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllPublicationsResponse) ProtoMessage() {}
+
+func (x *GetAllPublicationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_publications_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllPublicationsResponse.ProtoReflect.Descriptor instead.
+func (*GetAllPublicationsResponse) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_publications_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetAllPublicationsResponse) GetPublications() []*Publication {
+	if x != nil {
+		return x.Publications
+	}
+	return nil
+}
+
+func (x *GetAllPublicationsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetPublicationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Fields of the publication to return. Every field is returned when unset.
+	ReadMask      *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPublicationRequest) Reset() {
+	*x = GetPublicationRequest{}
+	mi := &file_jorgejr568_portfolio_grpc_publications_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPublicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicationRequest) ProtoMessage() {}
+
+func (x *GetPublicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_publications_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicationRequest.ProtoReflect.Descriptor instead.
+func (*GetPublicationRequest) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_publications_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetPublicationRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetPublicationRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+type GetPublicationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Publication   *Publication           `protobuf:"bytes,1,opt,name=publication,proto3" json:"publication,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPublicationResponse) Reset() {
+	*x = GetPublicationResponse{}
+	mi := &file_jorgejr568_portfolio_grpc_publications_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPublicationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicationResponse) ProtoMessage() {}
+
+func (x *GetPublicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_publications_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicationResponse.ProtoReflect.Descriptor instead.
+func (*GetPublicationResponse) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_publications_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetPublicationResponse) GetPublication() *Publication {
+	if x != nil {
+		return x.Publication
+	}
+	return nil
+}
+
+var File_jorgejr568_portfolio_grpc_publications_proto protoreflect.FileDescriptor
+
+const file_jorgejr568_portfolio_grpc_publications_proto_rawDesc = "" +
+	"\n" +
+	",jorgejr568/portfolio_grpc/publications.proto\x12\x19jorgejr568.portfolio_grpc\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16google/type/date.proto\"\x82\x04\n" +
+	"\vPublication\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12?\n" +
+	"\x04kind\x18\x02 \x01(\x0e2+.jorgejr568.portfolio_grpc.Publication.KindR\x04kind\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x14\n" +
+	"\x05venue\x18\x05 \x01(\tR\x05venue\x124\n" +
+	"\fpublished_at\x18\x06 \x01(\v2\x11.google.type.DateR\vpublishedAt\x12\x1d\n" +
+	"\n" +
+	"slides_url\x18\a \x01(\tR\tslidesUrl\x12\x1b\n" +
+	"\tvideo_url\x18\b \x01(\tR\bvideoUrl\x12\x1d\n" +
+	"\n" +
+	"co_authors\x18\t \x03(\tR\tcoAuthors\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"M\n" +
+	"\x04Kind\x12\x14\n" +
+	"\x10KIND_UNSPECIFIED\x10\x00\x12\r\n" +
+	"\tKIND_TALK\x10\x01\x12\x10\n" +
+	"\fKIND_ARTICLE\x10\x02\x12\x0e\n" +
+	"\n" +
+	"KIND_PAPER\x10\x03\"\xc3\x01\n" +
+	"\x19GetAllPublicationsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06filter\x18\x03 \x01(\tR\x06filter\x12\x19\n" +
+	"\border_by\x18\x04 \x01(\tR\aorderBy\x127\n" +
+	"\tread_mask\x18\x05 \x01(\v2\x1a.google.protobuf.FieldMaskR\breadMask\"\x90\x01\n" +
+	"\x1aGetAllPublicationsResponse\x12J\n" +
+	"\fpublications\x18\x01 \x03(\v2&.jorgejr568.portfolio_grpc.PublicationR\fpublications\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"`\n" +
+	"\x15GetPublicationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x127\n" +
+	"\tread_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\breadMask\"b\n" +
+	"\x16GetPublicationResponse\x12H\n" +
+	"\vpublication\x18\x01 \x01(\v2&.jorgejr568.portfolio_grpc.PublicationR\vpublicationB\xfa\x01\n" +
+	"\x1dcom.jorgejr568.portfolio_grpcB\x11PublicationsProtoP\x01ZEgithub.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc\xa2\x02\x03JPX\xaa\x02\x18Jorgejr568.PortfolioGrpc\xca\x02\x18Jorgejr568\\PortfolioGrpc\xe2\x02$Jorgejr568\\PortfolioGrpc\\GPBMetadata\xea\x02\x19Jorgejr568::PortfolioGrpcb\x06proto3"
+
+var (
+	file_jorgejr568_portfolio_grpc_publications_proto_rawDescOnce sync.Once
+	file_jorgejr568_portfolio_grpc_publications_proto_rawDescData []byte
+)
+
+func file_jorgejr568_portfolio_grpc_publications_proto_rawDescGZIP() []byte {
+	file_jorgejr568_portfolio_grpc_publications_proto_rawDescOnce.Do(func() {
+		file_jorgejr568_portfolio_grpc_publications_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_jorgejr568_portfolio_grpc_publications_proto_rawDesc), len(file_jorgejr568_portfolio_grpc_publications_proto_rawDesc)))
+	})
+	return file_jorgejr568_portfolio_grpc_publications_proto_rawDescData
+}
+
+var file_jorgejr568_portfolio_grpc_publications_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_jorgejr568_portfolio_grpc_publications_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_jorgejr568_portfolio_grpc_publications_proto_goTypes = []any{
+	(Publication_Kind)(0),              // 0: jorgejr568.portfolio_grpc.Publication.Kind
+	(*Publication)(nil),                // 1: jorgejr568.portfolio_grpc.Publication
+	(*GetAllPublicationsRequest)(nil),  // 2: jorgejr568.portfolio_grpc.GetAllPublicationsRequest
+	(*GetAllPublicationsResponse)(nil), // 3: jorgejr568.portfolio_grpc.GetAllPublicationsResponse
+	(*GetPublicationRequest)(nil),      // 4: jorgejr568.portfolio_grpc.GetPublicationRequest
+	(*GetPublicationResponse)(nil),     // 5: jorgejr568.portfolio_grpc.GetPublicationResponse
+	(*date.Date)(nil),                  // 6: google.type.Date
+	(*timestamppb.Timestamp)(nil),      // 7: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),      // 8: google.protobuf.FieldMask
+}
+var file_jorgejr568_portfolio_grpc_publications_proto_depIdxs = []int32{
+	0, // 0: jorgejr568.portfolio_grpc.Publication.kind:type_name -> jorgejr568.portfolio_grpc.Publication.Kind
+	6, // 1: jorgejr568.portfolio_grpc.Publication.published_at:type_name -> google.type.Date
+	7, // 2: jorgejr568.portfolio_grpc.Publication.created_at:type_name -> google.protobuf.Timestamp
+	7, // 3: jorgejr568.portfolio_grpc.Publication.updated_at:type_name -> google.protobuf.Timestamp
+	8, // 4: jorgejr568.portfolio_grpc.GetAllPublicationsRequest.read_mask:type_name -> google.protobuf.FieldMask
+	1, // 5: jorgejr568.portfolio_grpc.GetAllPublicationsResponse.publications:type_name -> jorgejr568.portfolio_grpc.Publication
+	8, // 6: jorgejr568.portfolio_grpc.GetPublicationRequest.read_mask:type_name -> google.protobuf.FieldMask
+	1, // 7: jorgejr568.portfolio_grpc.GetPublicationResponse.publication:type_name -> jorgejr568.portfolio_grpc.Publication
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_jorgejr568_portfolio_grpc_publications_proto_init() }
+func file_jorgejr568_portfolio_grpc_publications_proto_init() {
+	if File_jorgejr568_portfolio_grpc_publications_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_jorgejr568_portfolio_grpc_publications_proto_rawDesc), len(file_jorgejr568_portfolio_grpc_publications_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_jorgejr568_portfolio_grpc_publications_proto_goTypes,
+		DependencyIndexes: file_jorgejr568_portfolio_grpc_publications_proto_depIdxs,
+		EnumInfos:         file_jorgejr568_portfolio_grpc_publications_proto_enumTypes,
+		MessageInfos:      file_jorgejr568_portfolio_grpc_publications_proto_msgTypes,
+	}.Build()
+	File_jorgejr568_portfolio_grpc_publications_proto = out.File
+	file_jorgejr568_portfolio_grpc_publications_proto_goTypes = nil
+	file_jorgejr568_portfolio_grpc_publications_proto_depIdxs = nil
+}
@@ -0,0 +1,1016 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: jorgejr568/portfolio_grpc/revisions.proto
+
+package portfolio_grpc
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Revision_Operation int32
+
+const (
+	Revision_OPERATION_UNSPECIFIED Revision_Operation = 0
+	Revision_OPERATION_UPDATE      Revision_Operation = 1
+	Revision_OPERATION_DELETE      Revision_Operation = 2
+	Revision_OPERATION_UNDELETE    Revision_Operation = 3
+	Revision_OPERATION_RESTORE     Revision_Operation = 4
+)
+
+// Enum value maps for Revision_Operation.
+var (
+	Revision_Operation_name = map[int32]string{
+		0: "OPERATION_UNSPECIFIED",
+		1: "OPERATION_UPDATE",
+		2: "OPERATION_DELETE",
+		3: "OPERATION_UNDELETE",
+		4: "OPERATION_RESTORE",
+	}
+	Revision_Operation_value = map[string]int32{
+		"OPERATION_UNSPECIFIED": 0,
+		"OPERATION_UPDATE":      1,
+		"OPERATION_DELETE":      2,
+		"OPERATION_UNDELETE":    3,
+		"OPERATION_RESTORE":     4,
+	}
+)
+
+func (x Revision_Operation) Enum() *Revision_Operation {
+	p := new(Revision_Operation)
+	*p = x
+	return p
+}
+
+func (x Revision_Operation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Revision_Operation) Descriptor() protoreflect.EnumDescriptor {
+	return file_jorgejr568_portfolio_grpc_revisions_proto_enumTypes[0].Descriptor()
+}
+
+func (Revision_Operation) Type() protoreflect.EnumType {
+	return &file_jorgejr568_portfolio_grpc_revisions_proto_enumTypes[0]
+}
+
+func (x Revision_Operation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Revision_Operation.Descriptor instead.
+func (Revision_Operation) EnumDescriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_revisions_proto_rawDescGZIP(), []int{0, 0}
+}
+
+// Revision records a skill, experience or education as it was before it was
+// changed. Revisions are never modified.
+type Revision struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	EntityId int64                  `protobuf:"varint,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	// The change that replaced the snapshot.
+	Operation Revision_Operation `protobuf:"varint,3,opt,name=operation,proto3,enum=jorgejr568.portfolio_grpc.Revision_Operation" json:"operation,omitempty"`
+	// Name of the admin that made the change, empty for unauthenticated
+	// callers.
+	Actor     string                 `protobuf:"bytes,4,opt,name=actor,proto3" json:"actor,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// The entity as it was before the change.
+	//
+	// Types that are valid to be assigned to Snapshot:
+	//
+	//	*Revision_Skill
+	//	*Revision_Experience
+	//	*Revision_Education
+	Snapshot      isRevision_Snapshot `protobuf_oneof:"snapshot"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Revision) Reset() {
+	*x = Revision{}
+	mi := &file_jorgejr568_portfolio_grpc_revisions_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Revision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Revision) ProtoMessage() {}
+
+func (x *Revision) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_revisions_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Revision.ProtoReflect.Descriptor instead.
+func (*Revision) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_revisions_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Revision) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Revision) GetEntityId() int64 {
+	if x != nil {
+		return x.EntityId
+	}
+	return 0
+}
+
+func (x *Revision) GetOperation() Revision_Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return Revision_OPERATION_UNSPECIFIED
+}
+
+func (x *Revision) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *Revision) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Revision) GetSnapshot() isRevision_Snapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+func (x *Revision) GetSkill() *Skill {
+	if x != nil {
+		if x, ok := x.Snapshot.(*Revision_Skill); ok {
+			return x.Skill
+		}
+	}
+	return nil
+}
+
+func (x *Revision) GetExperience() *Experience {
+	if x != nil {
+		if x, ok := x.Snapshot.(*Revision_Experience); ok {
+			return x.Experience
+		}
+	}
+	return nil
+}
+
+func (x *Revision) GetEducation() *Education {
+	if x != nil {
+		if x, ok := x.Snapshot.(*Revision_Education); ok {
+			return x.Education
+		}
+	}
+	return nil
+}
+
+type isRevision_Snapshot interface {
+	isRevision_Snapshot()
+}
+
+type Revision_Skill struct {
+	Skill *Skill `protobuf:"bytes,6,opt,name=skill,proto3,oneof"`
+}
+
+type Revision_Experience struct {
+	Experience *Experience `protobuf:"bytes,7,opt,name=experience,proto3,oneof"`
+}
+
+type Revision_Education struct {
+	Education *Education `protobuf:"bytes,8,opt,name=education,proto3,oneof"`
+}
+
+func (*Revision_Skill) isRevision_Snapshot() {}
+
+func (*Revision_Experience) isRevision_Snapshot() {}
+
+func (*Revision_Education) isRevision_Snapshot() {}
+
+type ListSkillRevisionsRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	SkillId int64                  `protobuf:"varint,1,opt,name=skill_id,json=skillId,proto3" json:"skill_id,omitempty"`
+	// Maximum number of results to return. Defaults to 50 and is capped at 1000.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token of a previous response, to fetch the following page.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSkillRevisionsRequest) Reset() {
+	*x = ListSkillRevisionsRequest{}
+	mi := &file_jorgejr568_portfolio_grpc_revisions_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSkillRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSkillRevisionsRequest) ProtoMessage() {}
+
+func (x *ListSkillRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_revisions_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSkillRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSkillRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_revisions_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListSkillRevisionsRequest) GetSkillId() int64 {
+	if x != nil {
+		return x.SkillId
+	}
+	return 0
+}
+
+func (x *ListSkillRevisionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSkillRevisionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListSkillRevisionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Revisions of the skill, newest first.
+	Revisions []*Revision `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	// Token for the next page, empty when there are no more results.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSkillRevisionsResponse) Reset() {
+	*x = ListSkillRevisionsResponse{}
+	mi := &file_jorgejr568_portfolio_grpc_revisions_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSkillRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSkillRevisionsResponse) ProtoMessage() {}
+
+func (x *ListSkillRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_revisions_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSkillRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSkillRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_revisions_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListSkillRevisionsResponse) GetRevisions() []*Revision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+func (x *ListSkillRevisionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type RestoreSkillRevisionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkillId       int64                  `protobuf:"varint,1,opt,name=skill_id,json=skillId,proto3" json:"skill_id,omitempty"`
+	RevisionId    int64                  `protobuf:"varint,2,opt,name=revision_id,json=revisionId,proto3" json:"revision_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreSkillRevisionRequest) Reset() {
+	*x = RestoreSkillRevisionRequest{}
+	mi := &file_jorgejr568_portfolio_grpc_revisions_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreSkillRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreSkillRevisionRequest) ProtoMessage() {}
+
+func (x *RestoreSkillRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_revisions_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreSkillRevisionRequest.ProtoReflect.Descriptor instead.
+func (*RestoreSkillRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_revisions_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RestoreSkillRevisionRequest) GetSkillId() int64 {
+	if x != nil {
+		return x.SkillId
+	}
+	return 0
+}
+
+func (x *RestoreSkillRevisionRequest) GetRevisionId() int64 {
+	if x != nil {
+		return x.RevisionId
+	}
+	return 0
+}
+
+type RestoreSkillRevisionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Skill         *Skill                 `protobuf:"bytes,1,opt,name=skill,proto3" json:"skill,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreSkillRevisionResponse) Reset() {
+	*x = RestoreSkillRevisionResponse{}
+	mi := &file_jorgejr568_portfolio_grpc_revisions_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreSkillRevisionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreSkillRevisionResponse) ProtoMessage() {}
+
+func (x *RestoreSkillRevisionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_revisions_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreSkillRevisionResponse.ProtoReflect.Descriptor instead.
+func (*RestoreSkillRevisionResponse) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_revisions_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RestoreSkillRevisionResponse) GetSkill() *Skill {
+	if x != nil {
+		return x.Skill
+	}
+	return nil
+}
+
+type ListExperienceRevisionsRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	ExperienceId int64                  `protobuf:"varint,1,opt,name=experience_id,json=experienceId,proto3" json:"experience_id,omitempty"`
+	// Maximum number of results to return. Defaults to 50 and is capped at 1000.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token of a previous response, to fetch the following page.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExperienceRevisionsRequest) Reset() {
+	*x = ListExperienceRevisionsRequest{}
+	mi := &file_jorgejr568_portfolio_grpc_revisions_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExperienceRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExperienceRevisionsRequest) ProtoMessage() {}
+
+func (x *ListExperienceRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_revisions_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExperienceRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListExperienceRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_revisions_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListExperienceRevisionsRequest) GetExperienceId() int64 {
+	if x != nil {
+		return x.ExperienceId
+	}
+	return 0
+}
+
+func (x *ListExperienceRevisionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListExperienceRevisionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListExperienceRevisionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Revisions of the experience, newest first.
+	Revisions []*Revision `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	// Token for the next page, empty when there are no more results.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExperienceRevisionsResponse) Reset() {
+	*x = ListExperienceRevisionsResponse{}
+	mi := &file_jorgejr568_portfolio_grpc_revisions_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExperienceRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExperienceRevisionsResponse) ProtoMessage() {}
+
+func (x *ListExperienceRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_revisions_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExperienceRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListExperienceRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_revisions_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListExperienceRevisionsResponse) GetRevisions() []*Revision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+func (x *ListExperienceRevisionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type RestoreExperienceRevisionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExperienceId  int64                  `protobuf:"varint,1,opt,name=experience_id,json=experienceId,proto3" json:"experience_id,omitempty"`
+	RevisionId    int64                  `protobuf:"varint,2,opt,name=revision_id,json=revisionId,proto3" json:"revision_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreExperienceRevisionRequest) Reset() {
+	*x = RestoreExperienceRevisionRequest{}
+	mi := &file_jorgejr568_portfolio_grpc_revisions_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreExperienceRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreExperienceRevisionRequest) ProtoMessage() {}
+
+func (x *RestoreExperienceRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_revisions_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreExperienceRevisionRequest.ProtoReflect.Descriptor instead.
+func (*RestoreExperienceRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_revisions_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RestoreExperienceRevisionRequest) GetExperienceId() int64 {
+	if x != nil {
+		return x.ExperienceId
+	}
+	return 0
+}
+
+func (x *RestoreExperienceRevisionRequest) GetRevisionId() int64 {
+	if x != nil {
+		return x.RevisionId
+	}
+	return 0
+}
+
+type RestoreExperienceRevisionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Experience    *Experience            `protobuf:"bytes,1,opt,name=experience,proto3" json:"experience,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreExperienceRevisionResponse) Reset() {
+	*x = RestoreExperienceRevisionResponse{}
+	mi := &file_jorgejr568_portfolio_grpc_revisions_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreExperienceRevisionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreExperienceRevisionResponse) ProtoMessage() {}
+
+func (x *RestoreExperienceRevisionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_revisions_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreExperienceRevisionResponse.ProtoReflect.Descriptor instead.
+func (*RestoreExperienceRevisionResponse) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_revisions_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RestoreExperienceRevisionResponse) GetExperience() *Experience {
+	if x != nil {
+		return x.Experience
+	}
+	return nil
+}
+
+type ListEducationRevisionsRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	EducationId int64                  `protobuf:"varint,1,opt,name=education_id,json=educationId,proto3" json:"education_id,omitempty"`
+	// Maximum number of results to return. Defaults to 50 and is capped at 1000.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token of a previous response, to fetch the following page.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEducationRevisionsRequest) Reset() {
+	*x = ListEducationRevisionsRequest{}
+	mi := &file_jorgejr568_portfolio_grpc_revisions_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEducationRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEducationRevisionsRequest) ProtoMessage() {}
+
+func (x *ListEducationRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_revisions_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEducationRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListEducationRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_revisions_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListEducationRevisionsRequest) GetEducationId() int64 {
+	if x != nil {
+		return x.EducationId
+	}
+	return 0
+}
+
+func (x *ListEducationRevisionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListEducationRevisionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListEducationRevisionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Revisions of the education, newest first.
+	Revisions []*Revision `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	// Token for the next page, empty when there are no more results.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEducationRevisionsResponse) Reset() {
+	*x = ListEducationRevisionsResponse{}
+	mi := &file_jorgejr568_portfolio_grpc_revisions_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEducationRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEducationRevisionsResponse) ProtoMessage() {}
+
+func (x *ListEducationRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_revisions_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEducationRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListEducationRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_revisions_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListEducationRevisionsResponse) GetRevisions() []*Revision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+func (x *ListEducationRevisionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type RestoreEducationRevisionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EducationId   int64                  `protobuf:"varint,1,opt,name=education_id,json=educationId,proto3" json:"education_id,omitempty"`
+	RevisionId    int64                  `protobuf:"varint,2,opt,name=revision_id,json=revisionId,proto3" json:"revision_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreEducationRevisionRequest) Reset() {
+	*x = RestoreEducationRevisionRequest{}
+	mi := &file_jorgejr568_portfolio_grpc_revisions_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreEducationRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreEducationRevisionRequest) ProtoMessage() {}
+
+func (x *RestoreEducationRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_revisions_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreEducationRevisionRequest.ProtoReflect.Descriptor instead.
+func (*RestoreEducationRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_revisions_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RestoreEducationRevisionRequest) GetEducationId() int64 {
+	if x != nil {
+		return x.EducationId
+	}
+	return 0
+}
+
+func (x *RestoreEducationRevisionRequest) GetRevisionId() int64 {
+	if x != nil {
+		return x.RevisionId
+	}
+	return 0
+}
+
+type RestoreEducationRevisionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Education     *Education             `protobuf:"bytes,1,opt,name=education,proto3" json:"education,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreEducationRevisionResponse) Reset() {
+	*x = RestoreEducationRevisionResponse{}
+	mi := &file_jorgejr568_portfolio_grpc_revisions_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreEducationRevisionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreEducationRevisionResponse) ProtoMessage() {}
+
+func (x *RestoreEducationRevisionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_revisions_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreEducationRevisionResponse.ProtoReflect.Descriptor instead.
+func (*RestoreEducationRevisionResponse) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_revisions_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RestoreEducationRevisionResponse) GetEducation() *Education {
+	if x != nil {
+		return x.Education
+	}
+	return nil
+}
+
+var File_jorgejr568_portfolio_grpc_revisions_proto protoreflect.FileDescriptor
+
+const file_jorgejr568_portfolio_grpc_revisions_proto_rawDesc = "" +
+	"\n" +
+	")jorgejr568/portfolio_grpc/revisions.proto\x12\x19jorgejr568.portfolio_grpc\x1a\x1fgoogle/protobuf/timestamp.proto\x1a*jorgejr568/portfolio_grpc/educations.proto\x1a+jorgejr568/portfolio_grpc/experiences.proto\x1a&jorgejr568/portfolio_grpc/skills.proto\"\xae\x04\n" +
+	"\bRevision\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1b\n" +
+	"\tentity_id\x18\x02 \x01(\x03R\bentityId\x12K\n" +
+	"\toperation\x18\x03 \x01(\x0e2-.jorgejr568.portfolio_grpc.Revision.OperationR\toperation\x12\x14\n" +
+	"\x05actor\x18\x04 \x01(\tR\x05actor\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x128\n" +
+	"\x05skill\x18\x06 \x01(\v2 .jorgejr568.portfolio_grpc.SkillH\x00R\x05skill\x12G\n" +
+	"\n" +
+	"experience\x18\a \x01(\v2%.jorgejr568.portfolio_grpc.ExperienceH\x00R\n" +
+	"experience\x12D\n" +
+	"\teducation\x18\b \x01(\v2$.jorgejr568.portfolio_grpc.EducationH\x00R\teducation\"\x81\x01\n" +
+	"\tOperation\x12\x19\n" +
+	"\x15OPERATION_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10OPERATION_UPDATE\x10\x01\x12\x14\n" +
+	"\x10OPERATION_DELETE\x10\x02\x12\x16\n" +
+	"\x12OPERATION_UNDELETE\x10\x03\x12\x15\n" +
+	"\x11OPERATION_RESTORE\x10\x04B\n" +
+	"\n" +
+	"\bsnapshot\"r\n" +
+	"\x19ListSkillRevisionsRequest\x12\x19\n" +
+	"\bskill_id\x18\x01 \x01(\x03R\askillId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x87\x01\n" +
+	"\x1aListSkillRevisionsResponse\x12A\n" +
+	"\trevisions\x18\x01 \x03(\v2#.jorgejr568.portfolio_grpc.RevisionR\trevisions\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"Y\n" +
+	"\x1bRestoreSkillRevisionRequest\x12\x19\n" +
+	"\bskill_id\x18\x01 \x01(\x03R\askillId\x12\x1f\n" +
+	"\vrevision_id\x18\x02 \x01(\x03R\n" +
+	"revisionId\"V\n" +
+	"\x1cRestoreSkillRevisionResponse\x126\n" +
+	"\x05skill\x18\x01 \x01(\v2 .jorgejr568.portfolio_grpc.SkillR\x05skill\"\x81\x01\n" +
+	"\x1eListExperienceRevisionsRequest\x12#\n" +
+	"\rexperience_id\x18\x01 \x01(\x03R\fexperienceId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x8c\x01\n" +
+	"\x1fListExperienceRevisionsResponse\x12A\n" +
+	"\trevisions\x18\x01 \x03(\v2#.jorgejr568.portfolio_grpc.RevisionR\trevisions\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"h\n" +
+	" RestoreExperienceRevisionRequest\x12#\n" +
+	"\rexperience_id\x18\x01 \x01(\x03R\fexperienceId\x12\x1f\n" +
+	"\vrevision_id\x18\x02 \x01(\x03R\n" +
+	"revisionId\"j\n" +
+	"!RestoreExperienceRevisionResponse\x12E\n" +
+	"\n" +
+	"experience\x18\x01 \x01(\v2%.jorgejr568.portfolio_grpc.ExperienceR\n" +
+	"experience\"~\n" +
+	"\x1dListEducationRevisionsRequest\x12!\n" +
+	"\feducation_id\x18\x01 \x01(\x03R\veducationId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x8b\x01\n" +
+	"\x1eListEducationRevisionsResponse\x12A\n" +
+	"\trevisions\x18\x01 \x03(\v2#.jorgejr568.portfolio_grpc.RevisionR\trevisions\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"e\n" +
+	"\x1fRestoreEducationRevisionRequest\x12!\n" +
+	"\feducation_id\x18\x01 \x01(\x03R\veducationId\x12\x1f\n" +
+	"\vrevision_id\x18\x02 \x01(\x03R\n" +
+	"revisionId\"f\n" +
+	" RestoreEducationRevisionResponse\x12B\n" +
+	"\teducation\x18\x01 \x01(\v2$.jorgejr568.portfolio_grpc.EducationR\teducationB\xf7\x01\n" +
+	"\x1dcom.jorgejr568.portfolio_grpcB\x0eRevisionsProtoP\x01ZEgithub.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc\xa2\x02\x03JPX\xaa\x02\x18Jorgejr568.PortfolioGrpc\xca\x02\x18Jorgejr568\\PortfolioGrpc\xe2\x02$Jorgejr568\\PortfolioGrpc\\GPBMetadata\xea\x02\x19Jorgejr568::PortfolioGrpcb\x06proto3"
+
+var (
+	file_jorgejr568_portfolio_grpc_revisions_proto_rawDescOnce sync.Once
+	file_jorgejr568_portfolio_grpc_revisions_proto_rawDescData []byte
+)
+
+func file_jorgejr568_portfolio_grpc_revisions_proto_rawDescGZIP() []byte {
+	file_jorgejr568_portfolio_grpc_revisions_proto_rawDescOnce.Do(func() {
+		file_jorgejr568_portfolio_grpc_revisions_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_jorgejr568_portfolio_grpc_revisions_proto_rawDesc), len(file_jorgejr568_portfolio_grpc_revisions_proto_rawDesc)))
+	})
+	return file_jorgejr568_portfolio_grpc_revisions_proto_rawDescData
+}
+
+var file_jorgejr568_portfolio_grpc_revisions_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_jorgejr568_portfolio_grpc_revisions_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_jorgejr568_portfolio_grpc_revisions_proto_goTypes = []any{
+	(Revision_Operation)(0),                   // 0: jorgejr568.portfolio_grpc.Revision.Operation
+	(*Revision)(nil),                          // 1: jorgejr568.portfolio_grpc.Revision
+	(*ListSkillRevisionsRequest)(nil),         // 2: jorgejr568.portfolio_grpc.ListSkillRevisionsRequest
+	(*ListSkillRevisionsResponse)(nil),        // 3: jorgejr568.portfolio_grpc.ListSkillRevisionsResponse
+	(*RestoreSkillRevisionRequest)(nil),       // 4: jorgejr568.portfolio_grpc.RestoreSkillRevisionRequest
+	(*RestoreSkillRevisionResponse)(nil),      // 5: jorgejr568.portfolio_grpc.RestoreSkillRevisionResponse
+	(*ListExperienceRevisionsRequest)(nil),    // 6: jorgejr568.portfolio_grpc.ListExperienceRevisionsRequest
+	(*ListExperienceRevisionsResponse)(nil),   // 7: jorgejr568.portfolio_grpc.ListExperienceRevisionsResponse
+	(*RestoreExperienceRevisionRequest)(nil),  // 8: jorgejr568.portfolio_grpc.RestoreExperienceRevisionRequest
+	(*RestoreExperienceRevisionResponse)(nil), // 9: jorgejr568.portfolio_grpc.RestoreExperienceRevisionResponse
+	(*ListEducationRevisionsRequest)(nil),     // 10: jorgejr568.portfolio_grpc.ListEducationRevisionsRequest
+	(*ListEducationRevisionsResponse)(nil),    // 11: jorgejr568.portfolio_grpc.ListEducationRevisionsResponse
+	(*RestoreEducationRevisionRequest)(nil),   // 12: jorgejr568.portfolio_grpc.RestoreEducationRevisionRequest
+	(*RestoreEducationRevisionResponse)(nil),  // 13: jorgejr568.portfolio_grpc.RestoreEducationRevisionResponse
+	(*timestamppb.Timestamp)(nil),             // 14: google.protobuf.Timestamp
+	(*Skill)(nil),                             // 15: jorgejr568.portfolio_grpc.Skill
+	(*Experience)(nil),                        // 16: jorgejr568.portfolio_grpc.Experience
+	(*Education)(nil),                         // 17: jorgejr568.portfolio_grpc.Education
+}
+var file_jorgejr568_portfolio_grpc_revisions_proto_depIdxs = []int32{
+	0,  // 0: jorgejr568.portfolio_grpc.Revision.operation:type_name -> jorgejr568.portfolio_grpc.Revision.Operation
+	14, // 1: jorgejr568.portfolio_grpc.Revision.created_at:type_name -> google.protobuf.Timestamp
+	15, // 2: jorgejr568.portfolio_grpc.Revision.skill:type_name -> jorgejr568.portfolio_grpc.Skill
+	16, // 3: jorgejr568.portfolio_grpc.Revision.experience:type_name -> jorgejr568.portfolio_grpc.Experience
+	17, // 4: jorgejr568.portfolio_grpc.Revision.education:type_name -> jorgejr568.portfolio_grpc.Education
+	1,  // 5: jorgejr568.portfolio_grpc.ListSkillRevisionsResponse.revisions:type_name -> jorgejr568.portfolio_grpc.Revision
+	15, // 6: jorgejr568.portfolio_grpc.RestoreSkillRevisionResponse.skill:type_name -> jorgejr568.portfolio_grpc.Skill
+	1,  // 7: jorgejr568.portfolio_grpc.ListExperienceRevisionsResponse.revisions:type_name -> jorgejr568.portfolio_grpc.Revision
+	16, // 8: jorgejr568.portfolio_grpc.RestoreExperienceRevisionResponse.experience:type_name -> jorgejr568.portfolio_grpc.Experience
+	1,  // 9: jorgejr568.portfolio_grpc.ListEducationRevisionsResponse.revisions:type_name -> jorgejr568.portfolio_grpc.Revision
+	17, // 10: jorgejr568.portfolio_grpc.RestoreEducationRevisionResponse.education:type_name -> jorgejr568.portfolio_grpc.Education
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_jorgejr568_portfolio_grpc_revisions_proto_init() }
+func file_jorgejr568_portfolio_grpc_revisions_proto_init() {
+	if File_jorgejr568_portfolio_grpc_revisions_proto != nil {
+		return
+	}
+	file_jorgejr568_portfolio_grpc_educations_proto_init()
+	file_jorgejr568_portfolio_grpc_experiences_proto_init()
+	file_jorgejr568_portfolio_grpc_skills_proto_init()
+	file_jorgejr568_portfolio_grpc_revisions_proto_msgTypes[0].OneofWrappers = []any{
+		(*Revision_Skill)(nil),
+		(*Revision_Experience)(nil),
+		(*Revision_Education)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_jorgejr568_portfolio_grpc_revisions_proto_rawDesc), len(file_jorgejr568_portfolio_grpc_revisions_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_jorgejr568_portfolio_grpc_revisions_proto_goTypes,
+		DependencyIndexes: file_jorgejr568_portfolio_grpc_revisions_proto_depIdxs,
+		EnumInfos:         file_jorgejr568_portfolio_grpc_revisions_proto_enumTypes,
+		MessageInfos:      file_jorgejr568_portfolio_grpc_revisions_proto_msgTypes,
+	}.Build()
+	File_jorgejr568_portfolio_grpc_revisions_proto = out.File
+	file_jorgejr568_portfolio_grpc_revisions_proto_goTypes = nil
+	file_jorgejr568_portfolio_grpc_revisions_proto_depIdxs = nil
+}
@@ -0,0 +1,330 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: jorgejr568/portfolio_grpc/search.proto
+
+package portfolio_grpc
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SearchHit_Type int32
+
+const (
+	SearchHit_TYPE_UNSPECIFIED SearchHit_Type = 0
+	SearchHit_TYPE_SKILL       SearchHit_Type = 1
+	SearchHit_TYPE_EXPERIENCE  SearchHit_Type = 2
+	SearchHit_TYPE_EDUCATION   SearchHit_Type = 3
+)
+
+// Enum value maps for SearchHit_Type.
+var (
+	SearchHit_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "TYPE_SKILL",
+		2: "TYPE_EXPERIENCE",
+		3: "TYPE_EDUCATION",
+	}
+	SearchHit_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"TYPE_SKILL":       1,
+		"TYPE_EXPERIENCE":  2,
+		"TYPE_EDUCATION":   3,
+	}
+)
+
+func (x SearchHit_Type) Enum() *SearchHit_Type {
+	p := new(SearchHit_Type)
+	*p = x
+	return p
+}
+
+func (x SearchHit_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SearchHit_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_jorgejr568_portfolio_grpc_search_proto_enumTypes[0].Descriptor()
+}
+
+func (SearchHit_Type) Type() protoreflect.EnumType {
+	return &file_jorgejr568_portfolio_grpc_search_proto_enumTypes[0]
+}
+
+func (x SearchHit_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SearchHit_Type.Descriptor instead.
+func (SearchHit_Type) EnumDescriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_search_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type SearchHit struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Type  SearchHit_Type         `protobuf:"varint,1,opt,name=type,proto3,enum=jorgejr568.portfolio_grpc.SearchHit_Type" json:"type,omitempty"`
+	Id    int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Title string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	// HTML escaped excerpt of the matching text with matched terms wrapped in
+	// <mark> tags.
+	Snippet       string  `protobuf:"bytes,4,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	Rank          float32 `protobuf:"fixed32,5,opt,name=rank,proto3" json:"rank,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchHit) Reset() {
+	*x = SearchHit{}
+	mi := &file_jorgejr568_portfolio_grpc_search_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchHit) ProtoMessage() {}
+
+func (x *SearchHit) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_search_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchHit.ProtoReflect.Descriptor instead.
+func (*SearchHit) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_search_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SearchHit) GetType() SearchHit_Type {
+	if x != nil {
+		return x.Type
+	}
+	return SearchHit_TYPE_UNSPECIFIED
+}
+
+func (x *SearchHit) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SearchHit) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SearchHit) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+func (x *SearchHit) GetRank() float32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+type SearchPortfolioRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Search query. Supports quoted phrases, "or" and "-" to exclude terms.
+	Q string `protobuf:"bytes,1,opt,name=q,proto3" json:"q,omitempty"`
+	// Maximum number of hits to return. Defaults to 20 and is capped at 100.
+	PageSize      int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchPortfolioRequest) Reset() {
+	*x = SearchPortfolioRequest{}
+	mi := &file_jorgejr568_portfolio_grpc_search_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchPortfolioRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPortfolioRequest) ProtoMessage() {}
+
+func (x *SearchPortfolioRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_search_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPortfolioRequest.ProtoReflect.Descriptor instead.
+func (*SearchPortfolioRequest) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_search_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SearchPortfolioRequest) GetQ() string {
+	if x != nil {
+		return x.Q
+	}
+	return ""
+}
+
+func (x *SearchPortfolioRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type SearchPortfolioResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Hits ordered by relevance.
+	Hits          []*SearchHit `protobuf:"bytes,1,rep,name=hits,proto3" json:"hits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchPortfolioResponse) Reset() {
+	*x = SearchPortfolioResponse{}
+	mi := &file_jorgejr568_portfolio_grpc_search_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchPortfolioResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPortfolioResponse) ProtoMessage() {}
+
+func (x *SearchPortfolioResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_search_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPortfolioResponse.ProtoReflect.Descriptor instead.
+func (*SearchPortfolioResponse) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_search_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SearchPortfolioResponse) GetHits() []*SearchHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+var File_jorgejr568_portfolio_grpc_search_proto protoreflect.FileDescriptor
+
+const file_jorgejr568_portfolio_grpc_search_proto_rawDesc = "" +
+	"\n" +
+	"&jorgejr568/portfolio_grpc/search.proto\x12\x19jorgejr568.portfolio_grpc\"\xf5\x01\n" +
+	"\tSearchHit\x12=\n" +
+	"\x04type\x18\x01 \x01(\x0e2).jorgejr568.portfolio_grpc.SearchHit.TypeR\x04type\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x18\n" +
+	"\asnippet\x18\x04 \x01(\tR\asnippet\x12\x12\n" +
+	"\x04rank\x18\x05 \x01(\x02R\x04rank\"U\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x0e\n" +
+	"\n" +
+	"TYPE_SKILL\x10\x01\x12\x13\n" +
+	"\x0fTYPE_EXPERIENCE\x10\x02\x12\x12\n" +
+	"\x0eTYPE_EDUCATION\x10\x03\"C\n" +
+	"\x16SearchPortfolioRequest\x12\f\n" +
+	"\x01q\x18\x01 \x01(\tR\x01q\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"S\n" +
+	"\x17SearchPortfolioResponse\x128\n" +
+	"\x04hits\x18\x01 \x03(\v2$.jorgejr568.portfolio_grpc.SearchHitR\x04hitsB\xf4\x01\n" +
+	"\x1dcom.jorgejr568.portfolio_grpcB\vSearchProtoP\x01ZEgithub.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc\xa2\x02\x03JPX\xaa\x02\x18Jorgejr568.PortfolioGrpc\xca\x02\x18Jorgejr568\\PortfolioGrpc\xe2\x02$Jorgejr568\\PortfolioGrpc\\GPBMetadata\xea\x02\x19Jorgejr568::PortfolioGrpcb\x06proto3"
+
+var (
+	file_jorgejr568_portfolio_grpc_search_proto_rawDescOnce sync.Once
+	file_jorgejr568_portfolio_grpc_search_proto_rawDescData []byte
+)
+
+func file_jorgejr568_portfolio_grpc_search_proto_rawDescGZIP() []byte {
+	file_jorgejr568_portfolio_grpc_search_proto_rawDescOnce.Do(func() {
+		file_jorgejr568_portfolio_grpc_search_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_jorgejr568_portfolio_grpc_search_proto_rawDesc), len(file_jorgejr568_portfolio_grpc_search_proto_rawDesc)))
+	})
+	return file_jorgejr568_portfolio_grpc_search_proto_rawDescData
+}
+
+var file_jorgejr568_portfolio_grpc_search_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_jorgejr568_portfolio_grpc_search_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_jorgejr568_portfolio_grpc_search_proto_goTypes = []any{
+	(SearchHit_Type)(0),             // 0: jorgejr568.portfolio_grpc.SearchHit.Type
+	(*SearchHit)(nil),               // 1: jorgejr568.portfolio_grpc.SearchHit
+	(*SearchPortfolioRequest)(nil),  // 2: jorgejr568.portfolio_grpc.SearchPortfolioRequest
+	(*SearchPortfolioResponse)(nil), // 3: jorgejr568.portfolio_grpc.SearchPortfolioResponse
+}
+var file_jorgejr568_portfolio_grpc_search_proto_depIdxs = []int32{
+	0, // 0: jorgejr568.portfolio_grpc.SearchHit.type:type_name -> jorgejr568.portfolio_grpc.SearchHit.Type
+	1, // 1: jorgejr568.portfolio_grpc.SearchPortfolioResponse.hits:type_name -> jorgejr568.portfolio_grpc.SearchHit
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_jorgejr568_portfolio_grpc_search_proto_init() }
+func file_jorgejr568_portfolio_grpc_search_proto_init() {
+	if File_jorgejr568_portfolio_grpc_search_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_jorgejr568_portfolio_grpc_search_proto_rawDesc), len(file_jorgejr568_portfolio_grpc_search_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_jorgejr568_portfolio_grpc_search_proto_goTypes,
+		DependencyIndexes: file_jorgejr568_portfolio_grpc_search_proto_depIdxs,
+		EnumInfos:         file_jorgejr568_portfolio_grpc_search_proto_enumTypes,
+		MessageInfos:      file_jorgejr568_portfolio_grpc_search_proto_msgTypes,
+	}.Build()
+	File_jorgejr568_portfolio_grpc_search_proto = out.File
+	file_jorgejr568_portfolio_grpc_search_proto_goTypes = nil
+	file_jorgejr568_portfolio_grpc_search_proto_depIdxs = nil
+}
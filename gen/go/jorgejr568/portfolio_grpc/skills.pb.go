@@ -7,8 +7,10 @@
 package portfolio_grpc
 
 import (
+	date "google.golang.org/genproto/googleapis/type/date"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -23,12 +25,23 @@ const (
 )
 
 type Skill struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Level         int32                  `protobuf:"varint,3,opt,name=level,proto3" json:"level,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title     string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Level     int32                  `protobuf:"varint,3,opt,name=level,proto3" json:"level,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Category  *Skill_Category        `protobuf:"bytes,6,opt,name=category,proto3" json:"category,omitempty"`
+	// Output only. Locales the translatable fields are available in. The
+	// fields are served in the one that best matches the Accept-Language of
+	// the request.
+	AvailableLocales []string `protobuf:"bytes,7,rep,name=available_locales,json=availableLocales,proto3" json:"available_locales,omitempty"`
+	// Output only. When the skill was deleted; unset unless it is deleted.
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	// Whether the skill is public. Defaults to VISIBILITY_PUBLISHED.
+	Visibility Visibility `protobuf:"varint,9,opt,name=visibility,proto3,enum=jorgejr568.portfolio_grpc.Visibility" json:"visibility,omitempty"`
+	// When set, a published skill only becomes public at this time.
+	PublishAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -98,10 +111,65 @@ func (x *Skill) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Skill) GetCategory() *Skill_Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+func (x *Skill) GetAvailableLocales() []string {
+	if x != nil {
+		return x.AvailableLocales
+	}
+	return nil
+}
+
+func (x *Skill) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Skill) GetVisibility() Visibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return Visibility_VISIBILITY_UNSPECIFIED
+}
+
+func (x *Skill) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
 type GetAllSkillsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only return skills in this category when set.
+	CategoryId int64 `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	// Maximum number of results to return. Defaults to 50 and is capped at 1000.
+	// Requests without it used to return all skills and now only return
+	// the first page, so follow next_page_token to read the rest.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token of a previous response, to fetch the following page.
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// AIP-160 filter, e.g. `level >= 4 AND title:"go"`.
+	Filter string `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Comma separated fields to sort by, each optionally followed by "desc",
+	// e.g. `level desc, title`.
+	OrderBy string `protobuf:"bytes,5,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// Fields of each skill to return. Every field is returned when unset.
+	ReadMask *fieldmaskpb.FieldMask `protobuf:"bytes,6,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	// Also return deleted skills. Admin only.
+	ShowDeleted bool `protobuf:"varint,7,opt,name=show_deleted,json=showDeleted,proto3" json:"show_deleted,omitempty"`
+	// Also return drafts, archived skills and skills scheduled for later.
+	// Admin only.
+	ShowUnpublished bool `protobuf:"varint,8,opt,name=show_unpublished,json=showUnpublished,proto3" json:"show_unpublished,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *GetAllSkillsRequest) Reset() {
@@ -134,9 +202,67 @@ func (*GetAllSkillsRequest) Descriptor() ([]byte, []int) {
 	return file_jorgejr568_portfolio_grpc_skills_proto_rawDescGZIP(), []int{1}
 }
 
+func (x *GetAllSkillsRequest) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *GetAllSkillsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetAllSkillsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetAllSkillsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *GetAllSkillsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *GetAllSkillsRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+func (x *GetAllSkillsRequest) GetShowDeleted() bool {
+	if x != nil {
+		return x.ShowDeleted
+	}
+	return false
+}
+
+func (x *GetAllSkillsRequest) GetShowUnpublished() bool {
+	if x != nil {
+		return x.ShowUnpublished
+	}
+	return false
+}
+
 type GetAllSkillsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Skills        []*Skill               `protobuf:"bytes,1,rep,name=skills,proto3" json:"skills,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Skills []*Skill               `protobuf:"bytes,1,rep,name=skills,proto3" json:"skills,omitempty"`
+	// Token for the next page, empty when there are no more results.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -178,11 +304,25 @@ func (x *GetAllSkillsResponse) GetSkills() []*Skill {
 	return nil
 }
 
+func (x *GetAllSkillsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type GetSkillRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Fields of the skill to return. Every field is returned when unset. The
+	// experiences of the response are only returned when unset.
+	ReadMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	// Return the skill even if it is deleted. Admin only.
+	ShowDeleted bool `protobuf:"varint,3,opt,name=show_deleted,json=showDeleted,proto3" json:"show_deleted,omitempty"`
+	// Return the skill even if it is not public. Admin only.
+	ShowUnpublished bool `protobuf:"varint,4,opt,name=show_unpublished,json=showUnpublished,proto3" json:"show_unpublished,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *GetSkillRequest) Reset() {
@@ -222,9 +362,33 @@ func (x *GetSkillRequest) GetId() int64 {
 	return 0
 }
 
+func (x *GetSkillRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+func (x *GetSkillRequest) GetShowDeleted() bool {
+	if x != nil {
+		return x.ShowDeleted
+	}
+	return false
+}
+
+func (x *GetSkillRequest) GetShowUnpublished() bool {
+	if x != nil {
+		return x.ShowUnpublished
+	}
+	return false
+}
+
 type GetSkillResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Skill         *Skill                 `protobuf:"bytes,1,opt,name=skill,proto3" json:"skill,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Skill *Skill                 `protobuf:"bytes,1,opt,name=skill,proto3" json:"skill,omitempty"`
+	// Experiences listing the skill among their technologies, most recent
+	// first.
+	Experiences   []*GetSkillResponse_Experience `protobuf:"bytes,2,rep,name=experiences,proto3" json:"experiences,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -266,28 +430,37 @@ func (x *GetSkillResponse) GetSkill() *Skill {
 	return nil
 }
 
-type Skill_Category struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+func (x *GetSkillResponse) GetExperiences() []*GetSkillResponse_Experience {
+	if x != nil {
+		return x.Experiences
+	}
+	return nil
+}
+
+type BatchGetSkillsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Ids of the skills to return, at most 1000.
+	Ids []int64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	// Fields of each skill to return. Every field is returned when unset.
+	ReadMask      *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Skill_Category) Reset() {
-	*x = Skill_Category{}
+func (x *BatchGetSkillsRequest) Reset() {
+	*x = BatchGetSkillsRequest{}
 	mi := &file_jorgejr568_portfolio_grpc_skills_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Skill_Category) String() string {
+func (x *BatchGetSkillsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Skill_Category) ProtoMessage() {}
+func (*BatchGetSkillsRequest) ProtoMessage() {}
 
-func (x *Skill_Category) ProtoReflect() protoreflect.Message {
+func (x *BatchGetSkillsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_jorgejr568_portfolio_grpc_skills_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -299,47 +472,725 @@ func (x *Skill_Category) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Skill_Category.ProtoReflect.Descriptor instead.
-func (*Skill_Category) Descriptor() ([]byte, []int) {
-	return file_jorgejr568_portfolio_grpc_skills_proto_rawDescGZIP(), []int{0, 0}
+// Deprecated: Use BatchGetSkillsRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetSkillsRequest) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_skills_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *Skill_Category) GetId() int64 {
+func (x *BatchGetSkillsRequest) GetIds() []int64 {
 	if x != nil {
-		return x.Id
+		return x.Ids
 	}
-	return 0
+	return nil
 }
 
-func (x *Skill_Category) GetTitle() string {
+func (x *BatchGetSkillsRequest) GetReadMask() *fieldmaskpb.FieldMask {
 	if x != nil {
-		return x.Title
+		return x.ReadMask
 	}
-	return ""
+	return nil
 }
 
-var File_jorgejr568_portfolio_grpc_skills_proto protoreflect.FileDescriptor
+type BatchGetSkillsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The skills found, in the order of the requested ids.
+	Skills []*Skill `protobuf:"bytes,1,rep,name=skills,proto3" json:"skills,omitempty"`
+	// Requested ids without a matching skill.
+	MissingIds    []int64 `protobuf:"varint,2,rep,packed,name=missing_ids,json=missingIds,proto3" json:"missing_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_jorgejr568_portfolio_grpc_skills_proto_rawDesc = "" +
-	"\n" +
-	"&jorgejr568/portfolio_grpc/skills.proto\x12\x19jorgejr568.portfolio_grpc\x1a\x1fgoogle/protobuf/timestamp.proto\"\xeb\x01\n" +
-	"\x05Skill\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
-	"\x05title\x18\x02 \x01(\tR\x05title\x12\x14\n" +
-	"\x05level\x18\x03 \x01(\x05R\x05level\x129\n" +
-	"\n" +
-	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
-	"\n" +
-	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x1a0\n" +
-	"\bCategory\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
-	"\x05title\x18\x02 \x01(\tR\x05title\"\x15\n" +
-	"\x13GetAllSkillsRequest\"P\n" +
-	"\x14GetAllSkillsResponse\x128\n" +
-	"\x06skills\x18\x01 \x03(\v2 .jorgejr568.portfolio_grpc.SkillR\x06skills\"!\n" +
-	"\x0fGetSkillRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"J\n" +
-	"\x10GetSkillResponse\x126\n" +
+func (x *BatchGetSkillsResponse) Reset() {
+	*x = BatchGetSkillsResponse{}
+	mi := &file_jorgejr568_portfolio_grpc_skills_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetSkillsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetSkillsResponse) ProtoMessage() {}
+
+func (x *BatchGetSkillsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_skills_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetSkillsResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetSkillsResponse) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_skills_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BatchGetSkillsResponse) GetSkills() []*Skill {
+	if x != nil {
+		return x.Skills
+	}
+	return nil
+}
+
+func (x *BatchGetSkillsResponse) GetMissingIds() []int64 {
+	if x != nil {
+		return x.MissingIds
+	}
+	return nil
+}
+
+type ListSkillCategoriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSkillCategoriesRequest) Reset() {
+	*x = ListSkillCategoriesRequest{}
+	mi := &file_jorgejr568_portfolio_grpc_skills_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSkillCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSkillCategoriesRequest) ProtoMessage() {}
+
+func (x *ListSkillCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_skills_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSkillCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*ListSkillCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_skills_proto_rawDescGZIP(), []int{7}
+}
+
+type ListSkillCategoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*Skill_Category      `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSkillCategoriesResponse) Reset() {
+	*x = ListSkillCategoriesResponse{}
+	mi := &file_jorgejr568_portfolio_grpc_skills_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSkillCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSkillCategoriesResponse) ProtoMessage() {}
+
+func (x *ListSkillCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_skills_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSkillCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListSkillCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_skills_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListSkillCategoriesResponse) GetCategories() []*Skill_Category {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type CreateSkillRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Skill         *Skill                 `protobuf:"bytes,1,opt,name=skill,proto3" json:"skill,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSkillRequest) Reset() {
+	*x = CreateSkillRequest{}
+	mi := &file_jorgejr568_portfolio_grpc_skills_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSkillRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSkillRequest) ProtoMessage() {}
+
+func (x *CreateSkillRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_skills_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSkillRequest.ProtoReflect.Descriptor instead.
+func (*CreateSkillRequest) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_skills_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CreateSkillRequest) GetSkill() *Skill {
+	if x != nil {
+		return x.Skill
+	}
+	return nil
+}
+
+type CreateSkillResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Skill         *Skill                 `protobuf:"bytes,1,opt,name=skill,proto3" json:"skill,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSkillResponse) Reset() {
+	*x = CreateSkillResponse{}
+	mi := &file_jorgejr568_portfolio_grpc_skills_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSkillResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSkillResponse) ProtoMessage() {}
+
+func (x *CreateSkillResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_skills_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSkillResponse.ProtoReflect.Descriptor instead.
+func (*CreateSkillResponse) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_skills_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CreateSkillResponse) GetSkill() *Skill {
+	if x != nil {
+		return x.Skill
+	}
+	return nil
+}
+
+type UpdateSkillRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Skill *Skill                 `protobuf:"bytes,1,opt,name=skill,proto3" json:"skill,omitempty"`
+	// Fields of skill to update. An empty mask updates every mutable field.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSkillRequest) Reset() {
+	*x = UpdateSkillRequest{}
+	mi := &file_jorgejr568_portfolio_grpc_skills_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSkillRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSkillRequest) ProtoMessage() {}
+
+func (x *UpdateSkillRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_skills_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSkillRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSkillRequest) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_skills_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdateSkillRequest) GetSkill() *Skill {
+	if x != nil {
+		return x.Skill
+	}
+	return nil
+}
+
+func (x *UpdateSkillRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type UpdateSkillResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Skill         *Skill                 `protobuf:"bytes,1,opt,name=skill,proto3" json:"skill,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSkillResponse) Reset() {
+	*x = UpdateSkillResponse{}
+	mi := &file_jorgejr568_portfolio_grpc_skills_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSkillResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSkillResponse) ProtoMessage() {}
+
+func (x *UpdateSkillResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_skills_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSkillResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSkillResponse) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_skills_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UpdateSkillResponse) GetSkill() *Skill {
+	if x != nil {
+		return x.Skill
+	}
+	return nil
+}
+
+type DeleteSkillRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSkillRequest) Reset() {
+	*x = DeleteSkillRequest{}
+	mi := &file_jorgejr568_portfolio_grpc_skills_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSkillRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSkillRequest) ProtoMessage() {}
+
+func (x *DeleteSkillRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_skills_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSkillRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSkillRequest) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_skills_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeleteSkillRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteSkillResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSkillResponse) Reset() {
+	*x = DeleteSkillResponse{}
+	mi := &file_jorgejr568_portfolio_grpc_skills_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSkillResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSkillResponse) ProtoMessage() {}
+
+func (x *DeleteSkillResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_skills_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSkillResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSkillResponse) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_skills_proto_rawDescGZIP(), []int{14}
+}
+
+type UndeleteSkillRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UndeleteSkillRequest) Reset() {
+	*x = UndeleteSkillRequest{}
+	mi := &file_jorgejr568_portfolio_grpc_skills_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UndeleteSkillRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndeleteSkillRequest) ProtoMessage() {}
+
+func (x *UndeleteSkillRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_skills_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndeleteSkillRequest.ProtoReflect.Descriptor instead.
+func (*UndeleteSkillRequest) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_skills_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UndeleteSkillRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type UndeleteSkillResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Skill         *Skill                 `protobuf:"bytes,1,opt,name=skill,proto3" json:"skill,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UndeleteSkillResponse) Reset() {
+	*x = UndeleteSkillResponse{}
+	mi := &file_jorgejr568_portfolio_grpc_skills_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UndeleteSkillResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndeleteSkillResponse) ProtoMessage() {}
+
+func (x *UndeleteSkillResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_skills_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndeleteSkillResponse.ProtoReflect.Descriptor instead.
+func (*UndeleteSkillResponse) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_skills_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UndeleteSkillResponse) GetSkill() *Skill {
+	if x != nil {
+		return x.Skill
+	}
+	return nil
+}
+
+type Skill_Category struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Skill_Category) Reset() {
+	*x = Skill_Category{}
+	mi := &file_jorgejr568_portfolio_grpc_skills_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Skill_Category) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Skill_Category) ProtoMessage() {}
+
+func (x *Skill_Category) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_skills_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Skill_Category.ProtoReflect.Descriptor instead.
+func (*Skill_Category) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_skills_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Skill_Category) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Skill_Category) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+// An experience the skill was used in.
+type GetSkillResponse_Experience struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	CompanyName   string                 `protobuf:"bytes,3,opt,name=company_name,json=companyName,proto3" json:"company_name,omitempty"`
+	StartedAt     *date.Date             `protobuf:"bytes,4,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	EndedAt       *date.Date             `protobuf:"bytes,5,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSkillResponse_Experience) Reset() {
+	*x = GetSkillResponse_Experience{}
+	mi := &file_jorgejr568_portfolio_grpc_skills_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSkillResponse_Experience) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSkillResponse_Experience) ProtoMessage() {}
+
+func (x *GetSkillResponse_Experience) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_skills_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSkillResponse_Experience.ProtoReflect.Descriptor instead.
+func (*GetSkillResponse_Experience) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_skills_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *GetSkillResponse_Experience) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetSkillResponse_Experience) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *GetSkillResponse_Experience) GetCompanyName() string {
+	if x != nil {
+		return x.CompanyName
+	}
+	return ""
+}
+
+func (x *GetSkillResponse_Experience) GetStartedAt() *date.Date {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *GetSkillResponse_Experience) GetEndedAt() *date.Date {
+	if x != nil {
+		return x.EndedAt
+	}
+	return nil
+}
+
+var File_jorgejr568_portfolio_grpc_skills_proto protoreflect.FileDescriptor
+
+const file_jorgejr568_portfolio_grpc_skills_proto_rawDesc = "" +
+	"\n" +
+	"&jorgejr568/portfolio_grpc/skills.proto\x12\x19jorgejr568.portfolio_grpc\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16google/type/date.proto\x1a*jorgejr568/portfolio_grpc/visibility.proto\"\x9c\x04\n" +
+	"\x05Skill\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x14\n" +
+	"\x05level\x18\x03 \x01(\x05R\x05level\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12E\n" +
+	"\bcategory\x18\x06 \x01(\v2).jorgejr568.portfolio_grpc.Skill.CategoryR\bcategory\x12+\n" +
+	"\x11available_locales\x18\a \x03(\tR\x10availableLocales\x129\n" +
+	"\n" +
+	"deleted_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12E\n" +
+	"\n" +
+	"visibility\x18\t \x01(\x0e2%.jorgejr568.portfolio_grpc.VisibilityR\n" +
+	"visibility\x129\n" +
+	"\n" +
+	"publish_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tpublishAt\x1a0\n" +
+	"\bCategory\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\"\xac\x02\n" +
+	"\x13GetAllSkillsRequest\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\x03R\n" +
+	"categoryId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06filter\x18\x04 \x01(\tR\x06filter\x12\x19\n" +
+	"\border_by\x18\x05 \x01(\tR\aorderBy\x127\n" +
+	"\tread_mask\x18\x06 \x01(\v2\x1a.google.protobuf.FieldMaskR\breadMask\x12!\n" +
+	"\fshow_deleted\x18\a \x01(\bR\vshowDeleted\x12)\n" +
+	"\x10show_unpublished\x18\b \x01(\bR\x0fshowUnpublished\"x\n" +
+	"\x14GetAllSkillsResponse\x128\n" +
+	"\x06skills\x18\x01 \x03(\v2 .jorgejr568.portfolio_grpc.SkillR\x06skills\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xa8\x01\n" +
+	"\x0fGetSkillRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x127\n" +
+	"\tread_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\breadMask\x12!\n" +
+	"\fshow_deleted\x18\x03 \x01(\bR\vshowDeleted\x12)\n" +
+	"\x10show_unpublished\x18\x04 \x01(\bR\x0fshowUnpublished\"\xdc\x02\n" +
+	"\x10GetSkillResponse\x126\n" +
+	"\x05skill\x18\x01 \x01(\v2 .jorgejr568.portfolio_grpc.SkillR\x05skill\x12X\n" +
+	"\vexperiences\x18\x02 \x03(\v26.jorgejr568.portfolio_grpc.GetSkillResponse.ExperienceR\vexperiences\x1a\xb5\x01\n" +
+	"\n" +
+	"Experience\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12!\n" +
+	"\fcompany_name\x18\x03 \x01(\tR\vcompanyName\x120\n" +
+	"\n" +
+	"started_at\x18\x04 \x01(\v2\x11.google.type.DateR\tstartedAt\x12,\n" +
+	"\bended_at\x18\x05 \x01(\v2\x11.google.type.DateR\aendedAt\"b\n" +
+	"\x15BatchGetSkillsRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\x03R\x03ids\x127\n" +
+	"\tread_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\breadMask\"s\n" +
+	"\x16BatchGetSkillsResponse\x128\n" +
+	"\x06skills\x18\x01 \x03(\v2 .jorgejr568.portfolio_grpc.SkillR\x06skills\x12\x1f\n" +
+	"\vmissing_ids\x18\x02 \x03(\x03R\n" +
+	"missingIds\"\x1c\n" +
+	"\x1aListSkillCategoriesRequest\"h\n" +
+	"\x1bListSkillCategoriesResponse\x12I\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2).jorgejr568.portfolio_grpc.Skill.CategoryR\n" +
+	"categories\"L\n" +
+	"\x12CreateSkillRequest\x126\n" +
+	"\x05skill\x18\x01 \x01(\v2 .jorgejr568.portfolio_grpc.SkillR\x05skill\"M\n" +
+	"\x13CreateSkillResponse\x126\n" +
+	"\x05skill\x18\x01 \x01(\v2 .jorgejr568.portfolio_grpc.SkillR\x05skill\"\x89\x01\n" +
+	"\x12UpdateSkillRequest\x126\n" +
+	"\x05skill\x18\x01 \x01(\v2 .jorgejr568.portfolio_grpc.SkillR\x05skill\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"M\n" +
+	"\x13UpdateSkillResponse\x126\n" +
+	"\x05skill\x18\x01 \x01(\v2 .jorgejr568.portfolio_grpc.SkillR\x05skill\"$\n" +
+	"\x12DeleteSkillRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x15\n" +
+	"\x13DeleteSkillResponse\"&\n" +
+	"\x14UndeleteSkillRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"O\n" +
+	"\x15UndeleteSkillResponse\x126\n" +
 	"\x05skill\x18\x01 \x01(\v2 .jorgejr568.portfolio_grpc.SkillR\x05skillB\xf4\x01\n" +
 	"\x1dcom.jorgejr568.portfolio_grpcB\vSkillsProtoP\x01ZEgithub.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc\xa2\x02\x03JPX\xaa\x02\x18Jorgejr568.PortfolioGrpc\xca\x02\x18Jorgejr568\\PortfolioGrpc\xe2\x02$Jorgejr568\\PortfolioGrpc\\GPBMetadata\xea\x02\x19Jorgejr568::PortfolioGrpcb\x06proto3"
 
@@ -355,26 +1206,60 @@ func file_jorgejr568_portfolio_grpc_skills_proto_rawDescGZIP() []byte {
 	return file_jorgejr568_portfolio_grpc_skills_proto_rawDescData
 }
 
-var file_jorgejr568_portfolio_grpc_skills_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_jorgejr568_portfolio_grpc_skills_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_jorgejr568_portfolio_grpc_skills_proto_goTypes = []any{
-	(*Skill)(nil),                 // 0: jorgejr568.portfolio_grpc.Skill
-	(*GetAllSkillsRequest)(nil),   // 1: jorgejr568.portfolio_grpc.GetAllSkillsRequest
-	(*GetAllSkillsResponse)(nil),  // 2: jorgejr568.portfolio_grpc.GetAllSkillsResponse
-	(*GetSkillRequest)(nil),       // 3: jorgejr568.portfolio_grpc.GetSkillRequest
-	(*GetSkillResponse)(nil),      // 4: jorgejr568.portfolio_grpc.GetSkillResponse
-	(*Skill_Category)(nil),        // 5: jorgejr568.portfolio_grpc.Skill.Category
-	(*timestamppb.Timestamp)(nil), // 6: google.protobuf.Timestamp
+	(*Skill)(nil),                       // 0: jorgejr568.portfolio_grpc.Skill
+	(*GetAllSkillsRequest)(nil),         // 1: jorgejr568.portfolio_grpc.GetAllSkillsRequest
+	(*GetAllSkillsResponse)(nil),        // 2: jorgejr568.portfolio_grpc.GetAllSkillsResponse
+	(*GetSkillRequest)(nil),             // 3: jorgejr568.portfolio_grpc.GetSkillRequest
+	(*GetSkillResponse)(nil),            // 4: jorgejr568.portfolio_grpc.GetSkillResponse
+	(*BatchGetSkillsRequest)(nil),       // 5: jorgejr568.portfolio_grpc.BatchGetSkillsRequest
+	(*BatchGetSkillsResponse)(nil),      // 6: jorgejr568.portfolio_grpc.BatchGetSkillsResponse
+	(*ListSkillCategoriesRequest)(nil),  // 7: jorgejr568.portfolio_grpc.ListSkillCategoriesRequest
+	(*ListSkillCategoriesResponse)(nil), // 8: jorgejr568.portfolio_grpc.ListSkillCategoriesResponse
+	(*CreateSkillRequest)(nil),          // 9: jorgejr568.portfolio_grpc.CreateSkillRequest
+	(*CreateSkillResponse)(nil),         // 10: jorgejr568.portfolio_grpc.CreateSkillResponse
+	(*UpdateSkillRequest)(nil),          // 11: jorgejr568.portfolio_grpc.UpdateSkillRequest
+	(*UpdateSkillResponse)(nil),         // 12: jorgejr568.portfolio_grpc.UpdateSkillResponse
+	(*DeleteSkillRequest)(nil),          // 13: jorgejr568.portfolio_grpc.DeleteSkillRequest
+	(*DeleteSkillResponse)(nil),         // 14: jorgejr568.portfolio_grpc.DeleteSkillResponse
+	(*UndeleteSkillRequest)(nil),        // 15: jorgejr568.portfolio_grpc.UndeleteSkillRequest
+	(*UndeleteSkillResponse)(nil),       // 16: jorgejr568.portfolio_grpc.UndeleteSkillResponse
+	(*Skill_Category)(nil),              // 17: jorgejr568.portfolio_grpc.Skill.Category
+	(*GetSkillResponse_Experience)(nil), // 18: jorgejr568.portfolio_grpc.GetSkillResponse.Experience
+	(*timestamppb.Timestamp)(nil),       // 19: google.protobuf.Timestamp
+	(Visibility)(0),                     // 20: jorgejr568.portfolio_grpc.Visibility
+	(*fieldmaskpb.FieldMask)(nil),       // 21: google.protobuf.FieldMask
+	(*date.Date)(nil),                   // 22: google.type.Date
 }
 var file_jorgejr568_portfolio_grpc_skills_proto_depIdxs = []int32{
-	6, // 0: jorgejr568.portfolio_grpc.Skill.created_at:type_name -> google.protobuf.Timestamp
-	6, // 1: jorgejr568.portfolio_grpc.Skill.updated_at:type_name -> google.protobuf.Timestamp
-	0, // 2: jorgejr568.portfolio_grpc.GetAllSkillsResponse.skills:type_name -> jorgejr568.portfolio_grpc.Skill
-	0, // 3: jorgejr568.portfolio_grpc.GetSkillResponse.skill:type_name -> jorgejr568.portfolio_grpc.Skill
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	19, // 0: jorgejr568.portfolio_grpc.Skill.created_at:type_name -> google.protobuf.Timestamp
+	19, // 1: jorgejr568.portfolio_grpc.Skill.updated_at:type_name -> google.protobuf.Timestamp
+	17, // 2: jorgejr568.portfolio_grpc.Skill.category:type_name -> jorgejr568.portfolio_grpc.Skill.Category
+	19, // 3: jorgejr568.portfolio_grpc.Skill.deleted_at:type_name -> google.protobuf.Timestamp
+	20, // 4: jorgejr568.portfolio_grpc.Skill.visibility:type_name -> jorgejr568.portfolio_grpc.Visibility
+	19, // 5: jorgejr568.portfolio_grpc.Skill.publish_at:type_name -> google.protobuf.Timestamp
+	21, // 6: jorgejr568.portfolio_grpc.GetAllSkillsRequest.read_mask:type_name -> google.protobuf.FieldMask
+	0,  // 7: jorgejr568.portfolio_grpc.GetAllSkillsResponse.skills:type_name -> jorgejr568.portfolio_grpc.Skill
+	21, // 8: jorgejr568.portfolio_grpc.GetSkillRequest.read_mask:type_name -> google.protobuf.FieldMask
+	0,  // 9: jorgejr568.portfolio_grpc.GetSkillResponse.skill:type_name -> jorgejr568.portfolio_grpc.Skill
+	18, // 10: jorgejr568.portfolio_grpc.GetSkillResponse.experiences:type_name -> jorgejr568.portfolio_grpc.GetSkillResponse.Experience
+	21, // 11: jorgejr568.portfolio_grpc.BatchGetSkillsRequest.read_mask:type_name -> google.protobuf.FieldMask
+	0,  // 12: jorgejr568.portfolio_grpc.BatchGetSkillsResponse.skills:type_name -> jorgejr568.portfolio_grpc.Skill
+	17, // 13: jorgejr568.portfolio_grpc.ListSkillCategoriesResponse.categories:type_name -> jorgejr568.portfolio_grpc.Skill.Category
+	0,  // 14: jorgejr568.portfolio_grpc.CreateSkillRequest.skill:type_name -> jorgejr568.portfolio_grpc.Skill
+	0,  // 15: jorgejr568.portfolio_grpc.CreateSkillResponse.skill:type_name -> jorgejr568.portfolio_grpc.Skill
+	0,  // 16: jorgejr568.portfolio_grpc.UpdateSkillRequest.skill:type_name -> jorgejr568.portfolio_grpc.Skill
+	21, // 17: jorgejr568.portfolio_grpc.UpdateSkillRequest.update_mask:type_name -> google.protobuf.FieldMask
+	0,  // 18: jorgejr568.portfolio_grpc.UpdateSkillResponse.skill:type_name -> jorgejr568.portfolio_grpc.Skill
+	0,  // 19: jorgejr568.portfolio_grpc.UndeleteSkillResponse.skill:type_name -> jorgejr568.portfolio_grpc.Skill
+	22, // 20: jorgejr568.portfolio_grpc.GetSkillResponse.Experience.started_at:type_name -> google.type.Date
+	22, // 21: jorgejr568.portfolio_grpc.GetSkillResponse.Experience.ended_at:type_name -> google.type.Date
+	22, // [22:22] is the sub-list for method output_type
+	22, // [22:22] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_jorgejr568_portfolio_grpc_skills_proto_init() }
@@ -382,13 +1267,14 @@ func file_jorgejr568_portfolio_grpc_skills_proto_init() {
 	if File_jorgejr568_portfolio_grpc_skills_proto != nil {
 		return
 	}
+	file_jorgejr568_portfolio_grpc_visibility_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_jorgejr568_portfolio_grpc_skills_proto_rawDesc), len(file_jorgejr568_portfolio_grpc_skills_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
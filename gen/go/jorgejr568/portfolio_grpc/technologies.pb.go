@@ -0,0 +1,268 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: jorgejr568/portfolio_grpc/technologies.proto
+
+package portfolio_grpc
+
+import (
+	date "google.golang.org/genproto/googleapis/type/date"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// TechnologyStats sums up how long a technology was used across experiences.
+type TechnologyStats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the technology as written in the most recent experience using
+	// it. Names are grouped case-insensitively.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Days the technology was used, counting overlapping experiences once and
+	// experiences without an end date until today.
+	Days int32 `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"`
+	// days expressed in years of 365.25 days.
+	Years     float64    `protobuf:"fixed64,3,opt,name=years,proto3" json:"years,omitempty"`
+	FirstUsed *date.Date `protobuf:"bytes,4,opt,name=first_used,json=firstUsed,proto3" json:"first_used,omitempty"`
+	// Today when the technology is used in a current experience.
+	LastUsed *date.Date `protobuf:"bytes,5,opt,name=last_used,json=lastUsed,proto3" json:"last_used,omitempty"`
+	// Number of experiences using the technology.
+	ExperienceCount int32 `protobuf:"varint,6,opt,name=experience_count,json=experienceCount,proto3" json:"experience_count,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TechnologyStats) Reset() {
+	*x = TechnologyStats{}
+	mi := &file_jorgejr568_portfolio_grpc_technologies_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TechnologyStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TechnologyStats) ProtoMessage() {}
+
+func (x *TechnologyStats) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_technologies_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TechnologyStats.ProtoReflect.Descriptor instead.
+func (*TechnologyStats) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_technologies_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TechnologyStats) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TechnologyStats) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *TechnologyStats) GetYears() float64 {
+	if x != nil {
+		return x.Years
+	}
+	return 0
+}
+
+func (x *TechnologyStats) GetFirstUsed() *date.Date {
+	if x != nil {
+		return x.FirstUsed
+	}
+	return nil
+}
+
+func (x *TechnologyStats) GetLastUsed() *date.Date {
+	if x != nil {
+		return x.LastUsed
+	}
+	return nil
+}
+
+func (x *TechnologyStats) GetExperienceCount() int32 {
+	if x != nil {
+		return x.ExperienceCount
+	}
+	return 0
+}
+
+type GetTechnologyStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTechnologyStatsRequest) Reset() {
+	*x = GetTechnologyStatsRequest{}
+	mi := &file_jorgejr568_portfolio_grpc_technologies_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTechnologyStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTechnologyStatsRequest) ProtoMessage() {}
+
+func (x *GetTechnologyStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_technologies_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTechnologyStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetTechnologyStatsRequest) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_technologies_proto_rawDescGZIP(), []int{1}
+}
+
+type GetTechnologyStatsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Technologies sorted by years of use, longest first.
+	Technologies  []*TechnologyStats `protobuf:"bytes,1,rep,name=technologies,proto3" json:"technologies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTechnologyStatsResponse) Reset() {
+	*x = GetTechnologyStatsResponse{}
+	mi := &file_jorgejr568_portfolio_grpc_technologies_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTechnologyStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTechnologyStatsResponse) ProtoMessage() {}
+
+func (x *GetTechnologyStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_technologies_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTechnologyStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetTechnologyStatsResponse) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_technologies_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetTechnologyStatsResponse) GetTechnologies() []*TechnologyStats {
+	if x != nil {
+		return x.Technologies
+	}
+	return nil
+}
+
+var File_jorgejr568_portfolio_grpc_technologies_proto protoreflect.FileDescriptor
+
+const file_jorgejr568_portfolio_grpc_technologies_proto_rawDesc = "" +
+	"\n" +
+	",jorgejr568/portfolio_grpc/technologies.proto\x12\x19jorgejr568.portfolio_grpc\x1a\x16google/type/date.proto\"\xdc\x01\n" +
+	"\x0fTechnologyStats\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04days\x18\x02 \x01(\x05R\x04days\x12\x14\n" +
+	"\x05years\x18\x03 \x01(\x01R\x05years\x120\n" +
+	"\n" +
+	"first_used\x18\x04 \x01(\v2\x11.google.type.DateR\tfirstUsed\x12.\n" +
+	"\tlast_used\x18\x05 \x01(\v2\x11.google.type.DateR\blastUsed\x12)\n" +
+	"\x10experience_count\x18\x06 \x01(\x05R\x0fexperienceCount\"\x1b\n" +
+	"\x19GetTechnologyStatsRequest\"l\n" +
+	"\x1aGetTechnologyStatsResponse\x12N\n" +
+	"\ftechnologies\x18\x01 \x03(\v2*.jorgejr568.portfolio_grpc.TechnologyStatsR\ftechnologiesB\xfa\x01\n" +
+	"\x1dcom.jorgejr568.portfolio_grpcB\x11TechnologiesProtoP\x01ZEgithub.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc\xa2\x02\x03JPX\xaa\x02\x18Jorgejr568.PortfolioGrpc\xca\x02\x18Jorgejr568\\PortfolioGrpc\xe2\x02$Jorgejr568\\PortfolioGrpc\\GPBMetadata\xea\x02\x19Jorgejr568::PortfolioGrpcb\x06proto3"
+
+var (
+	file_jorgejr568_portfolio_grpc_technologies_proto_rawDescOnce sync.Once
+	file_jorgejr568_portfolio_grpc_technologies_proto_rawDescData []byte
+)
+
+func file_jorgejr568_portfolio_grpc_technologies_proto_rawDescGZIP() []byte {
+	file_jorgejr568_portfolio_grpc_technologies_proto_rawDescOnce.Do(func() {
+		file_jorgejr568_portfolio_grpc_technologies_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_jorgejr568_portfolio_grpc_technologies_proto_rawDesc), len(file_jorgejr568_portfolio_grpc_technologies_proto_rawDesc)))
+	})
+	return file_jorgejr568_portfolio_grpc_technologies_proto_rawDescData
+}
+
+var file_jorgejr568_portfolio_grpc_technologies_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_jorgejr568_portfolio_grpc_technologies_proto_goTypes = []any{
+	(*TechnologyStats)(nil),            // 0: jorgejr568.portfolio_grpc.TechnologyStats
+	(*GetTechnologyStatsRequest)(nil),  // 1: jorgejr568.portfolio_grpc.GetTechnologyStatsRequest
+	(*GetTechnologyStatsResponse)(nil), // 2: jorgejr568.portfolio_grpc.GetTechnologyStatsResponse
+	(*date.Date)(nil),                  // 3: google.type.Date
+}
+var file_jorgejr568_portfolio_grpc_technologies_proto_depIdxs = []int32{
+	3, // 0: jorgejr568.portfolio_grpc.TechnologyStats.first_used:type_name -> google.type.Date
+	3, // 1: jorgejr568.portfolio_grpc.TechnologyStats.last_used:type_name -> google.type.Date
+	0, // 2: jorgejr568.portfolio_grpc.GetTechnologyStatsResponse.technologies:type_name -> jorgejr568.portfolio_grpc.TechnologyStats
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_jorgejr568_portfolio_grpc_technologies_proto_init() }
+func file_jorgejr568_portfolio_grpc_technologies_proto_init() {
+	if File_jorgejr568_portfolio_grpc_technologies_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_jorgejr568_portfolio_grpc_technologies_proto_rawDesc), len(file_jorgejr568_portfolio_grpc_technologies_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_jorgejr568_portfolio_grpc_technologies_proto_goTypes,
+		DependencyIndexes: file_jorgejr568_portfolio_grpc_technologies_proto_depIdxs,
+		MessageInfos:      file_jorgejr568_portfolio_grpc_technologies_proto_msgTypes,
+	}.Build()
+	File_jorgejr568_portfolio_grpc_technologies_proto = out.File
+	file_jorgejr568_portfolio_grpc_technologies_proto_goTypes = nil
+	file_jorgejr568_portfolio_grpc_technologies_proto_depIdxs = nil
+}
@@ -0,0 +1,697 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: jorgejr568/portfolio_grpc/testimonials.proto
+
+package portfolio_grpc
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Testimonial_State int32
+
+const (
+	Testimonial_STATE_UNSPECIFIED Testimonial_State = 0
+	// Submitted and waiting for moderation.
+	Testimonial_STATE_PENDING  Testimonial_State = 1
+	Testimonial_STATE_APPROVED Testimonial_State = 2
+	Testimonial_STATE_REJECTED Testimonial_State = 3
+)
+
+// Enum value maps for Testimonial_State.
+var (
+	Testimonial_State_name = map[int32]string{
+		0: "STATE_UNSPECIFIED",
+		1: "STATE_PENDING",
+		2: "STATE_APPROVED",
+		3: "STATE_REJECTED",
+	}
+	Testimonial_State_value = map[string]int32{
+		"STATE_UNSPECIFIED": 0,
+		"STATE_PENDING":     1,
+		"STATE_APPROVED":    2,
+		"STATE_REJECTED":    3,
+	}
+)
+
+func (x Testimonial_State) Enum() *Testimonial_State {
+	p := new(Testimonial_State)
+	*p = x
+	return p
+}
+
+func (x Testimonial_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Testimonial_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_jorgejr568_portfolio_grpc_testimonials_proto_enumTypes[0].Descriptor()
+}
+
+func (Testimonial_State) Type() protoreflect.EnumType {
+	return &file_jorgejr568_portfolio_grpc_testimonials_proto_enumTypes[0]
+}
+
+func (x Testimonial_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Testimonial_State.Descriptor instead.
+func (Testimonial_State) EnumDescriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_testimonials_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type Testimonial struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AuthorName string                 `protobuf:"bytes,2,opt,name=author_name,json=authorName,proto3" json:"author_name,omitempty"`
+	// The author's job title, e.g. "Engineering Manager at Acme".
+	AuthorRole string `protobuf:"bytes,3,opt,name=author_role,json=authorRole,proto3" json:"author_role,omitempty"`
+	// How the author knows the portfolio owner, e.g. "manager" or "peer".
+	Relationship string `protobuf:"bytes,4,opt,name=relationship,proto3" json:"relationship,omitempty"`
+	Content      string `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	// Experience the testimonial refers to, or 0 when it is not tied to one.
+	ExperienceId int64 `protobuf:"varint,6,opt,name=experience_id,json=experienceId,proto3" json:"experience_id,omitempty"`
+	// Output only.
+	State         Testimonial_State      `protobuf:"varint,7,opt,name=state,proto3,enum=jorgejr568.portfolio_grpc.Testimonial_State" json:"state,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Testimonial) Reset() {
+	*x = Testimonial{}
+	mi := &file_jorgejr568_portfolio_grpc_testimonials_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Testimonial) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Testimonial) ProtoMessage() {}
+
+func (x *Testimonial) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_testimonials_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Testimonial.ProtoReflect.Descriptor instead.
+func (*Testimonial) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_testimonials_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Testimonial) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Testimonial) GetAuthorName() string {
+	if x != nil {
+		return x.AuthorName
+	}
+	return ""
+}
+
+func (x *Testimonial) GetAuthorRole() string {
+	if x != nil {
+		return x.AuthorRole
+	}
+	return ""
+}
+
+func (x *Testimonial) GetRelationship() string {
+	if x != nil {
+		return x.Relationship
+	}
+	return ""
+}
+
+func (x *Testimonial) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Testimonial) GetExperienceId() int64 {
+	if x != nil {
+		return x.ExperienceId
+	}
+	return 0
+}
+
+func (x *Testimonial) GetState() Testimonial_State {
+	if x != nil {
+		return x.State
+	}
+	return Testimonial_STATE_UNSPECIFIED
+}
+
+func (x *Testimonial) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Testimonial) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type GetAllTestimonialsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Maximum number of results to return. Defaults to 50 and is capped at 1000.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token of a previous response, to fetch the following page.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Fields of each testimonial to return. Every field is returned when unset.
+	ReadMask *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	// Only return testimonials in this state. Defaults to STATE_APPROVED;
+	// listing pending or rejected testimonials is admin only.
+	State         Testimonial_State `protobuf:"varint,4,opt,name=state,proto3,enum=jorgejr568.portfolio_grpc.Testimonial_State" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllTestimonialsRequest) Reset() {
+	*x = GetAllTestimonialsRequest{}
+	mi := &file_jorgejr568_portfolio_grpc_testimonials_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllTestimonialsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllTestimonialsRequest) ProtoMessage() {}
+
+func (x *GetAllTestimonialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_testimonials_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllTestimonialsRequest.ProtoReflect.Descriptor instead.
+func (*GetAllTestimonialsRequest) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_testimonials_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetAllTestimonialsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetAllTestimonialsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetAllTestimonialsRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+func (x *GetAllTestimonialsRequest) GetState() Testimonial_State {
+	if x != nil {
+		return x.State
+	}
+	return Testimonial_STATE_UNSPECIFIED
+}
+
+type GetAllTestimonialsResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Testimonials []*Testimonial         `protobuf:"bytes,1,rep,name=testimonials,proto3" json:"testimonials,omitempty"`
+	// Token for the next page, empty when there are no more results.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllTestimonialsResponse) Reset() {
+	*x = GetAllTestimonialsResponse{}
+	mi := &file_jorgejr568_portfolio_grpc_testimonials_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllTestimonialsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllTestimonialsResponse) ProtoMessage() {}
+
+func (x *GetAllTestimonialsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_testimonials_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllTestimonialsResponse.ProtoReflect.Descriptor instead.
+func (*GetAllTestimonialsResponse) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_testimonials_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetAllTestimonialsResponse) GetTestimonials() []*Testimonial {
+	if x != nil {
+		return x.Testimonials
+	}
+	return nil
+}
+
+func (x *GetAllTestimonialsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type SubmitTestimonialRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Testimonial   *Testimonial           `protobuf:"bytes,1,opt,name=testimonial,proto3" json:"testimonial,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitTestimonialRequest) Reset() {
+	*x = SubmitTestimonialRequest{}
+	mi := &file_jorgejr568_portfolio_grpc_testimonials_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitTestimonialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitTestimonialRequest) ProtoMessage() {}
+
+func (x *SubmitTestimonialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_testimonials_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitTestimonialRequest.ProtoReflect.Descriptor instead.
+func (*SubmitTestimonialRequest) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_testimonials_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SubmitTestimonialRequest) GetTestimonial() *Testimonial {
+	if x != nil {
+		return x.Testimonial
+	}
+	return nil
+}
+
+type SubmitTestimonialResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Testimonial   *Testimonial           `protobuf:"bytes,1,opt,name=testimonial,proto3" json:"testimonial,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitTestimonialResponse) Reset() {
+	*x = SubmitTestimonialResponse{}
+	mi := &file_jorgejr568_portfolio_grpc_testimonials_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitTestimonialResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitTestimonialResponse) ProtoMessage() {}
+
+func (x *SubmitTestimonialResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_testimonials_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitTestimonialResponse.ProtoReflect.Descriptor instead.
+func (*SubmitTestimonialResponse) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_testimonials_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SubmitTestimonialResponse) GetTestimonial() *Testimonial {
+	if x != nil {
+		return x.Testimonial
+	}
+	return nil
+}
+
+type ApproveTestimonialRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveTestimonialRequest) Reset() {
+	*x = ApproveTestimonialRequest{}
+	mi := &file_jorgejr568_portfolio_grpc_testimonials_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveTestimonialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveTestimonialRequest) ProtoMessage() {}
+
+func (x *ApproveTestimonialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_testimonials_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveTestimonialRequest.ProtoReflect.Descriptor instead.
+func (*ApproveTestimonialRequest) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_testimonials_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ApproveTestimonialRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ApproveTestimonialResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Testimonial   *Testimonial           `protobuf:"bytes,1,opt,name=testimonial,proto3" json:"testimonial,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveTestimonialResponse) Reset() {
+	*x = ApproveTestimonialResponse{}
+	mi := &file_jorgejr568_portfolio_grpc_testimonials_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveTestimonialResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveTestimonialResponse) ProtoMessage() {}
+
+func (x *ApproveTestimonialResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_testimonials_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveTestimonialResponse.ProtoReflect.Descriptor instead.
+func (*ApproveTestimonialResponse) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_testimonials_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ApproveTestimonialResponse) GetTestimonial() *Testimonial {
+	if x != nil {
+		return x.Testimonial
+	}
+	return nil
+}
+
+type RejectTestimonialRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectTestimonialRequest) Reset() {
+	*x = RejectTestimonialRequest{}
+	mi := &file_jorgejr568_portfolio_grpc_testimonials_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectTestimonialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectTestimonialRequest) ProtoMessage() {}
+
+func (x *RejectTestimonialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_testimonials_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectTestimonialRequest.ProtoReflect.Descriptor instead.
+func (*RejectTestimonialRequest) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_testimonials_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RejectTestimonialRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RejectTestimonialResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Testimonial   *Testimonial           `protobuf:"bytes,1,opt,name=testimonial,proto3" json:"testimonial,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectTestimonialResponse) Reset() {
+	*x = RejectTestimonialResponse{}
+	mi := &file_jorgejr568_portfolio_grpc_testimonials_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectTestimonialResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectTestimonialResponse) ProtoMessage() {}
+
+func (x *RejectTestimonialResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_testimonials_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectTestimonialResponse.ProtoReflect.Descriptor instead.
+func (*RejectTestimonialResponse) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_testimonials_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RejectTestimonialResponse) GetTestimonial() *Testimonial {
+	if x != nil {
+		return x.Testimonial
+	}
+	return nil
+}
+
+var File_jorgejr568_portfolio_grpc_testimonials_proto protoreflect.FileDescriptor
+
+const file_jorgejr568_portfolio_grpc_testimonials_proto_rawDesc = "" +
+	"\n" +
+	",jorgejr568/portfolio_grpc/testimonials.proto\x12\x19jorgejr568.portfolio_grpc\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd7\x03\n" +
+	"\vTestimonial\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
+	"\vauthor_name\x18\x02 \x01(\tR\n" +
+	"authorName\x12\x1f\n" +
+	"\vauthor_role\x18\x03 \x01(\tR\n" +
+	"authorRole\x12\"\n" +
+	"\frelationship\x18\x04 \x01(\tR\frelationship\x12\x18\n" +
+	"\acontent\x18\x05 \x01(\tR\acontent\x12#\n" +
+	"\rexperience_id\x18\x06 \x01(\x03R\fexperienceId\x12B\n" +
+	"\x05state\x18\a \x01(\x0e2,.jorgejr568.portfolio_grpc.Testimonial.StateR\x05state\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"Y\n" +
+	"\x05State\x12\x15\n" +
+	"\x11STATE_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\rSTATE_PENDING\x10\x01\x12\x12\n" +
+	"\x0eSTATE_APPROVED\x10\x02\x12\x12\n" +
+	"\x0eSTATE_REJECTED\x10\x03\"\xd4\x01\n" +
+	"\x19GetAllTestimonialsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x127\n" +
+	"\tread_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\breadMask\x12B\n" +
+	"\x05state\x18\x04 \x01(\x0e2,.jorgejr568.portfolio_grpc.Testimonial.StateR\x05state\"\x90\x01\n" +
+	"\x1aGetAllTestimonialsResponse\x12J\n" +
+	"\ftestimonials\x18\x01 \x03(\v2&.jorgejr568.portfolio_grpc.TestimonialR\ftestimonials\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"d\n" +
+	"\x18SubmitTestimonialRequest\x12H\n" +
+	"\vtestimonial\x18\x01 \x01(\v2&.jorgejr568.portfolio_grpc.TestimonialR\vtestimonial\"e\n" +
+	"\x19SubmitTestimonialResponse\x12H\n" +
+	"\vtestimonial\x18\x01 \x01(\v2&.jorgejr568.portfolio_grpc.TestimonialR\vtestimonial\"+\n" +
+	"\x19ApproveTestimonialRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"f\n" +
+	"\x1aApproveTestimonialResponse\x12H\n" +
+	"\vtestimonial\x18\x01 \x01(\v2&.jorgejr568.portfolio_grpc.TestimonialR\vtestimonial\"*\n" +
+	"\x18RejectTestimonialRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"e\n" +
+	"\x19RejectTestimonialResponse\x12H\n" +
+	"\vtestimonial\x18\x01 \x01(\v2&.jorgejr568.portfolio_grpc.TestimonialR\vtestimonialB\xfa\x01\n" +
+	"\x1dcom.jorgejr568.portfolio_grpcB\x11TestimonialsProtoP\x01ZEgithub.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc\xa2\x02\x03JPX\xaa\x02\x18Jorgejr568.PortfolioGrpc\xca\x02\x18Jorgejr568\\PortfolioGrpc\xe2\x02$Jorgejr568\\PortfolioGrpc\\GPBMetadata\xea\x02\x19Jorgejr568::PortfolioGrpcb\x06proto3"
+
+var (
+	file_jorgejr568_portfolio_grpc_testimonials_proto_rawDescOnce sync.Once
+	file_jorgejr568_portfolio_grpc_testimonials_proto_rawDescData []byte
+)
+
+func file_jorgejr568_portfolio_grpc_testimonials_proto_rawDescGZIP() []byte {
+	file_jorgejr568_portfolio_grpc_testimonials_proto_rawDescOnce.Do(func() {
+		file_jorgejr568_portfolio_grpc_testimonials_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_jorgejr568_portfolio_grpc_testimonials_proto_rawDesc), len(file_jorgejr568_portfolio_grpc_testimonials_proto_rawDesc)))
+	})
+	return file_jorgejr568_portfolio_grpc_testimonials_proto_rawDescData
+}
+
+var file_jorgejr568_portfolio_grpc_testimonials_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_jorgejr568_portfolio_grpc_testimonials_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_jorgejr568_portfolio_grpc_testimonials_proto_goTypes = []any{
+	(Testimonial_State)(0),             // 0: jorgejr568.portfolio_grpc.Testimonial.State
+	(*Testimonial)(nil),                // 1: jorgejr568.portfolio_grpc.Testimonial
+	(*GetAllTestimonialsRequest)(nil),  // 2: jorgejr568.portfolio_grpc.GetAllTestimonialsRequest
+	(*GetAllTestimonialsResponse)(nil), // 3: jorgejr568.portfolio_grpc.GetAllTestimonialsResponse
+	(*SubmitTestimonialRequest)(nil),   // 4: jorgejr568.portfolio_grpc.SubmitTestimonialRequest
+	(*SubmitTestimonialResponse)(nil),  // 5: jorgejr568.portfolio_grpc.SubmitTestimonialResponse
+	(*ApproveTestimonialRequest)(nil),  // 6: jorgejr568.portfolio_grpc.ApproveTestimonialRequest
+	(*ApproveTestimonialResponse)(nil), // 7: jorgejr568.portfolio_grpc.ApproveTestimonialResponse
+	(*RejectTestimonialRequest)(nil),   // 8: jorgejr568.portfolio_grpc.RejectTestimonialRequest
+	(*RejectTestimonialResponse)(nil),  // 9: jorgejr568.portfolio_grpc.RejectTestimonialResponse
+	(*timestamppb.Timestamp)(nil),      // 10: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),      // 11: google.protobuf.FieldMask
+}
+var file_jorgejr568_portfolio_grpc_testimonials_proto_depIdxs = []int32{
+	0,  // 0: jorgejr568.portfolio_grpc.Testimonial.state:type_name -> jorgejr568.portfolio_grpc.Testimonial.State
+	10, // 1: jorgejr568.portfolio_grpc.Testimonial.created_at:type_name -> google.protobuf.Timestamp
+	10, // 2: jorgejr568.portfolio_grpc.Testimonial.updated_at:type_name -> google.protobuf.Timestamp
+	11, // 3: jorgejr568.portfolio_grpc.GetAllTestimonialsRequest.read_mask:type_name -> google.protobuf.FieldMask
+	0,  // 4: jorgejr568.portfolio_grpc.GetAllTestimonialsRequest.state:type_name -> jorgejr568.portfolio_grpc.Testimonial.State
+	1,  // 5: jorgejr568.portfolio_grpc.GetAllTestimonialsResponse.testimonials:type_name -> jorgejr568.portfolio_grpc.Testimonial
+	1,  // 6: jorgejr568.portfolio_grpc.SubmitTestimonialRequest.testimonial:type_name -> jorgejr568.portfolio_grpc.Testimonial
+	1,  // 7: jorgejr568.portfolio_grpc.SubmitTestimonialResponse.testimonial:type_name -> jorgejr568.portfolio_grpc.Testimonial
+	1,  // 8: jorgejr568.portfolio_grpc.ApproveTestimonialResponse.testimonial:type_name -> jorgejr568.portfolio_grpc.Testimonial
+	1,  // 9: jorgejr568.portfolio_grpc.RejectTestimonialResponse.testimonial:type_name -> jorgejr568.portfolio_grpc.Testimonial
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_jorgejr568_portfolio_grpc_testimonials_proto_init() }
+func file_jorgejr568_portfolio_grpc_testimonials_proto_init() {
+	if File_jorgejr568_portfolio_grpc_testimonials_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_jorgejr568_portfolio_grpc_testimonials_proto_rawDesc), len(file_jorgejr568_portfolio_grpc_testimonials_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_jorgejr568_portfolio_grpc_testimonials_proto_goTypes,
+		DependencyIndexes: file_jorgejr568_portfolio_grpc_testimonials_proto_depIdxs,
+		EnumInfos:         file_jorgejr568_portfolio_grpc_testimonials_proto_enumTypes,
+		MessageInfos:      file_jorgejr568_portfolio_grpc_testimonials_proto_msgTypes,
+	}.Build()
+	File_jorgejr568_portfolio_grpc_testimonials_proto = out.File
+	file_jorgejr568_portfolio_grpc_testimonials_proto_goTypes = nil
+	file_jorgejr568_portfolio_grpc_testimonials_proto_depIdxs = nil
+}
@@ -0,0 +1,620 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: jorgejr568/portfolio_grpc/timeline.proto
+
+package portfolio_grpc
+
+import (
+	date "google.golang.org/genproto/googleapis/type/date"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TimelineEntry_Type int32
+
+const (
+	TimelineEntry_TYPE_UNSPECIFIED TimelineEntry_Type = 0
+	TimelineEntry_TYPE_EXPERIENCE  TimelineEntry_Type = 1
+	TimelineEntry_TYPE_EDUCATION   TimelineEntry_Type = 2
+	TimelineEntry_TYPE_PROJECT     TimelineEntry_Type = 3
+	// Placed on the day it was issued.
+	TimelineEntry_TYPE_CERTIFICATION TimelineEntry_Type = 4
+	// Placed on the day it was published.
+	TimelineEntry_TYPE_PUBLICATION TimelineEntry_Type = 5
+)
+
+// Enum value maps for TimelineEntry_Type.
+var (
+	TimelineEntry_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "TYPE_EXPERIENCE",
+		2: "TYPE_EDUCATION",
+		3: "TYPE_PROJECT",
+		4: "TYPE_CERTIFICATION",
+		5: "TYPE_PUBLICATION",
+	}
+	TimelineEntry_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED":   0,
+		"TYPE_EXPERIENCE":    1,
+		"TYPE_EDUCATION":     2,
+		"TYPE_PROJECT":       3,
+		"TYPE_CERTIFICATION": 4,
+		"TYPE_PUBLICATION":   5,
+	}
+)
+
+func (x TimelineEntry_Type) Enum() *TimelineEntry_Type {
+	p := new(TimelineEntry_Type)
+	*p = x
+	return p
+}
+
+func (x TimelineEntry_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TimelineEntry_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_jorgejr568_portfolio_grpc_timeline_proto_enumTypes[0].Descriptor()
+}
+
+func (TimelineEntry_Type) Type() protoreflect.EnumType {
+	return &file_jorgejr568_portfolio_grpc_timeline_proto_enumTypes[0]
+}
+
+func (x TimelineEntry_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TimelineEntry_Type.Descriptor instead.
+func (TimelineEntry_Type) EnumDescriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_timeline_proto_rawDescGZIP(), []int{0, 0}
+}
+
+// TimelineEntry is a dated item of the portfolio placed on the timeline.
+type TimelineEntry struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Type      TimelineEntry_Type     `protobuf:"varint,1,opt,name=type,proto3,enum=jorgejr568.portfolio_grpc.TimelineEntry_Type" json:"type,omitempty"`
+	Id        int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Title     string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	StartedAt *date.Date             `protobuf:"bytes,4,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	// Unset for ongoing entries and for certifications and publications.
+	EndedAt *date.Date `protobuf:"bytes,5,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
+	// Experiences, educations and projects whose period shares at least a day
+	// with this one. Ongoing entries last until today. Always empty for
+	// certifications and publications.
+	Overlaps []*TimelineEntry_Ref `protobuf:"bytes,6,rep,name=overlaps,proto3" json:"overlaps,omitempty"`
+	// Types that are valid to be assigned to Item:
+	//
+	//	*TimelineEntry_Experience
+	//	*TimelineEntry_Education
+	//	*TimelineEntry_Project
+	//	*TimelineEntry_Certification
+	//	*TimelineEntry_Publication
+	Item          isTimelineEntry_Item `protobuf_oneof:"item"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimelineEntry) Reset() {
+	*x = TimelineEntry{}
+	mi := &file_jorgejr568_portfolio_grpc_timeline_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimelineEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimelineEntry) ProtoMessage() {}
+
+func (x *TimelineEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_timeline_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimelineEntry.ProtoReflect.Descriptor instead.
+func (*TimelineEntry) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_timeline_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TimelineEntry) GetType() TimelineEntry_Type {
+	if x != nil {
+		return x.Type
+	}
+	return TimelineEntry_TYPE_UNSPECIFIED
+}
+
+func (x *TimelineEntry) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TimelineEntry) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *TimelineEntry) GetStartedAt() *date.Date {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *TimelineEntry) GetEndedAt() *date.Date {
+	if x != nil {
+		return x.EndedAt
+	}
+	return nil
+}
+
+func (x *TimelineEntry) GetOverlaps() []*TimelineEntry_Ref {
+	if x != nil {
+		return x.Overlaps
+	}
+	return nil
+}
+
+func (x *TimelineEntry) GetItem() isTimelineEntry_Item {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *TimelineEntry) GetExperience() *Experience {
+	if x != nil {
+		if x, ok := x.Item.(*TimelineEntry_Experience); ok {
+			return x.Experience
+		}
+	}
+	return nil
+}
+
+func (x *TimelineEntry) GetEducation() *Education {
+	if x != nil {
+		if x, ok := x.Item.(*TimelineEntry_Education); ok {
+			return x.Education
+		}
+	}
+	return nil
+}
+
+func (x *TimelineEntry) GetProject() *Project {
+	if x != nil {
+		if x, ok := x.Item.(*TimelineEntry_Project); ok {
+			return x.Project
+		}
+	}
+	return nil
+}
+
+func (x *TimelineEntry) GetCertification() *Certification {
+	if x != nil {
+		if x, ok := x.Item.(*TimelineEntry_Certification); ok {
+			return x.Certification
+		}
+	}
+	return nil
+}
+
+func (x *TimelineEntry) GetPublication() *Publication {
+	if x != nil {
+		if x, ok := x.Item.(*TimelineEntry_Publication); ok {
+			return x.Publication
+		}
+	}
+	return nil
+}
+
+type isTimelineEntry_Item interface {
+	isTimelineEntry_Item()
+}
+
+type TimelineEntry_Experience struct {
+	Experience *Experience `protobuf:"bytes,7,opt,name=experience,proto3,oneof"`
+}
+
+type TimelineEntry_Education struct {
+	Education *Education `protobuf:"bytes,8,opt,name=education,proto3,oneof"`
+}
+
+type TimelineEntry_Project struct {
+	Project *Project `protobuf:"bytes,9,opt,name=project,proto3,oneof"`
+}
+
+type TimelineEntry_Certification struct {
+	Certification *Certification `protobuf:"bytes,10,opt,name=certification,proto3,oneof"`
+}
+
+type TimelineEntry_Publication struct {
+	Publication *Publication `protobuf:"bytes,11,opt,name=publication,proto3,oneof"`
+}
+
+func (*TimelineEntry_Experience) isTimelineEntry_Item() {}
+
+func (*TimelineEntry_Education) isTimelineEntry_Item() {}
+
+func (*TimelineEntry_Project) isTimelineEntry_Item() {}
+
+func (*TimelineEntry_Certification) isTimelineEntry_Item() {}
+
+func (*TimelineEntry_Publication) isTimelineEntry_Item() {}
+
+type GetTimelineRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only include entries of these types. Every type is included when empty.
+	Types []TimelineEntry_Type `protobuf:"varint,1,rep,packed,name=types,proto3,enum=jorgejr568.portfolio_grpc.TimelineEntry_Type" json:"types,omitempty"`
+	// Return the entries grouped by the year they started in, in years,
+	// instead of in entries.
+	GroupByYear bool `protobuf:"varint,2,opt,name=group_by_year,json=groupByYear,proto3" json:"group_by_year,omitempty"`
+	// Sort the most recent entries first instead of the oldest.
+	NewestFirst   bool `protobuf:"varint,3,opt,name=newest_first,json=newestFirst,proto3" json:"newest_first,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTimelineRequest) Reset() {
+	*x = GetTimelineRequest{}
+	mi := &file_jorgejr568_portfolio_grpc_timeline_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTimelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTimelineRequest) ProtoMessage() {}
+
+func (x *GetTimelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_timeline_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTimelineRequest.ProtoReflect.Descriptor instead.
+func (*GetTimelineRequest) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_timeline_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetTimelineRequest) GetTypes() []TimelineEntry_Type {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *GetTimelineRequest) GetGroupByYear() bool {
+	if x != nil {
+		return x.GroupByYear
+	}
+	return false
+}
+
+func (x *GetTimelineRequest) GetNewestFirst() bool {
+	if x != nil {
+		return x.NewestFirst
+	}
+	return false
+}
+
+type GetTimelineResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Entries sorted by started_at. Empty when group_by_year is set.
+	Entries []*TimelineEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	// Years in the order of their entries. Empty unless group_by_year is set.
+	Years         []*GetTimelineResponse_Year `protobuf:"bytes,2,rep,name=years,proto3" json:"years,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTimelineResponse) Reset() {
+	*x = GetTimelineResponse{}
+	mi := &file_jorgejr568_portfolio_grpc_timeline_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTimelineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTimelineResponse) ProtoMessage() {}
+
+func (x *GetTimelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_timeline_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTimelineResponse.ProtoReflect.Descriptor instead.
+func (*GetTimelineResponse) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_timeline_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetTimelineResponse) GetEntries() []*TimelineEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GetTimelineResponse) GetYears() []*GetTimelineResponse_Year {
+	if x != nil {
+		return x.Years
+	}
+	return nil
+}
+
+// Ref identifies another entry of the timeline.
+type TimelineEntry_Ref struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          TimelineEntry_Type     `protobuf:"varint,1,opt,name=type,proto3,enum=jorgejr568.portfolio_grpc.TimelineEntry_Type" json:"type,omitempty"`
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimelineEntry_Ref) Reset() {
+	*x = TimelineEntry_Ref{}
+	mi := &file_jorgejr568_portfolio_grpc_timeline_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimelineEntry_Ref) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimelineEntry_Ref) ProtoMessage() {}
+
+func (x *TimelineEntry_Ref) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_timeline_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimelineEntry_Ref.ProtoReflect.Descriptor instead.
+func (*TimelineEntry_Ref) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_timeline_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *TimelineEntry_Ref) GetType() TimelineEntry_Type {
+	if x != nil {
+		return x.Type
+	}
+	return TimelineEntry_TYPE_UNSPECIFIED
+}
+
+func (x *TimelineEntry_Ref) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetTimelineResponse_Year struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Year          int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	Entries       []*TimelineEntry       `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTimelineResponse_Year) Reset() {
+	*x = GetTimelineResponse_Year{}
+	mi := &file_jorgejr568_portfolio_grpc_timeline_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTimelineResponse_Year) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTimelineResponse_Year) ProtoMessage() {}
+
+func (x *GetTimelineResponse_Year) ProtoReflect() protoreflect.Message {
+	mi := &file_jorgejr568_portfolio_grpc_timeline_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTimelineResponse_Year.ProtoReflect.Descriptor instead.
+func (*GetTimelineResponse_Year) Descriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_timeline_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *GetTimelineResponse_Year) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *GetTimelineResponse_Year) GetEntries() []*TimelineEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+var File_jorgejr568_portfolio_grpc_timeline_proto protoreflect.FileDescriptor
+
+const file_jorgejr568_portfolio_grpc_timeline_proto_rawDesc = "" +
+	"\n" +
+	"(jorgejr568/portfolio_grpc/timeline.proto\x12\x19jorgejr568.portfolio_grpc\x1a\x16google/type/date.proto\x1a.jorgejr568/portfolio_grpc/certifications.proto\x1a*jorgejr568/portfolio_grpc/educations.proto\x1a+jorgejr568/portfolio_grpc/experiences.proto\x1a(jorgejr568/portfolio_grpc/projects.proto\x1a,jorgejr568/portfolio_grpc/publications.proto\"\xf9\x06\n" +
+	"\rTimelineEntry\x12A\n" +
+	"\x04type\x18\x01 \x01(\x0e2-.jorgejr568.portfolio_grpc.TimelineEntry.TypeR\x04type\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x120\n" +
+	"\n" +
+	"started_at\x18\x04 \x01(\v2\x11.google.type.DateR\tstartedAt\x12,\n" +
+	"\bended_at\x18\x05 \x01(\v2\x11.google.type.DateR\aendedAt\x12H\n" +
+	"\boverlaps\x18\x06 \x03(\v2,.jorgejr568.portfolio_grpc.TimelineEntry.RefR\boverlaps\x12G\n" +
+	"\n" +
+	"experience\x18\a \x01(\v2%.jorgejr568.portfolio_grpc.ExperienceH\x00R\n" +
+	"experience\x12D\n" +
+	"\teducation\x18\b \x01(\v2$.jorgejr568.portfolio_grpc.EducationH\x00R\teducation\x12>\n" +
+	"\aproject\x18\t \x01(\v2\".jorgejr568.portfolio_grpc.ProjectH\x00R\aproject\x12P\n" +
+	"\rcertification\x18\n" +
+	" \x01(\v2(.jorgejr568.portfolio_grpc.CertificationH\x00R\rcertification\x12J\n" +
+	"\vpublication\x18\v \x01(\v2&.jorgejr568.portfolio_grpc.PublicationH\x00R\vpublication\x1aX\n" +
+	"\x03Ref\x12A\n" +
+	"\x04type\x18\x01 \x01(\x0e2-.jorgejr568.portfolio_grpc.TimelineEntry.TypeR\x04type\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\"\x85\x01\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fTYPE_EXPERIENCE\x10\x01\x12\x12\n" +
+	"\x0eTYPE_EDUCATION\x10\x02\x12\x10\n" +
+	"\fTYPE_PROJECT\x10\x03\x12\x16\n" +
+	"\x12TYPE_CERTIFICATION\x10\x04\x12\x14\n" +
+	"\x10TYPE_PUBLICATION\x10\x05B\x06\n" +
+	"\x04item\"\xa0\x01\n" +
+	"\x12GetTimelineRequest\x12C\n" +
+	"\x05types\x18\x01 \x03(\x0e2-.jorgejr568.portfolio_grpc.TimelineEntry.TypeR\x05types\x12\"\n" +
+	"\rgroup_by_year\x18\x02 \x01(\bR\vgroupByYear\x12!\n" +
+	"\fnewest_first\x18\x03 \x01(\bR\vnewestFirst\"\x84\x02\n" +
+	"\x13GetTimelineResponse\x12B\n" +
+	"\aentries\x18\x01 \x03(\v2(.jorgejr568.portfolio_grpc.TimelineEntryR\aentries\x12I\n" +
+	"\x05years\x18\x02 \x03(\v23.jorgejr568.portfolio_grpc.GetTimelineResponse.YearR\x05years\x1a^\n" +
+	"\x04Year\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\x05R\x04year\x12B\n" +
+	"\aentries\x18\x02 \x03(\v2(.jorgejr568.portfolio_grpc.TimelineEntryR\aentriesB\xf6\x01\n" +
+	"\x1dcom.jorgejr568.portfolio_grpcB\rTimelineProtoP\x01ZEgithub.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc\xa2\x02\x03JPX\xaa\x02\x18Jorgejr568.PortfolioGrpc\xca\x02\x18Jorgejr568\\PortfolioGrpc\xe2\x02$Jorgejr568\\PortfolioGrpc\\GPBMetadata\xea\x02\x19Jorgejr568::PortfolioGrpcb\x06proto3"
+
+var (
+	file_jorgejr568_portfolio_grpc_timeline_proto_rawDescOnce sync.Once
+	file_jorgejr568_portfolio_grpc_timeline_proto_rawDescData []byte
+)
+
+func file_jorgejr568_portfolio_grpc_timeline_proto_rawDescGZIP() []byte {
+	file_jorgejr568_portfolio_grpc_timeline_proto_rawDescOnce.Do(func() {
+		file_jorgejr568_portfolio_grpc_timeline_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_jorgejr568_portfolio_grpc_timeline_proto_rawDesc), len(file_jorgejr568_portfolio_grpc_timeline_proto_rawDesc)))
+	})
+	return file_jorgejr568_portfolio_grpc_timeline_proto_rawDescData
+}
+
+var file_jorgejr568_portfolio_grpc_timeline_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_jorgejr568_portfolio_grpc_timeline_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_jorgejr568_portfolio_grpc_timeline_proto_goTypes = []any{
+	(TimelineEntry_Type)(0),          // 0: jorgejr568.portfolio_grpc.TimelineEntry.Type
+	(*TimelineEntry)(nil),            // 1: jorgejr568.portfolio_grpc.TimelineEntry
+	(*GetTimelineRequest)(nil),       // 2: jorgejr568.portfolio_grpc.GetTimelineRequest
+	(*GetTimelineResponse)(nil),      // 3: jorgejr568.portfolio_grpc.GetTimelineResponse
+	(*TimelineEntry_Ref)(nil),        // 4: jorgejr568.portfolio_grpc.TimelineEntry.Ref
+	(*GetTimelineResponse_Year)(nil), // 5: jorgejr568.portfolio_grpc.GetTimelineResponse.Year
+	(*date.Date)(nil),                // 6: google.type.Date
+	(*Experience)(nil),               // 7: jorgejr568.portfolio_grpc.Experience
+	(*Education)(nil),                // 8: jorgejr568.portfolio_grpc.Education
+	(*Project)(nil),                  // 9: jorgejr568.portfolio_grpc.Project
+	(*Certification)(nil),            // 10: jorgejr568.portfolio_grpc.Certification
+	(*Publication)(nil),              // 11: jorgejr568.portfolio_grpc.Publication
+}
+var file_jorgejr568_portfolio_grpc_timeline_proto_depIdxs = []int32{
+	0,  // 0: jorgejr568.portfolio_grpc.TimelineEntry.type:type_name -> jorgejr568.portfolio_grpc.TimelineEntry.Type
+	6,  // 1: jorgejr568.portfolio_grpc.TimelineEntry.started_at:type_name -> google.type.Date
+	6,  // 2: jorgejr568.portfolio_grpc.TimelineEntry.ended_at:type_name -> google.type.Date
+	4,  // 3: jorgejr568.portfolio_grpc.TimelineEntry.overlaps:type_name -> jorgejr568.portfolio_grpc.TimelineEntry.Ref
+	7,  // 4: jorgejr568.portfolio_grpc.TimelineEntry.experience:type_name -> jorgejr568.portfolio_grpc.Experience
+	8,  // 5: jorgejr568.portfolio_grpc.TimelineEntry.education:type_name -> jorgejr568.portfolio_grpc.Education
+	9,  // 6: jorgejr568.portfolio_grpc.TimelineEntry.project:type_name -> jorgejr568.portfolio_grpc.Project
+	10, // 7: jorgejr568.portfolio_grpc.TimelineEntry.certification:type_name -> jorgejr568.portfolio_grpc.Certification
+	11, // 8: jorgejr568.portfolio_grpc.TimelineEntry.publication:type_name -> jorgejr568.portfolio_grpc.Publication
+	0,  // 9: jorgejr568.portfolio_grpc.GetTimelineRequest.types:type_name -> jorgejr568.portfolio_grpc.TimelineEntry.Type
+	1,  // 10: jorgejr568.portfolio_grpc.GetTimelineResponse.entries:type_name -> jorgejr568.portfolio_grpc.TimelineEntry
+	5,  // 11: jorgejr568.portfolio_grpc.GetTimelineResponse.years:type_name -> jorgejr568.portfolio_grpc.GetTimelineResponse.Year
+	0,  // 12: jorgejr568.portfolio_grpc.TimelineEntry.Ref.type:type_name -> jorgejr568.portfolio_grpc.TimelineEntry.Type
+	1,  // 13: jorgejr568.portfolio_grpc.GetTimelineResponse.Year.entries:type_name -> jorgejr568.portfolio_grpc.TimelineEntry
+	14, // [14:14] is the sub-list for method output_type
+	14, // [14:14] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
+}
+
+func init() { file_jorgejr568_portfolio_grpc_timeline_proto_init() }
+func file_jorgejr568_portfolio_grpc_timeline_proto_init() {
+	if File_jorgejr568_portfolio_grpc_timeline_proto != nil {
+		return
+	}
+	file_jorgejr568_portfolio_grpc_certifications_proto_init()
+	file_jorgejr568_portfolio_grpc_educations_proto_init()
+	file_jorgejr568_portfolio_grpc_experiences_proto_init()
+	file_jorgejr568_portfolio_grpc_projects_proto_init()
+	file_jorgejr568_portfolio_grpc_publications_proto_init()
+	file_jorgejr568_portfolio_grpc_timeline_proto_msgTypes[0].OneofWrappers = []any{
+		(*TimelineEntry_Experience)(nil),
+		(*TimelineEntry_Education)(nil),
+		(*TimelineEntry_Project)(nil),
+		(*TimelineEntry_Certification)(nil),
+		(*TimelineEntry_Publication)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_jorgejr568_portfolio_grpc_timeline_proto_rawDesc), len(file_jorgejr568_portfolio_grpc_timeline_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_jorgejr568_portfolio_grpc_timeline_proto_goTypes,
+		DependencyIndexes: file_jorgejr568_portfolio_grpc_timeline_proto_depIdxs,
+		EnumInfos:         file_jorgejr568_portfolio_grpc_timeline_proto_enumTypes,
+		MessageInfos:      file_jorgejr568_portfolio_grpc_timeline_proto_msgTypes,
+	}.Build()
+	File_jorgejr568_portfolio_grpc_timeline_proto = out.File
+	file_jorgejr568_portfolio_grpc_timeline_proto_goTypes = nil
+	file_jorgejr568_portfolio_grpc_timeline_proto_depIdxs = nil
+}
@@ -0,0 +1,139 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: jorgejr568/portfolio_grpc/visibility.proto
+
+package portfolio_grpc
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Visibility controls whether an entry is served to the public.
+type Visibility int32
+
+const (
+	Visibility_VISIBILITY_UNSPECIFIED Visibility = 0
+	// Staged and only shown to admins previewing unpublished entries.
+	Visibility_VISIBILITY_DRAFT Visibility = 1
+	// Public once its publish_at, if set, has passed.
+	Visibility_VISIBILITY_PUBLISHED Visibility = 2
+	// Taken off the public portfolio but kept.
+	Visibility_VISIBILITY_ARCHIVED Visibility = 3
+)
+
+// Enum value maps for Visibility.
+var (
+	Visibility_name = map[int32]string{
+		0: "VISIBILITY_UNSPECIFIED",
+		1: "VISIBILITY_DRAFT",
+		2: "VISIBILITY_PUBLISHED",
+		3: "VISIBILITY_ARCHIVED",
+	}
+	Visibility_value = map[string]int32{
+		"VISIBILITY_UNSPECIFIED": 0,
+		"VISIBILITY_DRAFT":       1,
+		"VISIBILITY_PUBLISHED":   2,
+		"VISIBILITY_ARCHIVED":    3,
+	}
+)
+
+func (x Visibility) Enum() *Visibility {
+	p := new(Visibility)
+	*p = x
+	return p
+}
+
+func (x Visibility) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Visibility) Descriptor() protoreflect.EnumDescriptor {
+	return file_jorgejr568_portfolio_grpc_visibility_proto_enumTypes[0].Descriptor()
+}
+
+func (Visibility) Type() protoreflect.EnumType {
+	return &file_jorgejr568_portfolio_grpc_visibility_proto_enumTypes[0]
+}
+
+func (x Visibility) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Visibility.Descriptor instead.
+func (Visibility) EnumDescriptor() ([]byte, []int) {
+	return file_jorgejr568_portfolio_grpc_visibility_proto_rawDescGZIP(), []int{0}
+}
+
+var File_jorgejr568_portfolio_grpc_visibility_proto protoreflect.FileDescriptor
+
+const file_jorgejr568_portfolio_grpc_visibility_proto_rawDesc = "" +
+	"\n" +
+	"*jorgejr568/portfolio_grpc/visibility.proto\x12\x19jorgejr568.portfolio_grpc*q\n" +
+	"\n" +
+	"Visibility\x12\x1a\n" +
+	"\x16VISIBILITY_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10VISIBILITY_DRAFT\x10\x01\x12\x18\n" +
+	"\x14VISIBILITY_PUBLISHED\x10\x02\x12\x17\n" +
+	"\x13VISIBILITY_ARCHIVED\x10\x03B\xf8\x01\n" +
+	"\x1dcom.jorgejr568.portfolio_grpcB\x0fVisibilityProtoP\x01ZEgithub.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc\xa2\x02\x03JPX\xaa\x02\x18Jorgejr568.PortfolioGrpc\xca\x02\x18Jorgejr568\\PortfolioGrpc\xe2\x02$Jorgejr568\\PortfolioGrpc\\GPBMetadata\xea\x02\x19Jorgejr568::PortfolioGrpcb\x06proto3"
+
+var (
+	file_jorgejr568_portfolio_grpc_visibility_proto_rawDescOnce sync.Once
+	file_jorgejr568_portfolio_grpc_visibility_proto_rawDescData []byte
+)
+
+func file_jorgejr568_portfolio_grpc_visibility_proto_rawDescGZIP() []byte {
+	file_jorgejr568_portfolio_grpc_visibility_proto_rawDescOnce.Do(func() {
+		file_jorgejr568_portfolio_grpc_visibility_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_jorgejr568_portfolio_grpc_visibility_proto_rawDesc), len(file_jorgejr568_portfolio_grpc_visibility_proto_rawDesc)))
+	})
+	return file_jorgejr568_portfolio_grpc_visibility_proto_rawDescData
+}
+
+var file_jorgejr568_portfolio_grpc_visibility_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_jorgejr568_portfolio_grpc_visibility_proto_goTypes = []any{
+	(Visibility)(0), // 0: jorgejr568.portfolio_grpc.Visibility
+}
+var file_jorgejr568_portfolio_grpc_visibility_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_jorgejr568_portfolio_grpc_visibility_proto_init() }
+func file_jorgejr568_portfolio_grpc_visibility_proto_init() {
+	if File_jorgejr568_portfolio_grpc_visibility_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_jorgejr568_portfolio_grpc_visibility_proto_rawDesc), len(file_jorgejr568_portfolio_grpc_visibility_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_jorgejr568_portfolio_grpc_visibility_proto_goTypes,
+		DependencyIndexes: file_jorgejr568_portfolio_grpc_visibility_proto_depIdxs,
+		EnumInfos:         file_jorgejr568_portfolio_grpc_visibility_proto_enumTypes,
+	}.Build()
+	File_jorgejr568_portfolio_grpc_visibility_proto = out.File
+	file_jorgejr568_portfolio_grpc_visibility_proto_goTypes = nil
+	file_jorgejr568_portfolio_grpc_visibility_proto_depIdxs = nil
+}
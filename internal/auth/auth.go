@@ -0,0 +1,79 @@
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"strings"
+)
+
+var (
+	ErrMalformedTokens = errors.New("malformed admin tokens")
+)
+
+// Actor identifies an authenticated admin caller.
+type Actor struct {
+	Name string
+}
+
+type actorKey struct{}
+
+// ToContext returns a copy of ctx carrying actor.
+func ToContext(ctx context.Context, actor Actor) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// FromContext returns the admin that made the request, if any.
+func FromContext(ctx context.Context) (Actor, bool) {
+	actor, ok := ctx.Value(actorKey{}).(Actor)
+	return actor, ok
+}
+
+// IsAdmin reports whether the request was made by an authenticated admin.
+func IsAdmin(ctx context.Context) bool {
+	_, ok := FromContext(ctx)
+	return ok
+}
+
+// Tokens maps admin bearer tokens to the admins they belong to.
+type Tokens struct {
+	actors map[string]Actor
+}
+
+// ParseTokens parses a comma separated list of name:token pairs, as in
+// "jorge:s3cr3t,ci:t0k3n". An empty string yields no admins.
+func ParseTokens(raw string) (*Tokens, error) {
+	tokens := &Tokens{actors: make(map[string]Actor)}
+	for _, pair := range strings.Split(raw, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		name, token, ok := strings.Cut(pair, ":")
+		name, token = strings.TrimSpace(name), strings.TrimSpace(token)
+		if !ok || name == "" || token == "" {
+			return nil, ErrMalformedTokens
+		}
+
+		tokens.actors[token] = Actor{Name: name}
+	}
+
+	return tokens, nil
+}
+
+// Lookup returns the admin owning token. Every known token is compared in
+// constant time so the lookup does not leak which prefix matched.
+func (t *Tokens) Lookup(token string) (Actor, bool) {
+	var (
+		found Actor
+		ok    bool
+	)
+	for known, actor := range t.actors {
+		if subtle.ConstantTimeCompare([]byte(known), []byte(token)) == 1 {
+			found, ok = actor, true
+		}
+	}
+
+	return found, ok
+}
@@ -0,0 +1,200 @@
+package changefeed
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+// Channel is the Postgres notification channel the change triggers publish
+// to, see migrations/0011_create_change_notifications.sql.
+const Channel = "portfolio_changes"
+
+const (
+	// subscriberBuffer is how many events a subscriber may fall behind
+	// before it is dropped.
+	subscriberBuffer = 64
+
+	minReconnectInterval = 10 * time.Second
+	maxReconnectInterval = time.Minute
+)
+
+var (
+	ErrClosed  = errors.New("change feed closed")
+	ErrLagging = errors.New("subscriber fell behind the change feed")
+)
+
+// Event is a row change published by the change triggers.
+type Event struct {
+	// EntityType is "skill", "experience" or "education".
+	EntityType string `json:"entity_type"`
+	ID         int64  `json:"id"`
+	// Operation is "insert", "update" or "delete".
+	Operation string `json:"operation"`
+}
+
+// Feed fans the notifications of Channel out to its subscribers.
+type Feed struct {
+	listener *pq.Listener
+	logger   *zap.Logger
+
+	mu          sync.Mutex
+	subscribers map[*Subscription]struct{}
+	closed      bool
+}
+
+// New creates a Feed listening on the database at dataSourceName. The
+// listener holds its own connection, as notifications are not delivered to
+// idle connections of a *sql.DB pool. Run must be called to start
+// delivering events.
+func New(dataSourceName string, logger *zap.Logger) *Feed {
+	listener := pq.NewListener(dataSourceName, minReconnectInterval, maxReconnectInterval, func(event pq.ListenerEventType, err error) {
+		if err != nil {
+			logger.Warn("changefeed.listener", zap.Error(err))
+		}
+	})
+
+	return &Feed{
+		listener:    listener,
+		logger:      logger,
+		subscribers: make(map[*Subscription]struct{}),
+	}
+}
+
+// Run listens for changes until ctx is done, then closes the feed.
+func (f *Feed) Run(ctx context.Context) error {
+	defer f.Close()
+
+	if err := f.listener.Listen(Channel); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case notification, ok := <-f.listener.Notify:
+			if !ok {
+				return nil
+			}
+
+			// A nil notification follows a reconnect, during which changes
+			// may have been missed.
+			if notification == nil {
+				f.logger.Warn("changefeed.reconnected")
+				continue
+			}
+
+			var event Event
+			if err := json.Unmarshal([]byte(notification.Extra), &event); err != nil {
+				f.logger.Error("changefeed.decode", zap.String("payload", notification.Extra), zap.Error(err))
+				continue
+			}
+
+			f.Publish(event)
+		}
+	}
+}
+
+// Subscribe returns a subscription receiving every event published from now
+// on. The subscription must be closed once no longer read.
+func (f *Feed) Subscribe() *Subscription {
+	subscription := &Subscription{
+		feed:   f,
+		events: make(chan Event, subscriberBuffer),
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.closed {
+		subscription.end(ErrClosed)
+		return subscription
+	}
+
+	f.subscribers[subscription] = struct{}{}
+
+	return subscription
+}
+
+// Close stops listening and ends every subscription with ErrClosed.
+func (f *Feed) Close() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.closed {
+		return
+	}
+
+	f.closed = true
+	for subscription := range f.subscribers {
+		delete(f.subscribers, subscription)
+		subscription.end(ErrClosed)
+	}
+
+	if err := f.listener.Close(); err != nil {
+		f.logger.Warn("changefeed.close", zap.Error(err))
+	}
+}
+
+// Publish sends event to the subscribers of this feed. It is used for changes
+// no trigger sees, such as entries going live once their publish_at passes,
+// which every instance of the service announces to its own subscribers.
+func (f *Feed) Publish(event Event) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for subscription := range f.subscribers {
+		select {
+		case subscription.events <- event:
+		default:
+			delete(f.subscribers, subscription)
+			subscription.end(ErrLagging)
+		}
+	}
+}
+
+func (f *Feed) unsubscribe(subscription *Subscription) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, ok := f.subscribers[subscription]; ok {
+		delete(f.subscribers, subscription)
+		subscription.end(nil)
+	}
+}
+
+// Subscription receives the events of a Feed.
+type Subscription struct {
+	feed   *Feed
+	events chan Event
+	// err is written before events is closed.
+	err error
+}
+
+// Events returns the events of the subscription. The channel is closed when
+// the subscription ends, after which Err reports why.
+func (s *Subscription) Events() <-chan Event {
+	return s.events
+}
+
+// Err returns ErrClosed or ErrLagging once Events is closed by the feed.
+func (s *Subscription) Err() error {
+	return s.err
+}
+
+// Close ends the subscription.
+func (s *Subscription) Close() {
+	s.feed.unsubscribe(s)
+}
+
+// end closes the subscription with err. The feed lock must be held.
+func (s *Subscription) end(err error) {
+	s.err = err
+	close(s.events)
+}
@@ -0,0 +1,150 @@
+package mailer
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrNoRecipients = errors.New("message has no recipients")
+)
+
+type Client interface {
+	Send(ctx context.Context, message Message) error
+}
+
+// Message is a plain text email.
+type Message struct {
+	To      []string
+	ReplyTo string
+	Subject string
+	Body    string
+}
+
+// client delivers messages through an SMTP relay
+type client struct {
+	addr     string
+	host     string
+	from     string
+	username string
+	password string
+}
+
+// Config holds the configuration for the SMTP client
+type Config struct {
+	Host     string // SMTP relay host (e.g., "localhost")
+	Port     int    // SMTP relay port (e.g., 1025)
+	Username string // Optional; PLAIN auth is used when set
+	Password string
+	From     string // Envelope and header sender (e.g., "portfolio@example.com")
+}
+
+// New creates a new SMTP client. Connections are opened per message, so New
+// does not contact the relay.
+func New(config Config) (Client, error) {
+	if _, err := mail.ParseAddress(config.From); err != nil {
+		return nil, fmt.Errorf("invalid sender address %q: %w", config.From, err)
+	}
+
+	return &client{
+		addr:     net.JoinHostPort(config.Host, strconv.Itoa(config.Port)),
+		host:     config.Host,
+		from:     config.From,
+		username: config.Username,
+		password: config.Password,
+	}, nil
+}
+
+// Send delivers message, giving up when ctx is done.
+func (c *client) Send(ctx context.Context, message Message) error {
+	if len(message.To) == 0 {
+		return ErrNoRecipients
+	}
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", c.addr)
+	if err != nil {
+		return fmt.Errorf("failed to connect to SMTP relay at %s: %w", c.addr, err)
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	} else {
+		_ = conn.SetDeadline(time.Now().Add(30 * time.Second))
+	}
+
+	smtpClient, err := smtp.NewClient(conn, c.host)
+	if err != nil {
+		return err
+	}
+	defer smtpClient.Close()
+
+	if ok, _ := smtpClient.Extension("STARTTLS"); ok {
+		if err := smtpClient.StartTLS(&tls.Config{ServerName: c.host}); err != nil {
+			return err
+		}
+	}
+
+	if c.username != "" {
+		if err := smtpClient.Auth(smtp.PlainAuth("", c.username, c.password, c.host)); err != nil {
+			return err
+		}
+	}
+
+	if err := smtpClient.Mail(c.from); err != nil {
+		return err
+	}
+
+	for _, to := range message.To {
+		if err := smtpClient.Rcpt(to); err != nil {
+			return err
+		}
+	}
+
+	w, err := smtpClient.Data()
+	if err != nil {
+		return err
+	}
+
+	if _, err := w.Write(c.render(message)); err != nil {
+		return err
+	}
+
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	return smtpClient.Quit()
+}
+
+// render formats message as an RFC 5322 email.
+func (c *client) render(message Message) []byte {
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", c.from)
+	fmt.Fprintf(&b, "To: %s\r\n", strings.Join(message.To, ", "))
+	if message.ReplyTo != "" {
+		fmt.Fprintf(&b, "Reply-To: %s\r\n", sanitizeHeader(message.ReplyTo))
+	}
+	fmt.Fprintf(&b, "Subject: %s\r\n", sanitizeHeader(message.Subject))
+	fmt.Fprintf(&b, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(strings.ReplaceAll(message.Body, "\r\n", "\n"), "\n", "\r\n"))
+
+	return []byte(b.String())
+}
+
+// sanitizeHeader strips line breaks so user input cannot inject headers.
+func sanitizeHeader(value string) string {
+	return strings.NewReplacer("\r", " ", "\n", " ").Replace(value)
+}
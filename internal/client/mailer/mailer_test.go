@@ -0,0 +1,189 @@
+package mailer
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/jorgejr568/portfolio-grpc/internal/client/mailer/mailertest"
+)
+
+func newTestClient(t *testing.T, relay *mailertest.Server, username, password string) Client {
+	t.Helper()
+
+	c, err := New(Config{
+		Host:     relay.Host(),
+		Port:     relay.Port(),
+		Username: username,
+		Password: password,
+		From:     "portfolio@example.com",
+	})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	return c
+}
+
+func TestSend(t *testing.T) {
+	tests := []struct {
+		name     string
+		username string
+		password string
+		wantAuth string
+	}{
+		{name: "anonymous"},
+		{name: "plain auth", username: "jorge", password: "s3cr3t", wantAuth: "\x00jorge\x00s3cr3t"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			relay := mailertest.NewServer(t)
+			c := newTestClient(t, relay, tt.username, tt.password)
+
+			err := c.Send(context.Background(), Message{
+				To:      []string{"owner@example.com", "backup@example.com"},
+				ReplyTo: "Ann <ann@example.com>",
+				Subject: "Hello",
+				Body:    "Hi there\nBye",
+			})
+			if err != nil {
+				t.Fatalf("Send() error = %v", err)
+			}
+
+			messages := relay.Messages()
+			if len(messages) != 1 {
+				t.Fatalf("relay received %d messages, want 1", len(messages))
+			}
+
+			got := messages[0]
+			if got.From != "portfolio@example.com" {
+				t.Errorf("MAIL FROM = %q, want %q", got.From, "portfolio@example.com")
+			}
+			if strings.Join(got.To, ",") != "owner@example.com,backup@example.com" {
+				t.Errorf("RCPT TO = %v, want [owner@example.com backup@example.com]", got.To)
+			}
+			if got.Auth != tt.wantAuth {
+				t.Errorf("AUTH = %q, want %q", got.Auth, tt.wantAuth)
+			}
+
+			for _, want := range []string{
+				"From: portfolio@example.com\r\n",
+				"To: owner@example.com, backup@example.com\r\n",
+				"Reply-To: Ann <ann@example.com>\r\n",
+				"Subject: Hello\r\n",
+				"\r\n\r\nHi there\r\nBye",
+			} {
+				if !strings.Contains(got.Data, want) {
+					t.Errorf("DATA = %q, want it to contain %q", got.Data, want)
+				}
+			}
+		})
+	}
+}
+
+func TestSendWithoutRecipients(t *testing.T) {
+	relay := mailertest.NewServer(t)
+	c := newTestClient(t, relay, "", "")
+
+	err := c.Send(context.Background(), Message{Subject: "Hello", Body: "Hi"})
+	if !errors.Is(err, ErrNoRecipients) {
+		t.Fatalf("Send() error = %v, want %v", err, ErrNoRecipients)
+	}
+
+	if messages := relay.Messages(); len(messages) != 0 {
+		t.Errorf("relay received %d messages, want none", len(messages))
+	}
+}
+
+func TestSendCanceled(t *testing.T) {
+	relay := mailertest.NewServer(t)
+	c := newTestClient(t, relay, "", "")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := c.Send(ctx, Message{To: []string{"owner@example.com"}}); err == nil {
+		t.Fatal("Send() with a canceled context succeeded, want an error")
+	}
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name    string
+		from    string
+		wantErr bool
+	}{
+		{name: "address", from: "portfolio@example.com"},
+		{name: "named address", from: "Portfolio <portfolio@example.com>"},
+		{name: "empty", from: "", wantErr: true},
+		{name: "malformed", from: "portfolio", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := New(Config{Host: "localhost", Port: 25, From: tt.from})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("New(From: %q) error = %v, wantErr %v", tt.from, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRender(t *testing.T) {
+	c := &client{from: "portfolio@example.com"}
+
+	tests := []struct {
+		name    string
+		message Message
+		want    []string
+		notWant []string
+	}{
+		{
+			name:    "headers",
+			message: Message{To: []string{"a@example.com", "b@example.com"}, ReplyTo: "Ann <ann@example.com>", Subject: "Hello"},
+			want: []string{
+				"From: portfolio@example.com\r\n",
+				"To: a@example.com, b@example.com\r\n",
+				"Reply-To: Ann <ann@example.com>\r\n",
+				"Subject: Hello\r\n",
+				"MIME-Version: 1.0\r\n",
+				"Content-Type: text/plain; charset=UTF-8\r\n",
+			},
+		},
+		{
+			name:    "no reply-to",
+			message: Message{To: []string{"a@example.com"}, Subject: "Hello"},
+			notWant: []string{"Reply-To:"},
+		},
+		{
+			name:    "header injection",
+			message: Message{To: []string{"a@example.com"}, ReplyTo: "ann@example.com\r\nBcc: x@example.com", Subject: "Hi\nBcc: y@example.com"},
+			want:    []string{"Reply-To: ann@example.com  Bcc: x@example.com\r\n", "Subject: Hi Bcc: y@example.com\r\n"},
+			notWant: []string{"\r\nBcc:", "\nBcc:"},
+		},
+		{
+			name:    "body line endings",
+			message: Message{To: []string{"a@example.com"}, Body: "one\ntwo\r\nthree"},
+			want:    []string{"\r\n\r\none\r\ntwo\r\nthree"},
+			notWant: []string{"\r\r\n"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := string(c.render(tt.message))
+			for _, want := range tt.want {
+				if !strings.Contains(got, want) {
+					t.Errorf("render() = %q, want it to contain %q", got, want)
+				}
+			}
+			for _, notWant := range tt.notWant {
+				if strings.Contains(got, notWant) {
+					t.Errorf("render() = %q, want it not to contain %q", got, notWant)
+				}
+			}
+		})
+	}
+}
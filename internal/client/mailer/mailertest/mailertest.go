@@ -0,0 +1,179 @@
+// Package mailertest provides an in-process SMTP relay for tests.
+package mailertest
+
+import (
+	"encoding/base64"
+	"net"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// Message is an email accepted by the Server.
+type Message struct {
+	From string
+	To   []string
+	// Auth is the decoded AUTH PLAIN response, empty when the client did
+	// not authenticate.
+	Auth string
+	// Data is the message as sent after DATA, without the terminating dot.
+	Data string
+}
+
+// Server is a minimal SMTP relay listening on loopback. It accepts every
+// message and advertises AUTH PLAIN but not STARTTLS.
+type Server struct {
+	listener net.Listener
+
+	mu       sync.Mutex
+	messages []Message
+	wg       sync.WaitGroup
+}
+
+// NewServer starts a Server that is closed when the test ends.
+func NewServer(t testing.TB) *Server {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+
+	s := &Server{listener: listener}
+	s.wg.Add(1)
+	go s.serve()
+
+	t.Cleanup(func() {
+		_ = listener.Close()
+		s.wg.Wait()
+	})
+
+	return s
+}
+
+// Host returns the host the Server listens on.
+func (s *Server) Host() string {
+	return s.listener.Addr().(*net.TCPAddr).IP.String()
+}
+
+// Port returns the port the Server listens on.
+func (s *Server) Port() int {
+	return s.listener.Addr().(*net.TCPAddr).Port
+}
+
+// Messages returns the messages accepted so far.
+func (s *Server) Messages() []Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]Message(nil), s.messages...)
+}
+
+func (s *Server) serve() {
+	defer s.wg.Done()
+
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			defer conn.Close()
+			s.handle(textproto.NewConn(conn))
+		}()
+	}
+}
+
+func (s *Server) handle(conn *textproto.Conn) {
+	var message Message
+	reply := func(code int, text string) bool {
+		return conn.PrintfLine("%d %s", code, text) == nil
+	}
+
+	if !reply(220, "localhost ESMTP mailertest") {
+		return
+	}
+
+	for {
+		line, err := conn.ReadLine()
+		if err != nil {
+			return
+		}
+
+		verb, arg, _ := strings.Cut(line, " ")
+		switch strings.ToUpper(verb) {
+		case "EHLO", "HELO":
+			if conn.PrintfLine("250-localhost") != nil || !reply(250, "AUTH PLAIN") {
+				return
+			}
+		case "AUTH":
+			mechanism, response, _ := strings.Cut(arg, " ")
+			decoded, err := base64.StdEncoding.DecodeString(response)
+			if !strings.EqualFold(mechanism, "PLAIN") || err != nil {
+				if !reply(504, "unsupported authentication") {
+					return
+				}
+				continue
+			}
+			message.Auth = string(decoded)
+			if !reply(235, "authenticated") {
+				return
+			}
+		case "MAIL":
+			message.From = address(arg)
+			if !reply(250, "OK") {
+				return
+			}
+		case "RCPT":
+			message.To = append(message.To, address(arg))
+			if !reply(250, "OK") {
+				return
+			}
+		case "DATA":
+			if !reply(354, "end data with <CR><LF>.<CR><LF>") {
+				return
+			}
+			lines, err := conn.ReadDotLines()
+			if err != nil {
+				return
+			}
+			message.Data = strings.Join(lines, "\r\n")
+
+			s.mu.Lock()
+			s.messages = append(s.messages, message)
+			s.mu.Unlock()
+
+			message = Message{Auth: message.Auth}
+			if !reply(250, "queued as "+strconv.Itoa(len(s.Messages()))) {
+				return
+			}
+		case "RSET", "NOOP":
+			if !reply(250, "OK") {
+				return
+			}
+		case "QUIT":
+			reply(221, "bye")
+			return
+		default:
+			if !reply(502, "command not implemented") {
+				return
+			}
+		}
+	}
+}
+
+// address extracts the address from a MAIL FROM:<a> or RCPT TO:<a> argument.
+func address(arg string) string {
+	_, addr, _ := strings.Cut(arg, ":")
+	addr = strings.TrimSpace(addr)
+	if i := strings.IndexByte(addr, ' '); i >= 0 {
+		addr = addr[:i]
+	}
+
+	return strings.Trim(addr, "<>")
+}
@@ -3,6 +3,7 @@ package statsd
 import (
 	"fmt"
 	"net"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -37,7 +38,7 @@ type Config struct {
 
 // New creates a new StatsD client with UDP connection
 func New(config Config) (Client, error) {
-	addr := fmt.Sprintf("%s:%d", config.Host, config.Port)
+	addr := net.JoinHostPort(config.Host, strconv.Itoa(config.Port))
 	conn, err := net.DialTimeout("udp", addr, 5*time.Second)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to StatsD server at %s: %w", addr, err)
@@ -0,0 +1,107 @@
+package clientip
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/netip"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+var (
+	ErrMalformedProxies = errors.New("malformed trusted proxies")
+)
+
+// loopback is always trusted, as the HTTP gateway relays requests to the gRPC
+// server over a loopback connection.
+var loopback = []netip.Prefix{
+	netip.MustParsePrefix("127.0.0.0/8"),
+	netip.MustParsePrefix("::1/128"),
+}
+
+// Resolver finds the address of the client behind a request.
+type Resolver struct {
+	trusted []netip.Prefix
+}
+
+// ParseTrustedProxies parses a comma separated list of the CIDRs of the
+// proxies allowed to name the client in X-Forwarded-For, as in
+// "10.0.0.0/8,192.168.1.10/32". An empty string trusts loopback only.
+func ParseTrustedProxies(raw string) (*Resolver, error) {
+	resolver := &Resolver{trusted: append([]netip.Prefix(nil), loopback...)}
+	for _, cidr := range strings.Split(raw, ",") {
+		cidr = strings.TrimSpace(cidr)
+		if cidr == "" {
+			continue
+		}
+
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			return nil, ErrMalformedProxies
+		}
+
+		resolver.trusted = append(resolver.trusted, prefix.Masked())
+	}
+
+	return resolver, nil
+}
+
+// Resolve returns the address of the client that made the request. When the
+// connection comes from a trusted proxy, X-Forwarded-For is walked from the
+// nearest hop back and the first address not belonging to a trusted proxy is
+// the client; otherwise the peer is, whatever the headers claim.
+func (r *Resolver) Resolve(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		host = p.Addr.String()
+	}
+
+	client, err := netip.ParseAddr(host)
+	if err != nil {
+		return host
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	hops := forwardedHops(md.Get("x-forwarded-for"))
+	for i := len(hops) - 1; i >= 0 && r.trusts(client); i-- {
+		hop, err := netip.ParseAddr(hops[i])
+		if err != nil {
+			break
+		}
+		client = hop
+	}
+
+	return client.Unmap().String()
+}
+
+func (r *Resolver) trusts(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	for _, prefix := range r.trusted {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// forwardedHops splits X-Forwarded-For values into their addresses, nearest
+// hop last.
+func forwardedHops(values []string) []string {
+	var hops []string
+	for _, value := range values {
+		for _, hop := range strings.Split(value, ",") {
+			hops = append(hops, strings.TrimSpace(hop))
+		}
+	}
+
+	return hops
+}
@@ -0,0 +1,86 @@
+package clientip
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func TestParseTrustedProxies(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		wantErr error
+		want    int
+	}{
+		{name: "empty", raw: "", want: len(loopback)},
+		{name: "list", raw: "10.0.0.0/8, 192.168.1.10/32,", want: len(loopback) + 2},
+		{name: "ipv6", raw: "2001:db8::/32", want: len(loopback) + 1},
+		{name: "bare address", raw: "10.0.0.1", wantErr: ErrMalformedProxies},
+		{name: "garbage", raw: "10.0.0.0/8,proxy", wantErr: ErrMalformedProxies},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resolver, err := ParseTrustedProxies(tt.raw)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseTrustedProxies(%q) error = %v, want %v", tt.raw, err, tt.wantErr)
+			}
+			if err == nil && len(resolver.trusted) != tt.want {
+				t.Errorf("ParseTrustedProxies(%q) trusts %d prefixes, want %d", tt.raw, len(resolver.trusted), tt.want)
+			}
+		})
+	}
+}
+
+func TestResolve(t *testing.T) {
+	resolver, err := ParseTrustedProxies("10.0.0.0/8")
+	if err != nil {
+		t.Fatalf("ParseTrustedProxies() error = %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		peer      net.Addr
+		forwarded []string
+		want      string
+	}{
+		{name: "no peer", want: ""},
+		{name: "direct client", peer: tcpAddr("203.0.113.7"), want: "203.0.113.7"},
+		{name: "direct client spoofing", peer: tcpAddr("203.0.113.7"), forwarded: []string{"198.51.100.1"}, want: "203.0.113.7"},
+		{name: "gateway", peer: tcpAddr("127.0.0.1"), forwarded: []string{"203.0.113.7"}, want: "203.0.113.7"},
+		{name: "gateway ipv6", peer: tcpAddr("::1"), forwarded: []string{"2001:db8::7"}, want: "2001:db8::7"},
+		{name: "gateway without header", peer: tcpAddr("127.0.0.1"), want: "127.0.0.1"},
+		{name: "client spoofing through gateway", peer: tcpAddr("127.0.0.1"), forwarded: []string{"198.51.100.1, 203.0.113.7"}, want: "203.0.113.7"},
+		{name: "trusted proxy", peer: tcpAddr("127.0.0.1"), forwarded: []string{"198.51.100.1, 203.0.113.7, 10.1.2.3"}, want: "203.0.113.7"},
+		{name: "repeated header", peer: tcpAddr("127.0.0.1"), forwarded: []string{"203.0.113.7", "10.1.2.3"}, want: "203.0.113.7"},
+		{name: "only proxies", peer: tcpAddr("127.0.0.1"), forwarded: []string{"10.9.9.9, 10.1.2.3"}, want: "10.9.9.9"},
+		{name: "malformed hop", peer: tcpAddr("127.0.0.1"), forwarded: []string{"203.0.113.7, unknown, 10.1.2.3"}, want: "10.1.2.3"},
+		{name: "mapped ipv4", peer: tcpAddr("::ffff:203.0.113.7"), want: "203.0.113.7"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.peer != nil {
+				ctx = peer.NewContext(ctx, &peer.Peer{Addr: tt.peer})
+			}
+			if tt.forwarded != nil {
+				md := metadata.MD{"x-forwarded-for": tt.forwarded}
+				ctx = metadata.NewIncomingContext(ctx, md)
+			}
+
+			if got := resolver.Resolve(ctx); got != tt.want {
+				t.Errorf("Resolve() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func tcpAddr(ip string) net.Addr {
+	return &net.TCPAddr{IP: net.ParseIP(ip), Port: 54321}
+}
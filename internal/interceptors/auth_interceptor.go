@@ -0,0 +1,85 @@
+package interceptors
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jorgejr568/portfolio-grpc/internal/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryAuthInterceptor authenticates callers presenting an admin bearer token
+// in the authorization metadata and stores them in the context. Methods in
+// adminMethods are rejected unless the caller is an admin; every other
+// method stays public.
+func UnaryAuthInterceptor(tokens *auth.Tokens, adminMethods []string) grpc.UnaryServerInterceptor {
+	restricted := methodSet(adminMethods)
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := authenticate(ctx, tokens, restricted[info.FullMethod])
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamAuthInterceptor is the streaming counterpart of UnaryAuthInterceptor.
+func StreamAuthInterceptor(tokens *auth.Tokens, adminMethods []string) grpc.StreamServerInterceptor {
+	restricted := methodSet(adminMethods)
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authenticate(ss.Context(), tokens, restricted[info.FullMethod])
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, wrapServerStream(ctx, ss))
+	}
+}
+
+func authenticate(ctx context.Context, tokens *auth.Tokens, adminOnly bool) (context.Context, error) {
+	token, ok := bearerToken(ctx)
+	if !ok {
+		if adminOnly {
+			return nil, status.Error(codes.Unauthenticated, "missing bearer token")
+		}
+		return ctx, nil
+	}
+
+	actor, ok := tokens.Lookup(token)
+	if !ok {
+		// A wrong token is always an error, even on public methods, so a
+		// misconfigured admin client does not silently act as anonymous.
+		return nil, status.Error(codes.Unauthenticated, "invalid bearer token")
+	}
+
+	return auth.ToContext(ctx, actor), nil
+}
+
+func bearerToken(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+
+	for _, value := range md.Get("authorization") {
+		scheme, token, ok := strings.Cut(value, " ")
+		if ok && strings.EqualFold(scheme, "bearer") && strings.TrimSpace(token) != "" {
+			return strings.TrimSpace(token), true
+		}
+	}
+
+	return "", false
+}
+
+func methodSet(methods []string) map[string]bool {
+	set := make(map[string]bool, len(methods))
+	for _, method := range methods {
+		set[method] = true
+	}
+
+	return set
+}
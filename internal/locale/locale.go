@@ -0,0 +1,111 @@
+package locale
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// MetadataKey is the gRPC metadata key holding the caller's Accept-Language
+// header. The HTTP gateway forwards the header under this key.
+const MetadataKey = "accept-language"
+
+// Negotiator picks the locale content is served in.
+type Negotiator struct {
+	defaultLocale string
+}
+
+// New creates a Negotiator falling back to defaultLocale, the locale the
+// untranslated columns are written in.
+func New(defaultLocale string) *Negotiator {
+	return &Negotiator{defaultLocale: normalize(defaultLocale)}
+}
+
+// Default returns the locale untranslated content is written in.
+func (n *Negotiator) Default() string {
+	return n.defaultLocale
+}
+
+// Best returns the available locale that best matches the accept-language
+// metadata of ctx. Exact tags win over a shared base language, so "pt-BR"
+// prefers "pt-br" over "pt" but still matches "pt". Without a match the
+// default locale is returned.
+func (n *Negotiator) Best(ctx context.Context, available []string) string {
+	for _, preferred := range Preferences(ctx) {
+		for _, candidate := range available {
+			if normalize(candidate) == preferred {
+				return candidate
+			}
+		}
+
+		base := baseLanguage(preferred)
+		for _, candidate := range available {
+			if baseLanguage(normalize(candidate)) == base {
+				return candidate
+			}
+		}
+	}
+
+	return n.defaultLocale
+}
+
+// Preferences returns the locales listed in the accept-language metadata of
+// ctx, most preferred first. Wildcards and refused (q=0) locales are skipped.
+func Preferences(ctx context.Context) []string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	type weighted struct {
+		tag     string
+		quality float64
+	}
+
+	var ranges []weighted
+	for _, header := range md.Get(MetadataKey) {
+		for _, part := range strings.Split(header, ",") {
+			tag, params, _ := strings.Cut(part, ";")
+			tag = normalize(tag)
+			if tag == "" || tag == "*" {
+				continue
+			}
+
+			quality := 1.0
+			if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+				parsed, err := strconv.ParseFloat(q, 64)
+				if err != nil {
+					continue
+				}
+				quality = parsed
+			}
+
+			if quality > 0 {
+				ranges = append(ranges, weighted{tag: tag, quality: quality})
+			}
+		}
+	}
+
+	sort.SliceStable(ranges, func(i, j int) bool {
+		return ranges[i].quality > ranges[j].quality
+	})
+
+	tags := make([]string, len(ranges))
+	for i, r := range ranges {
+		tags[i] = r.tag
+	}
+
+	return tags
+}
+
+func normalize(tag string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(tag), "_", "-"))
+}
+
+func baseLanguage(tag string) string {
+	base, _, _ := strings.Cut(tag, "-")
+	return base
+}
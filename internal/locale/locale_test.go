@@ -0,0 +1,81 @@
+package locale
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc/metadata"
+)
+
+func contextWith(headers ...string) context.Context {
+	md := metadata.MD{}
+	for _, header := range headers {
+		md.Append(MetadataKey, header)
+	}
+
+	return metadata.NewIncomingContext(context.Background(), md)
+}
+
+func TestPreferences(t *testing.T) {
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want []string
+	}{
+		{name: "no metadata", ctx: context.Background(), want: nil},
+		{name: "no header", ctx: contextWith(), want: []string{}},
+		{name: "single", ctx: contextWith("pt-BR"), want: []string{"pt-br"}},
+		{name: "quality order", ctx: contextWith("en;q=0.5, pt-BR, es;q=0.8"), want: []string{"pt-br", "es", "en"}},
+		{name: "ties keep header order", ctx: contextWith("fr, de, it;q=0.9"), want: []string{"fr", "de", "it"}},
+		{name: "underscore and case", ctx: contextWith(" PT_br "), want: []string{"pt-br"}},
+		{name: "wildcard skipped", ctx: contextWith("*, en;q=0.1"), want: []string{"en"}},
+		{name: "refused skipped", ctx: contextWith("en;q=0, pt"), want: []string{"pt"}},
+		{name: "malformed quality skipped", ctx: contextWith("en;q=high, pt"), want: []string{"pt"}},
+		{name: "empty ranges skipped", ctx: contextWith(",, pt ,"), want: []string{"pt"}},
+		{name: "several headers", ctx: contextWith("en;q=0.2", "pt"), want: []string{"pt", "en"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Preferences(tt.ctx); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Preferences() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBest(t *testing.T) {
+	negotiator := New("EN")
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		available []string
+		want      string
+	}{
+		{name: "no preference", ctx: context.Background(), available: []string{"pt-BR"}, want: "en"},
+		{name: "exact match", ctx: contextWith("pt-BR"), available: []string{"pt", "pt-BR"}, want: "pt-BR"},
+		{name: "exact match ignores case", ctx: contextWith("pt-br"), available: []string{"pt_BR"}, want: "pt_BR"},
+		{name: "base language of preference", ctx: contextWith("pt-BR"), available: []string{"es", "pt"}, want: "pt"},
+		{name: "base language of candidate", ctx: contextWith("pt"), available: []string{"pt-PT"}, want: "pt-PT"},
+		{name: "first preference wins", ctx: contextWith("es, pt;q=0.9"), available: []string{"pt", "es-MX"}, want: "es-MX"},
+		{name: "falls through preferences", ctx: contextWith("fr, pt;q=0.5"), available: []string{"pt"}, want: "pt"},
+		{name: "no match", ctx: contextWith("fr"), available: []string{"pt", "es"}, want: "en"},
+		{name: "nothing available", ctx: contextWith("pt"), available: nil, want: "en"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := negotiator.Best(tt.ctx, tt.available); got != tt.want {
+				t.Errorf("Best(%v) = %q, want %q", tt.available, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDefault(t *testing.T) {
+	if got := New(" pt_BR ").Default(); got != "pt-br" {
+		t.Errorf("Default() = %q, want %q", got, "pt-br")
+	}
+}
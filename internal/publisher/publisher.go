@@ -0,0 +1,96 @@
+package publisher
+
+import (
+	"context"
+	"time"
+
+	"github.com/jorgejr568/portfolio-grpc/internal/changefeed"
+	"github.com/jorgejr568/portfolio-grpc/internal/repositories"
+	"go.uber.org/zap"
+)
+
+// interval is how often entries whose publish_at passed are looked for, and
+// so how late they may be announced.
+const interval = time.Minute
+
+// Publisher announces skills, experiences and educations on the change feed
+// once their publish_at passes. Nothing writes the row at that moment, so the
+// change triggers cannot report it.
+type Publisher struct {
+	skills      repositories.SkillsRepository
+	experiences repositories.ExperiencesRepository
+	educations  repositories.EducationsRepository
+	feed        *changefeed.Feed
+	logger      *zap.Logger
+
+	// since holds, per entity type, when the last successful check ran.
+	since map[string]time.Time
+}
+
+// New creates a Publisher announcing on feed.
+func New(
+	skills repositories.SkillsRepository,
+	experiences repositories.ExperiencesRepository,
+	educations repositories.EducationsRepository,
+	feed *changefeed.Feed,
+	logger *zap.Logger,
+) *Publisher {
+	return &Publisher{
+		skills:      skills,
+		experiences: experiences,
+		educations:  educations,
+		feed:        feed,
+		logger:      logger,
+		since:       make(map[string]time.Time),
+	}
+}
+
+// Run announces the entries going live every interval until ctx is done.
+// Entries that went live before Run was called are not announced.
+func (p *Publisher) Run(ctx context.Context) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	start := time.Now()
+	for _, entityType := range []string{"skill", "experience", "education"} {
+		p.since[entityType] = start
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			p.publish(ctx)
+		}
+	}
+}
+
+func (p *Publisher) publish(ctx context.Context) {
+	lists := []struct {
+		entityType string
+		list       func(ctx context.Context, since time.Time) ([]int64, time.Time, error)
+	}{
+		{"skill", p.skills.ListSkillsPublishedSince},
+		{"experience", p.experiences.ListExperiencesPublishedSince},
+		{"education", p.educations.ListEducationsPublishedSince},
+	}
+
+	for _, list := range lists {
+		ids, checkedAt, err := list.list(ctx, p.since[list.entityType])
+		if err != nil {
+			p.logger.Error("publisher.failed", zap.String("entity", list.entityType), zap.Error(err))
+			continue
+		}
+
+		for _, id := range ids {
+			p.feed.Publish(changefeed.Event{
+				EntityType: list.entityType,
+				ID:         id,
+				Operation:  "insert",
+			})
+		}
+
+		p.since[list.entityType] = checkedAt
+	}
+}
@@ -0,0 +1,79 @@
+package purge
+
+import (
+	"context"
+	"time"
+
+	"github.com/jorgejr568/portfolio-grpc/internal/repositories"
+	"go.uber.org/zap"
+)
+
+// interval is how often deleted rows past the retention window are purged.
+const interval = time.Hour
+
+// Purger permanently removes skills, experiences and educations that were
+// deleted longer than the retention window ago.
+type Purger struct {
+	skills      repositories.SkillsRepository
+	experiences repositories.ExperiencesRepository
+	educations  repositories.EducationsRepository
+	logger      *zap.Logger
+	retention   time.Duration
+}
+
+// New creates a Purger keeping deleted rows for retention.
+func New(
+	skills repositories.SkillsRepository,
+	experiences repositories.ExperiencesRepository,
+	educations repositories.EducationsRepository,
+	logger *zap.Logger,
+	retention time.Duration,
+) *Purger {
+	return &Purger{
+		skills:      skills,
+		experiences: experiences,
+		educations:  educations,
+		logger:      logger,
+		retention:   retention,
+	}
+}
+
+// Run purges once right away and then every interval until ctx is done.
+func (p *Purger) Run(ctx context.Context) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		p.purge(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *Purger) purge(ctx context.Context) {
+	before := time.Now().Add(-p.retention)
+	purges := []struct {
+		entity string
+		purge  func(ctx context.Context, before time.Time) (int64, error)
+	}{
+		{"skills", p.skills.PurgeDeletedSkills},
+		{"experiences", p.experiences.PurgeDeletedExperiences},
+		{"educations", p.educations.PurgeDeletedEducations},
+	}
+
+	for _, purge := range purges {
+		purged, err := purge.purge(ctx, before)
+		if err != nil {
+			p.logger.Error("purge.failed", zap.String("entity", purge.entity), zap.Error(err))
+			continue
+		}
+
+		if purged > 0 {
+			p.logger.Info("purge.purged", zap.String("entity", purge.entity), zap.Int64("rows", purged))
+		}
+	}
+}
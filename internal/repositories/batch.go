@@ -0,0 +1,24 @@
+package repositories
+
+// inRequestOrder arranges items, fetched in any order, in the order of ids
+// and returns the ids no item was found for. An id requested more than once
+// is returned as many times.
+func inRequestOrder[T any](ids []int64, items []T, id func(T) int64) ([]T, []int64) {
+	byID := make(map[int64]T, len(items))
+	for _, item := range items {
+		byID[id(item)] = item
+	}
+
+	ordered := make([]T, 0, len(ids))
+	missing := make([]int64, 0)
+	for _, requested := range ids {
+		item, ok := byID[requested]
+		if !ok {
+			missing = append(missing, requested)
+			continue
+		}
+		ordered = append(ordered, item)
+	}
+
+	return ordered, missing
+}
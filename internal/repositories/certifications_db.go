@@ -0,0 +1,206 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc"
+	"github.com/jorgejr568/portfolio-grpc/internal/utils"
+)
+
+const (
+	certificationsTableName = "certifications"
+	// certificationsExpiringSoonDays is how close to its expiry date a
+	// certification is flagged as expiring soon.
+	certificationsExpiringSoonDays = 90
+)
+
+var (
+	ErrCertificationNotFound = errors.New("certification not found")
+)
+
+func newCertificationsDBRepository(db *sql.DB) CertificationsRepository {
+	return &certificationsRepositoryImpl{
+		db:        db,
+		tableName: certificationsTableName,
+		columns:   certificationsColumns,
+	}
+}
+
+var certificationsColumns = columnSet[pgCertification]{
+	{expr: "c.id", paths: []string{"id"}, dest: func(p *pgCertification) any { return &p.ID }},
+	{expr: "c.title", paths: []string{"title"}, dest: func(p *pgCertification) any { return &p.Title }},
+	{expr: "c.issuer", paths: []string{"issuer"}, dest: func(p *pgCertification) any { return &p.Issuer }},
+	{expr: "c.credential_id", paths: []string{"credential_id"}, dest: func(p *pgCertification) any { return &p.CredentialID }},
+	{expr: "c.verification_url", paths: []string{"verification_url"}, dest: func(p *pgCertification) any { return &p.VerificationURL }},
+	{expr: "c.issued_at", paths: []string{"issued_at"}, dest: func(p *pgCertification) any { return &p.IssuedAt }},
+	{expr: "c.expires_at", paths: []string{"expires_at"}, dest: func(p *pgCertification) any { return &p.ExpiresAt }},
+	{expr: fmt.Sprintf("(c.expires_at >= CURRENT_DATE AND c.expires_at < CURRENT_DATE + %d) IS TRUE", certificationsExpiringSoonDays), paths: []string{"expires_soon"}, dest: func(p *pgCertification) any { return &p.ExpiresSoon }},
+	{expr: "c.created_at", paths: []string{"created_at"}, dest: func(p *pgCertification) any { return &p.CreatedAt }},
+	{expr: "c.updated_at", paths: []string{"updated_at"}, dest: func(p *pgCertification) any { return &p.UpdatedAt }},
+}
+
+type pgCertification struct {
+	ID              int64
+	Title           string
+	Issuer          string
+	CredentialID    string
+	VerificationURL string
+	IssuedAt        *time.Time
+	ExpiresAt       *time.Time
+	ExpiresSoon     bool
+	CreatedAt       *time.Time
+	UpdatedAt       *time.Time
+}
+
+func (p *pgCertification) toProto() *portfolio_grpc.Certification {
+	return &portfolio_grpc.Certification{
+		Id:              p.ID,
+		Title:           p.Title,
+		Issuer:          p.Issuer,
+		CredentialId:    p.CredentialID,
+		VerificationUrl: p.VerificationURL,
+		IssuedAt:        utils.TimeToProtoDate(p.IssuedAt),
+		ExpiresAt:       utils.TimeToProtoDate(p.ExpiresAt),
+		ExpiresSoon:     p.ExpiresSoon,
+		CreatedAt:       utils.TimeToProtoTimestamp(p.CreatedAt),
+		UpdatedAt:       utils.TimeToProtoTimestamp(p.UpdatedAt),
+	}
+}
+
+type certificationsRepositoryImpl struct {
+	db        *sql.DB
+	tableName string
+	columns   columnSet[pgCertification]
+}
+
+var certificationsFilterFields = map[string]filterField{
+	"id":            {column: "c.id", kind: filterInt},
+	"title":         {column: "c.title", kind: filterString},
+	"issuer":        {column: "c.issuer", kind: filterString},
+	"credential_id": {column: "c.credential_id", kind: filterString},
+	"issued_at":     {column: "c.issued_at", kind: filterDate},
+	"expires_at":    {column: "c.expires_at", kind: filterDate},
+	"created_at":    {column: "c.created_at", kind: filterTimestamp},
+	"updated_at":    {column: "c.updated_at", kind: filterTimestamp},
+}
+
+var certificationsSortFields = map[string]sortField[*portfolio_grpc.Certification]{
+	"id": {column: "c.id", cast: "bigint", value: func(certification *portfolio_grpc.Certification) string {
+		return formatIDKey(certification.Id)
+	}},
+	"title": {column: "c.title", cast: "text", value: func(certification *portfolio_grpc.Certification) string {
+		return certification.Title
+	}},
+	"issuer": {column: "c.issuer", cast: "text", value: func(certification *portfolio_grpc.Certification) string {
+		return certification.Issuer
+	}},
+	"issued_at": {column: "c.issued_at", cast: "date", value: func(certification *portfolio_grpc.Certification) string {
+		return formatDateKey(certification.IssuedAt)
+	}},
+	"created_at": {column: "c.created_at", cast: "timestamptz", value: func(certification *portfolio_grpc.Certification) string {
+		return formatTimestampKey(certification.CreatedAt)
+	}},
+	"updated_at": {column: "c.updated_at", cast: "timestamptz", value: func(certification *portfolio_grpc.Certification) string {
+		return formatTimestampKey(certification.UpdatedAt)
+	}},
+}
+
+func (c *certificationsRepositoryImpl) ListCertifications(ctx context.Context, params ListCertificationsParams) ([]*portfolio_grpc.Certification, string, error) {
+	order, err := parseOrderBy(params.OrderBy, certificationsSortFields, "issued_at desc")
+	if err != nil {
+		return nil, "", err
+	}
+
+	fingerprint := queryFingerprint(params.ExcludeExpired, params.Filter, params.OrderBy)
+	token, err := decodePageToken(params.PageToken, fingerprint, order.keys)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var (
+		args       queryArgs
+		conditions []string
+	)
+	if params.ExcludeExpired {
+		conditions = append(conditions, "(c.expires_at IS NULL OR c.expires_at >= CURRENT_DATE)")
+	}
+
+	filter, err := compileFilter(params.Filter, certificationsFilterFields, &args)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if filter != "" {
+		conditions = append(conditions, filter)
+	}
+
+	if token != nil {
+		conditions = append(conditions, keysetCondition(&args, order.keys, token.Keys))
+	}
+
+	columns := c.columns.selection(params.ReadMask, order.fields...)
+	pageSize := normalizePageSize(params.PageSize)
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM %s c
+		%s
+		%s
+		LIMIT %s`, columns.sql(), c.tableName, whereClause(conditions), orderByClause(order.keys), args.bind(pageSize+1))
+
+	rows, err := c.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	certifications := make([]*portfolio_grpc.Certification, 0)
+	for rows.Next() {
+		certification, err := c.decodeCertification(rows, columns)
+		if err != nil {
+			return nil, "", err
+		}
+		certifications = append(certifications, certification)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	certifications, nextPageToken := paginate(certifications, pageSize, fingerprint, order.keyValues)
+
+	return certifications, nextPageToken, nil
+}
+
+func (c *certificationsRepositoryImpl) GetCertification(ctx context.Context, id int, readMask []string) (*portfolio_grpc.Certification, error) {
+	columns := c.columns.selection(readMask, "id")
+
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM %s c
+		WHERE c.id = $1`, columns.sql(), c.tableName)
+
+	row := c.db.QueryRowContext(ctx, query, id)
+
+	certification, err := c.decodeCertification(row, columns)
+	if err != nil {
+		return nil, err
+	}
+
+	return certification, nil
+}
+
+func (c *certificationsRepositoryImpl) decodeCertification(row rowScanner, columns columnSet[pgCertification]) (*portfolio_grpc.Certification, error) {
+	certification, err := columns.scan(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrCertificationNotFound
+		}
+		return nil, fmt.Errorf("failed to scan certification: %w", err)
+	}
+
+	return certification.toProto(), nil
+}
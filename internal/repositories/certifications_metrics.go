@@ -0,0 +1,48 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc"
+	"github.com/jorgejr568/portfolio-grpc/internal/client/statsd"
+)
+
+type certificationsMetricsRepositoryImpl struct {
+	repo   CertificationsRepository
+	statsd statsd.Client
+}
+
+func (c *certificationsMetricsRepositoryImpl) ListCertifications(ctx context.Context, params ListCertificationsParams) ([]*portfolio_grpc.Certification, string, error) {
+	stat := c.statsd.Start("certifications", "ListCertifications")
+	defer stat.Finished()
+
+	certifications, nextPageToken, err := c.repo.ListCertifications(ctx, params)
+	if err != nil {
+		stat.FailedWithError(err)
+		return nil, "", err
+	}
+
+	stat.Succeeded()
+	return certifications, nextPageToken, nil
+}
+
+func (c *certificationsMetricsRepositoryImpl) GetCertification(ctx context.Context, id int, readMask []string) (*portfolio_grpc.Certification, error) {
+	stat := c.statsd.Start("certifications", "GetCertification")
+	defer stat.Finished()
+
+	certification, err := c.repo.GetCertification(ctx, id, readMask)
+	if err != nil {
+		stat.FailedWithError(err)
+		return nil, err
+	}
+
+	stat.Succeeded()
+	return certification, nil
+}
+
+func newCertificationsMetricsRepository(repo CertificationsRepository, statsdClient statsd.Client) CertificationsRepository {
+	return &certificationsMetricsRepositoryImpl{
+		repo:   repo,
+		statsd: statsdClient,
+	}
+}
@@ -0,0 +1,37 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc"
+	"github.com/jorgejr568/portfolio-grpc/internal/client/statsd"
+)
+
+type CertificationsRepository interface {
+	// ListCertifications returns a page of certifications and the token of the
+	// next page, which is empty on the last page.
+	ListCertifications(ctx context.Context, params ListCertificationsParams) ([]*portfolio_grpc.Certification, string, error)
+	// GetCertification returns the certification with the given id.
+	GetCertification(ctx context.Context, id int, readMask []string) (*portfolio_grpc.Certification, error)
+}
+
+type ListCertificationsParams struct {
+	// Filter is an AIP-160 filter, see compileFilter.
+	Filter string
+	// OrderBy is an AIP-132 order_by such as "issued_at desc".
+	OrderBy   string
+	PageSize  int
+	PageToken string
+	// ExcludeExpired leaves out certifications whose expiry date has passed.
+	ExcludeExpired bool
+	// ReadMask limits the fields read. Empty reads every field.
+	ReadMask []string
+}
+
+func NewCertificationsRepository(db *sql.DB, client statsd.Client) CertificationsRepository {
+	return newCertificationsMetricsRepository(
+		newCertificationsDBRepository(db),
+		client,
+	)
+}
@@ -0,0 +1,76 @@
+package repositories
+
+import (
+	"strings"
+)
+
+// column is a selectable column of the row type T. Paths are the read mask
+// paths the column serves and dest returns the field of T it is scanned into.
+type column[T any] struct {
+	expr  string
+	paths []string
+	dest  func(row *T) any
+}
+
+// columnSet is the ordered list of columns a repository reads.
+type columnSet[T any] []column[T]
+
+// selection returns the columns serving readMask, plus the columns of the
+// required paths, such as the id and the sort keys a page token is built
+// from. An empty readMask selects every column. Paths without a column are
+// ignored; the server validates read masks against the proto message.
+func (c columnSet[T]) selection(readMask []string, required ...string) columnSet[T] {
+	if len(readMask) == 0 {
+		return c
+	}
+
+	paths := append(append([]string{}, readMask...), required...)
+	selected := make(columnSet[T], 0, len(c))
+	for _, col := range c {
+		if col.servesAny(paths) {
+			selected = append(selected, col)
+		}
+	}
+
+	return selected
+}
+
+// servesAny reports whether the column is needed by any of paths. A path
+// selects its own column, the columns of its subfields and, for a subfield
+// path such as "company.name", the column holding the whole parent.
+func (col column[T]) servesAny(paths []string) bool {
+	for _, path := range paths {
+		for _, served := range col.paths {
+			if served == path || strings.HasPrefix(served, path+".") || strings.HasPrefix(path, served+".") {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// sql renders the columns as the select list of a query.
+func (c columnSet[T]) sql() string {
+	exprs := make([]string, len(c))
+	for i, col := range c {
+		exprs[i] = col.expr
+	}
+
+	return strings.Join(exprs, ",")
+}
+
+// scan scans row into a new T, reading only the selected columns.
+func (c columnSet[T]) scan(row rowScanner) (*T, error) {
+	dest := new(T)
+	targets := make([]any, len(c))
+	for i, col := range c {
+		targets[i] = col.dest(dest)
+	}
+
+	if err := row.Scan(targets...); err != nil {
+		return nil, err
+	}
+
+	return dest, nil
+}
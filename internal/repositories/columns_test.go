@@ -0,0 +1,94 @@
+package repositories
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type testColumnRow struct {
+	ID          int64
+	Title       string
+	CompanyName string
+	CompanyURL  string
+	Details     string
+}
+
+var testColumns = columnSet[testColumnRow]{
+	{expr: "id", paths: []string{"id"}, dest: func(r *testColumnRow) any { return &r.ID }},
+	{expr: "title", paths: []string{"title"}, dest: func(r *testColumnRow) any { return &r.Title }},
+	{expr: "company_name", paths: []string{"company.name"}, dest: func(r *testColumnRow) any { return &r.CompanyName }},
+	{expr: "company_url", paths: []string{"company.url"}, dest: func(r *testColumnRow) any { return &r.CompanyURL }},
+	{expr: "technology_details", paths: []string{"technologies", "technology_details"}, dest: func(r *testColumnRow) any { return &r.Details }},
+}
+
+func TestColumnSetSelection(t *testing.T) {
+	tests := []struct {
+		name     string
+		readMask []string
+		required []string
+		want     string
+	}{
+		{name: "empty mask selects every column", readMask: nil, required: []string{"id"}, want: "id,title,company_name,company_url,technology_details"},
+		{name: "single path", readMask: []string{"title"}, want: "title"},
+		{name: "required paths", readMask: []string{"title"}, required: []string{"id"}, want: "id,title"},
+		{name: "keeps column order", readMask: []string{"company.url", "id"}, want: "id,company_url"},
+		{name: "parent selects subfield columns", readMask: []string{"company"}, want: "company_name,company_url"},
+		{name: "subfield of whole column", readMask: []string{"technology_details.name"}, want: "technology_details"},
+		{name: "column serving several paths", readMask: []string{"technologies"}, want: "technology_details"},
+		{name: "prefix is not a parent", readMask: []string{"comp"}, want: ""},
+		{name: "unknown path", readMask: []string{"salary"}, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := testColumns.selection(tt.readMask, tt.required...)
+			if got.sql() != tt.want {
+				t.Errorf("selection(%v, %v) = %q, want %q", tt.readMask, tt.required, got.sql(), tt.want)
+			}
+		})
+	}
+}
+
+func TestColumnSetSelectionDoesNotAlias(t *testing.T) {
+	readMask := make([]string, 1, 4)
+	readMask[0] = "title"
+	testColumns.selection(readMask, "id")
+
+	if extended := readMask[:2]; extended[1] != "" {
+		t.Errorf("selection appended %q to the caller's read mask", extended[1])
+	}
+}
+
+// fakeRow scans values into the destinations in order.
+type fakeRow []any
+
+func (r fakeRow) Scan(dest ...any) error {
+	if len(dest) != len(r) {
+		return errors.New("wrong number of destinations")
+	}
+
+	for i, value := range r {
+		reflect.ValueOf(dest[i]).Elem().Set(reflect.ValueOf(value))
+	}
+
+	return nil
+}
+
+func TestColumnSetScan(t *testing.T) {
+	selected := testColumns.selection([]string{"company.name"}, "id")
+
+	got, err := selected.scan(fakeRow{int64(7), "Acme"})
+	if err != nil {
+		t.Fatalf("scan() error = %v", err)
+	}
+
+	want := &testColumnRow{ID: 7, CompanyName: "Acme"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("scan() = %+v, want %+v", got, want)
+	}
+
+	if _, err := selected.scan(fakeRow{int64(7)}); err == nil {
+		t.Error("scan() with a short row succeeded, want an error")
+	}
+}
@@ -0,0 +1,190 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc"
+	"github.com/jorgejr568/portfolio-grpc/internal/utils"
+)
+
+const (
+	contactMessagesTableName = "contact_messages"
+)
+
+var (
+	ErrContactMessageNotFound = errors.New("contact message not found")
+	ErrContactRateLimited     = errors.New("too many contact messages")
+)
+
+func newContactMessagesDBRepository(db *sql.DB) ContactMessagesRepository {
+	return &contactMessagesRepositoryImpl{
+		db:        db,
+		tableName: contactMessagesTableName,
+		selectColumns: strings.Join([]string{
+			"id",
+			"name",
+			"email",
+			"subject",
+			"message",
+			"sender_ip",
+			"created_at",
+			"delivered_at",
+		}, ","),
+	}
+}
+
+type pgContactMessage struct {
+	ID          int64
+	Name        string
+	Email       string
+	Subject     string
+	Message     string
+	SenderIP    string
+	CreatedAt   *time.Time
+	DeliveredAt *time.Time
+}
+
+func (p *pgContactMessage) toProto() *portfolio_grpc.ContactMessage {
+	return &portfolio_grpc.ContactMessage{
+		Id:          p.ID,
+		Name:        p.Name,
+		Email:       p.Email,
+		Subject:     p.Subject,
+		Message:     p.Message,
+		SenderIp:    p.SenderIP,
+		CreatedAt:   utils.TimeToProtoTimestamp(p.CreatedAt),
+		DeliveredAt: utils.TimeToProtoTimestamp(p.DeliveredAt),
+	}
+}
+
+type contactMessagesRepositoryImpl struct {
+	db            *sql.DB
+	tableName     string
+	selectColumns string
+}
+
+func (c *contactMessagesRepositoryImpl) CreateContactMessage(ctx context.Context, message *portfolio_grpc.ContactMessage, limit int, since time.Time) (*portfolio_grpc.ContactMessage, error) {
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if err := lockContactSender(ctx, tx, message.SenderIp, message.Email); err != nil {
+		return nil, err
+	}
+
+	countQuery := fmt.Sprintf(`
+		SELECT COUNT(*)
+		FROM %s
+		WHERE created_at >= $1
+		AND ((sender_ip <> '' AND sender_ip = $2) OR lower(email) = lower($3))`, c.tableName)
+
+	var count int
+	if err := tx.QueryRowContext(ctx, countQuery, since, message.SenderIp, message.Email).Scan(&count); err != nil {
+		return nil, fmt.Errorf("failed to count contact messages: %w", err)
+	}
+
+	if count >= limit {
+		return nil, ErrContactRateLimited
+	}
+
+	insertQuery := fmt.Sprintf(`
+		INSERT INTO %s (name, email, subject, message, sender_ip, created_at)
+		VALUES ($1, $2, $3, $4, $5, NOW())
+		RETURNING %s`, c.tableName, c.selectColumns)
+
+	row := tx.QueryRowContext(ctx, insertQuery,
+		message.Name,
+		message.Email,
+		message.Subject,
+		message.Message,
+		message.SenderIp,
+	)
+
+	created, err := c.decodeContactMessage(row)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// lockContactSender holds transaction level advisory locks on the sender IP
+// and email until tx ends, so concurrent messages from the same sender are
+// counted one after the other. The email is always locked first so two
+// transactions cannot wait on each other.
+func lockContactSender(ctx context.Context, tx *sql.Tx, senderIP, email string) error {
+	keys := []string{"contact_messages:email:" + strings.ToLower(email)}
+	if senderIP != "" {
+		keys = append(keys, "contact_messages:ip:"+senderIP)
+	}
+
+	for _, key := range keys {
+		if err := advisoryXactLock(ctx, tx, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// advisoryXactLock holds a transaction level advisory lock on key until tx
+// ends.
+func advisoryXactLock(ctx context.Context, tx *sql.Tx, key string) error {
+	if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock(hashtext($1))", key); err != nil {
+		return fmt.Errorf("failed to take advisory lock: %w", err)
+	}
+
+	return nil
+}
+
+func (c *contactMessagesRepositoryImpl) MarkContactMessageDelivered(ctx context.Context, id int) error {
+	query := fmt.Sprintf("UPDATE %s SET delivered_at = NOW() WHERE id = $1", c.tableName)
+	result, err := c.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return ErrContactMessageNotFound
+	}
+
+	return nil
+}
+
+func (c *contactMessagesRepositoryImpl) decodeContactMessage(row rowScanner) (*portfolio_grpc.ContactMessage, error) {
+	message := new(pgContactMessage)
+	err := row.Scan(
+		&message.ID,
+		&message.Name,
+		&message.Email,
+		&message.Subject,
+		&message.Message,
+		&message.SenderIP,
+		&message.CreatedAt,
+		&message.DeliveredAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrContactMessageNotFound
+		}
+		return nil, fmt.Errorf("failed to scan contact message: %w", err)
+	}
+
+	return message.toProto(), nil
+}
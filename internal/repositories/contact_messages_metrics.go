@@ -0,0 +1,49 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc"
+	"github.com/jorgejr568/portfolio-grpc/internal/client/statsd"
+)
+
+type contactMessagesMetricsRepositoryImpl struct {
+	repo   ContactMessagesRepository
+	statsd statsd.Client
+}
+
+func (c *contactMessagesMetricsRepositoryImpl) CreateContactMessage(ctx context.Context, message *portfolio_grpc.ContactMessage, limit int, since time.Time) (*portfolio_grpc.ContactMessage, error) {
+	stat := c.statsd.Start("contact_messages", "CreateContactMessage")
+	defer stat.Finished()
+
+	created, err := c.repo.CreateContactMessage(ctx, message, limit, since)
+	if err != nil {
+		stat.FailedWithError(err)
+		return nil, err
+	}
+
+	stat.Succeeded()
+	return created, nil
+}
+
+func (c *contactMessagesMetricsRepositoryImpl) MarkContactMessageDelivered(ctx context.Context, id int) error {
+	stat := c.statsd.Start("contact_messages", "MarkContactMessageDelivered")
+	defer stat.Finished()
+
+	err := c.repo.MarkContactMessageDelivered(ctx, id)
+	if err != nil {
+		stat.FailedWithError(err)
+		return err
+	}
+
+	stat.Succeeded()
+	return nil
+}
+
+func newContactMessagesMetricsRepository(repo ContactMessagesRepository, statsdClient statsd.Client) ContactMessagesRepository {
+	return &contactMessagesMetricsRepositoryImpl{
+		repo:   repo,
+		statsd: statsdClient,
+	}
+}
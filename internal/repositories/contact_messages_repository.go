@@ -0,0 +1,25 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc"
+	"github.com/jorgejr568/portfolio-grpc/internal/client/statsd"
+)
+
+type ContactMessagesRepository interface {
+	// CreateContactMessage stores message unless its sender, matched by
+	// sender IP or email, already sent limit messages since the given time,
+	// in which case it returns ErrContactRateLimited.
+	CreateContactMessage(ctx context.Context, message *portfolio_grpc.ContactMessage, limit int, since time.Time) (*portfolio_grpc.ContactMessage, error)
+	MarkContactMessageDelivered(ctx context.Context, id int) error
+}
+
+func NewContactMessagesRepository(db *sql.DB, client statsd.Client) ContactMessagesRepository {
+	return newContactMessagesMetricsRepository(
+		newContactMessagesDBRepository(db),
+		client,
+	)
+}
@@ -0,0 +1,28 @@
+package repositories
+
+import (
+	"errors"
+
+	"github.com/jorgejr568/portfolio-grpc/internal/utils"
+	"google.golang.org/genproto/googleapis/type/date"
+)
+
+var (
+	ErrInvalidDateRange = errors.New("ended_at must not be before started_at")
+)
+
+// checkDateRange rejects ranges that end before they start. Open ranges are
+// always valid.
+func checkDateRange(startedAt, endedAt *date.Date) error {
+	start := utils.ProtoDateToTime(startedAt)
+	end := utils.ProtoDateToTime(endedAt)
+	if start == nil || end == nil {
+		return nil
+	}
+
+	if end.Before(*start) {
+		return ErrInvalidDateRange
+	}
+
+	return nil
+}
@@ -5,11 +5,12 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc"
+	"github.com/jorgejr568/portfolio-grpc/internal/locale"
 	"github.com/jorgejr568/portfolio-grpc/internal/utils"
+	"github.com/lib/pq"
 )
 
 const (
@@ -20,23 +21,40 @@ var (
 	ErrEducationNotFound = errors.New("education not found")
 )
 
-func newEducationsDBRepository(db *sql.DB) EducationsRepository {
+func newEducationsDBRepository(db *sql.DB, negotiator *locale.Negotiator) EducationsRepository {
 	return &educationsRepositoryImpl{
-		db:        db,
-		tableName: educationsTableName,
-		selectColumns: strings.Join([]string{
-			"id",
-			"title",
-			"institution_name",
-			"institution_url",
-			"started_at",
-			"finished_at as ended_at",
-			"created_at",
-			"updated_at",
-		}, ","),
+		db:         db,
+		negotiator: negotiator,
+		tableName:  educationsTableName,
+		columns:    educationsColumns,
 	}
 }
 
+var educationsUpdateFields = []updateField{
+	{path: "title", columns: []string{"title"}},
+	{path: "institution", columns: []string{"institution_name", "institution_url"}},
+	{path: "institution.name", columns: []string{"institution_name"}},
+	{path: "institution.url", columns: []string{"institution_url"}},
+	{path: "started_at", columns: []string{"started_at"}},
+	{path: "ended_at", columns: []string{"finished_at"}},
+	{path: "visibility", columns: []string{"visibility"}},
+	{path: "publish_at", columns: []string{"publish_at"}},
+}
+
+var educationsColumns = columnSet[pgEducation]{
+	{expr: "id", paths: []string{"id"}, dest: func(p *pgEducation) any { return &p.ID }},
+	{expr: "title", paths: []string{"title"}, dest: func(p *pgEducation) any { return &p.Title }},
+	{expr: "institution_name", paths: []string{"institution.name"}, dest: func(p *pgEducation) any { return &p.InstitutionName }},
+	{expr: "institution_url", paths: []string{"institution.url"}, dest: func(p *pgEducation) any { return &p.InstitutionURL }},
+	{expr: "started_at", paths: []string{"started_at"}, dest: func(p *pgEducation) any { return &p.StartedAt }},
+	{expr: "finished_at as ended_at", paths: []string{"ended_at"}, dest: func(p *pgEducation) any { return &p.EndedAt }},
+	{expr: "created_at", paths: []string{"created_at"}, dest: func(p *pgEducation) any { return &p.CreatedAt }},
+	{expr: "updated_at", paths: []string{"updated_at"}, dest: func(p *pgEducation) any { return &p.UpdatedAt }},
+	{expr: "deleted_at", paths: []string{"deleted_at"}, dest: func(p *pgEducation) any { return &p.DeletedAt }},
+	{expr: "visibility", paths: []string{"visibility"}, dest: func(p *pgEducation) any { return &p.Visibility }},
+	{expr: "publish_at", paths: []string{"publish_at"}, dest: func(p *pgEducation) any { return &p.PublishAt }},
+}
+
 type pgEducation struct {
 	ID              int64
 	Title           string
@@ -47,16 +65,22 @@ type pgEducation struct {
 	EndedAt         *time.Time
 	CreatedAt       *time.Time
 	UpdatedAt       *time.Time
+	DeletedAt       *time.Time
+	Visibility      string
+	PublishAt       *time.Time
 }
 
 func (p *pgEducation) toProto() *portfolio_grpc.Education {
 	edu := &portfolio_grpc.Education{
-		Id:        p.ID,
-		Title:     p.Title,
-		CreatedAt: utils.TimeToProtoTimestamp(p.CreatedAt),
-		UpdatedAt: utils.TimeToProtoTimestamp(p.UpdatedAt),
-		StartedAt: utils.TimeToProtoDate(p.StartedAt),
-		EndedAt:   utils.TimeToProtoDate(p.EndedAt),
+		Id:         p.ID,
+		Title:      p.Title,
+		CreatedAt:  utils.TimeToProtoTimestamp(p.CreatedAt),
+		UpdatedAt:  utils.TimeToProtoTimestamp(p.UpdatedAt),
+		DeletedAt:  utils.TimeToProtoTimestamp(p.DeletedAt),
+		StartedAt:  utils.TimeToProtoDate(p.StartedAt),
+		EndedAt:    utils.TimeToProtoDate(p.EndedAt),
+		Visibility: visibilities[p.Visibility],
+		PublishAt:  utils.TimeToProtoTimestamp(p.PublishAt),
 	}
 
 	edu.Institution = &portfolio_grpc.Education_Institution{
@@ -76,68 +100,356 @@ func (p *pgEducation) toProto() *portfolio_grpc.Education {
 }
 
 type educationsRepositoryImpl struct {
-	db            *sql.DB
-	tableName     string
-	selectColumns string
+	db         *sql.DB
+	negotiator *locale.Negotiator
+	tableName  string
+	columns    columnSet[pgEducation]
 }
 
-func (e *educationsRepositoryImpl) ListEducations(ctx context.Context) ([]*portfolio_grpc.Education, error) {
+var educationsFilterFields = map[string]filterField{
+	"id":               {column: "e.id", kind: filterInt},
+	"title":            {column: "e.title", kind: filterString},
+	"institution.name": {column: "e.institution_name", kind: filterString},
+	"started_at":       {column: "e.started_at", kind: filterDate},
+	"ended_at":         {column: "e.finished_at", kind: filterDate},
+	"created_at":       {column: "e.created_at", kind: filterTimestamp},
+	"updated_at":       {column: "e.updated_at", kind: filterTimestamp},
+}
+
+var educationsSortFields = map[string]sortField[*portfolio_grpc.Education]{
+	"id": {column: "e.id", cast: "bigint", value: func(education *portfolio_grpc.Education) string {
+		return formatIDKey(education.Id)
+	}},
+	"title": {column: "e.title", cast: "text", value: func(education *portfolio_grpc.Education) string {
+		return education.Title
+	}},
+	"started_at": {column: "e.started_at", cast: "date", value: func(education *portfolio_grpc.Education) string {
+		return formatDateKey(education.StartedAt)
+	}},
+	"created_at": {column: "e.created_at", cast: "timestamptz", value: func(education *portfolio_grpc.Education) string {
+		return formatTimestampKey(education.CreatedAt)
+	}},
+	"updated_at": {column: "e.updated_at", cast: "timestamptz", value: func(education *portfolio_grpc.Education) string {
+		return formatTimestampKey(education.UpdatedAt)
+	}},
+}
+
+func (e *educationsRepositoryImpl) ListEducations(ctx context.Context, params ListEducationsParams) ([]*portfolio_grpc.Education, string, error) {
+	order, err := parseOrderBy(params.OrderBy, educationsSortFields, "started_at desc")
+	if err != nil {
+		return nil, "", err
+	}
+
+	fingerprint := queryFingerprint(params.Filter, params.OrderBy, params.ShowDeleted, params.ShowUnpublished)
+	token, err := decodePageToken(params.PageToken, fingerprint, order.keys)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var args queryArgs
+	filter, err := compileFilter(params.Filter, educationsFilterFields, &args)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var conditions []string
+	if !params.ShowDeleted {
+		conditions = append(conditions, "e.deleted_at IS NULL")
+	}
+
+	if !params.ShowUnpublished {
+		conditions = append(conditions, publishedCondition("e."))
+	}
+
+	if filter != "" {
+		conditions = append(conditions, filter)
+	}
+
+	if token != nil {
+		conditions = append(conditions, keysetCondition(&args, order.keys, token.Keys))
+	}
+
+	columns := e.columns.selection(params.ReadMask, order.fields...)
+	pageSize := normalizePageSize(params.PageSize)
 	query := fmt.Sprintf(`
 		SELECT %s
 		FROM %s e
-		ORDER BY e.started_at DESC
-		LIMIT 1000`, e.selectColumns, e.tableName)
+		%s
+		%s
+		LIMIT %s`, columns.sql(), e.tableName, whereClause(conditions), orderByClause(order.keys), args.bind(pageSize+1))
 
-	rows, err := e.db.QueryContext(ctx, query)
+	rows, err := e.db.QueryContext(ctx, query, args...)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	defer rows.Close()
 
 	educations := make([]*portfolio_grpc.Education, 0)
 	for rows.Next() {
-		education, err := e.decodeEducation(rows)
+		education, err := e.decodeEducation(rows, columns)
 		if err != nil {
-			return nil, err
+			return nil, "", err
 		}
 		educations = append(educations, education)
 	}
 
 	if err := rows.Err(); err != nil {
-		return nil, err
+		return nil, "", err
+	}
+
+	educations, nextPageToken := paginate(educations, pageSize, fingerprint, order.keyValues)
+
+	// Localized after paginating so page tokens keep the stored values.
+	if err := e.localizeEducations(ctx, educations); err != nil {
+		return nil, "", err
 	}
 
-	return educations, nil
+	return educations, nextPageToken, nil
 }
 
-func (e *educationsRepositoryImpl) GetEducation(ctx context.Context, id int) (*portfolio_grpc.Education, error) {
+func (e *educationsRepositoryImpl) GetEducation(ctx context.Context, id int, readMask []string, showDeleted, showUnpublished bool) (*portfolio_grpc.Education, error) {
+	columns := e.columns.selection(readMask, "id")
+
+	conditions := []string{"id = $1"}
+	if !showDeleted {
+		conditions = append(conditions, "deleted_at IS NULL")
+	}
+
+	if !showUnpublished {
+		conditions = append(conditions, publishedCondition(""))
+	}
+
 	query := fmt.Sprintf(`
 		SELECT %s
 		FROM %s
-		WHERE id = $1`, e.selectColumns, e.tableName)
+		%s`, columns.sql(), e.tableName, whereClause(conditions))
 
 	row := e.db.QueryRowContext(ctx, query, id)
 
-	education, err := e.decodeEducation(row)
+	education, err := e.decodeEducation(row, columns)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := e.localizeEducations(ctx, []*portfolio_grpc.Education{education}); err != nil {
+		return nil, err
+	}
+
 	return education, nil
 }
 
-func (e *educationsRepositoryImpl) decodeEducation(row rowScanner) (*portfolio_grpc.Education, error) {
-	edu := new(pgEducation)
-	err := row.Scan(
-		&edu.ID,
-		&edu.Title,
-		&edu.InstitutionName,
-		&edu.InstitutionURL,
-		&edu.StartedAt,
-		&edu.EndedAt,
-		&edu.CreatedAt,
-		&edu.UpdatedAt,
+func (e *educationsRepositoryImpl) BatchGetEducations(ctx context.Context, ids []int64, readMask []string) ([]*portfolio_grpc.Education, []int64, error) {
+	columns := e.columns.selection(readMask, "id")
+
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM %s
+		WHERE id = ANY($1) AND deleted_at IS NULL AND %s`, columns.sql(), e.tableName, publishedCondition(""))
+
+	rows, err := e.db.QueryContext(ctx, query, pq.Array(ids))
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	educations := make([]*portfolio_grpc.Education, 0, len(ids))
+	for rows.Next() {
+		education, err := e.decodeEducation(rows, columns)
+		if err != nil {
+			return nil, nil, err
+		}
+		educations = append(educations, education)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	if err := e.localizeEducations(ctx, educations); err != nil {
+		return nil, nil, err
+	}
+
+	educations, missing := inRequestOrder(ids, educations, func(education *portfolio_grpc.Education) int64 {
+		return education.Id
+	})
+
+	return educations, missing, nil
+}
+
+func (e *educationsRepositoryImpl) CreateEducation(ctx context.Context, education *portfolio_grpc.Education) (*portfolio_grpc.Education, error) {
+	if err := checkDateRange(education.StartedAt, education.EndedAt); err != nil {
+		return nil, err
+	}
+
+	visibility, err := visibilityColumn(education.Visibility)
+	if err != nil {
+		return nil, err
+	}
+
+	query := fmt.Sprintf(`
+		INSERT INTO %s (
+			title, institution_name, institution_url,
+			started_at, finished_at, visibility, publish_at, created_at, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, NOW(), NOW())
+		RETURNING %s`, e.tableName, e.columns.sql())
+
+	institution := education.GetInstitution()
+	row := e.db.QueryRowContext(ctx, query,
+		education.Title,
+		institution.GetName(),
+		institution.GetUrl(),
+		utils.ProtoDateToTime(education.StartedAt),
+		utils.ProtoDateToTime(education.EndedAt),
+		visibility,
+		utils.ProtoTimestampToTime(education.PublishAt),
+	)
+
+	return e.decodeEducation(row, e.columns)
+}
+
+func (e *educationsRepositoryImpl) UpdateEducation(ctx context.Context, education *portfolio_grpc.Education, paths []string) (*portfolio_grpc.Education, error) {
+	return revise(ctx, e.db, "education", education.Id, revisionUpdate,
+		func(tx *sql.Tx) (*portfolio_grpc.Education, error) {
+			return e.lockEducation(ctx, tx, education.Id, false)
+		},
+		func(tx *sql.Tx) (*portfolio_grpc.Education, error) {
+			return e.writeEducation(ctx, tx, education, paths)
+		},
+	)
+}
+
+func (e *educationsRepositoryImpl) DeleteEducation(ctx context.Context, id int) error {
+	_, err := revise(ctx, e.db, "education", int64(id), revisionDelete,
+		func(tx *sql.Tx) (*portfolio_grpc.Education, error) {
+			return e.lockEducation(ctx, tx, int64(id), false)
+		},
+		func(tx *sql.Tx) (*portfolio_grpc.Education, error) {
+			query := fmt.Sprintf("UPDATE %s SET deleted_at = NOW() WHERE id = $1", e.tableName)
+			_, err := tx.ExecContext(ctx, query, id)
+			return nil, err
+		},
 	)
+
+	return err
+}
+
+func (e *educationsRepositoryImpl) UndeleteEducation(ctx context.Context, id int) (*portfolio_grpc.Education, error) {
+	return revise(ctx, e.db, "education", int64(id), revisionUndelete,
+		func(tx *sql.Tx) (*portfolio_grpc.Education, error) {
+			return e.lockEducation(ctx, tx, int64(id), true)
+		},
+		func(tx *sql.Tx) (*portfolio_grpc.Education, error) {
+			query := fmt.Sprintf(`
+				UPDATE %s
+				SET deleted_at = NULL
+				WHERE id = $1
+				RETURNING %s`, e.tableName, e.columns.sql())
+
+			return e.decodeEducation(tx.QueryRowContext(ctx, query, id), e.columns)
+		},
+	)
+}
+
+func (e *educationsRepositoryImpl) ListEducationRevisions(ctx context.Context, educationID int, params ListRevisionsParams) ([]*portfolio_grpc.Revision, string, error) {
+	return listRevisions(ctx, e.db, "education", int64(educationID), params, func(revision *portfolio_grpc.Revision, snapshot []byte) error {
+		education := new(portfolio_grpc.Education)
+		if err := unmarshalSnapshot(snapshot, education); err != nil {
+			return err
+		}
+
+		revision.Snapshot = &portfolio_grpc.Revision_Education{Education: education}
+		return nil
+	})
+}
+
+func (e *educationsRepositoryImpl) RestoreEducationRevision(ctx context.Context, educationID int, revisionID int64) (*portfolio_grpc.Education, error) {
+	return revise(ctx, e.db, "education", int64(educationID), revisionRestore,
+		func(tx *sql.Tx) (*portfolio_grpc.Education, error) {
+			return e.lockEducation(ctx, tx, int64(educationID), false)
+		},
+		func(tx *sql.Tx) (*portfolio_grpc.Education, error) {
+			snapshot := new(portfolio_grpc.Education)
+			if err := loadRevision(ctx, tx, "education", int64(educationID), revisionID, snapshot); err != nil {
+				return nil, err
+			}
+
+			snapshot.Id = int64(educationID)
+			return e.writeEducation(ctx, tx, snapshot, nil)
+		},
+	)
+}
+
+func (e *educationsRepositoryImpl) PurgeDeletedEducations(ctx context.Context, before time.Time) (int64, error) {
+	return purgeDeleted(ctx, e.db, e.tableName, "education", before)
+}
+
+func (e *educationsRepositoryImpl) ListEducationsPublishedSince(ctx context.Context, since time.Time) ([]int64, time.Time, error) {
+	return listPublishedSince(ctx, e.db, e.tableName, since)
+}
+
+// lockEducation reads the education with the given id and locks it until
+// tx ends. Deleted selects whether a deleted or a live education is
+// expected.
+func (e *educationsRepositoryImpl) lockEducation(ctx context.Context, tx *sql.Tx, id int64, deleted bool) (*portfolio_grpc.Education, error) {
+	condition := "deleted_at IS NULL"
+	if deleted {
+		condition = "deleted_at IS NOT NULL"
+	}
+
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM %s
+		WHERE id = $1 AND %s
+		FOR UPDATE`, e.columns.sql(), e.tableName, condition)
+
+	return e.decodeEducation(tx.QueryRowContext(ctx, query, id), e.columns)
+}
+
+// writeEducation updates the fields of education selected by paths within tx.
+func (e *educationsRepositoryImpl) writeEducation(ctx context.Context, tx *sql.Tx, education *portfolio_grpc.Education, paths []string) (*portfolio_grpc.Education, error) {
+	visibility, err := visibilityColumn(education.Visibility)
+	if err != nil {
+		return nil, err
+	}
+
+	institution := education.GetInstitution()
+	setClause, args, err := buildSetClause(educationsUpdateFields, paths, map[string]any{
+		"title":            education.Title,
+		"institution_name": institution.GetName(),
+		"institution_url":  institution.GetUrl(),
+		"started_at":       utils.ProtoDateToTime(education.StartedAt),
+		"finished_at":      utils.ProtoDateToTime(education.EndedAt),
+		"visibility":       visibility,
+		"publish_at":       utils.ProtoTimestampToTime(education.PublishAt),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	args = append(args, education.Id)
+	query := fmt.Sprintf(`
+		UPDATE %s
+		SET %s
+		WHERE id = $%d
+		RETURNING %s`, e.tableName, setClause, len(args), e.columns.sql())
+
+	// A partial update may only touch one end of the date range, so the
+	// range is checked against the stored row before committing.
+	updated, err := e.decodeEducation(tx.QueryRowContext(ctx, query, args...), e.columns)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkDateRange(updated.StartedAt, updated.EndedAt); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func (e *educationsRepositoryImpl) decodeEducation(row rowScanner, columns columnSet[pgEducation]) (*portfolio_grpc.Education, error) {
+	edu, err := columns.scan(row)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, ErrEducationNotFound
@@ -147,3 +459,18 @@ func (e *educationsRepositoryImpl) decodeEducation(row rowScanner) (*portfolio_g
 
 	return edu.toProto(), nil
 }
+
+func (e *educationsRepositoryImpl) localizeEducations(ctx context.Context, educations []*portfolio_grpc.Education) error {
+	items := make([]translatable, len(educations))
+	for i, education := range educations {
+		items[i] = translatable{
+			id: education.Id,
+			fields: map[string]*string{
+				"title": &education.Title,
+			},
+			locales: &education.AvailableLocales,
+		}
+	}
+
+	return localize(ctx, e.db, e.negotiator, "education", items)
+}
@@ -2,6 +2,7 @@ package repositories
 
 import (
 	"context"
+	"time"
 
 	"github.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc"
 	"github.com/jorgejr568/portfolio-grpc/internal/client/statsd"
@@ -12,25 +13,25 @@ type educationsMetricsRepositoryImpl struct {
 	statsd statsd.Client
 }
 
-func (e *educationsMetricsRepositoryImpl) ListEducations(ctx context.Context) ([]*portfolio_grpc.Education, error) {
+func (e *educationsMetricsRepositoryImpl) ListEducations(ctx context.Context, params ListEducationsParams) ([]*portfolio_grpc.Education, string, error) {
 	stat := e.statsd.Start("educations", "ListEducations")
 	defer stat.Finished()
 
-	educations, err := e.repo.ListEducations(ctx)
+	educations, nextPageToken, err := e.repo.ListEducations(ctx, params)
 	if err != nil {
 		stat.FailedWithError(err)
-		return nil, err
+		return nil, "", err
 	}
 
 	stat.Succeeded()
-	return educations, nil
+	return educations, nextPageToken, nil
 }
 
-func (e *educationsMetricsRepositoryImpl) GetEducation(ctx context.Context, id int) (*portfolio_grpc.Education, error) {
+func (e *educationsMetricsRepositoryImpl) GetEducation(ctx context.Context, id int, readMask []string, showDeleted, showUnpublished bool) (*portfolio_grpc.Education, error) {
 	stat := e.statsd.Start("educations", "GetEducation")
 	defer stat.Finished()
 
-	education, err := e.repo.GetEducation(ctx, id)
+	education, err := e.repo.GetEducation(ctx, id, readMask, showDeleted, showUnpublished)
 	if err != nil {
 		stat.FailedWithError(err)
 		return nil, err
@@ -40,6 +41,132 @@ func (e *educationsMetricsRepositoryImpl) GetEducation(ctx context.Context, id i
 	return education, nil
 }
 
+func (e *educationsMetricsRepositoryImpl) BatchGetEducations(ctx context.Context, ids []int64, readMask []string) ([]*portfolio_grpc.Education, []int64, error) {
+	stat := e.statsd.Start("educations", "BatchGetEducations")
+	defer stat.Finished()
+
+	educations, missing, err := e.repo.BatchGetEducations(ctx, ids, readMask)
+	if err != nil {
+		stat.FailedWithError(err)
+		return nil, nil, err
+	}
+
+	stat.Succeeded()
+	return educations, missing, nil
+}
+
+func (e *educationsMetricsRepositoryImpl) CreateEducation(ctx context.Context, education *portfolio_grpc.Education) (*portfolio_grpc.Education, error) {
+	stat := e.statsd.Start("educations", "CreateEducation")
+	defer stat.Finished()
+
+	created, err := e.repo.CreateEducation(ctx, education)
+	if err != nil {
+		stat.FailedWithError(err)
+		return nil, err
+	}
+
+	stat.Succeeded()
+	return created, nil
+}
+
+func (e *educationsMetricsRepositoryImpl) UpdateEducation(ctx context.Context, education *portfolio_grpc.Education, paths []string) (*portfolio_grpc.Education, error) {
+	stat := e.statsd.Start("educations", "UpdateEducation")
+	defer stat.Finished()
+
+	updated, err := e.repo.UpdateEducation(ctx, education, paths)
+	if err != nil {
+		stat.FailedWithError(err)
+		return nil, err
+	}
+
+	stat.Succeeded()
+	return updated, nil
+}
+
+func (e *educationsMetricsRepositoryImpl) DeleteEducation(ctx context.Context, id int) error {
+	stat := e.statsd.Start("educations", "DeleteEducation")
+	defer stat.Finished()
+
+	err := e.repo.DeleteEducation(ctx, id)
+	if err != nil {
+		stat.FailedWithError(err)
+		return err
+	}
+
+	stat.Succeeded()
+	return nil
+}
+
+func (e *educationsMetricsRepositoryImpl) UndeleteEducation(ctx context.Context, id int) (*portfolio_grpc.Education, error) {
+	stat := e.statsd.Start("educations", "UndeleteEducation")
+	defer stat.Finished()
+
+	restored, err := e.repo.UndeleteEducation(ctx, id)
+	if err != nil {
+		stat.FailedWithError(err)
+		return nil, err
+	}
+
+	stat.Succeeded()
+	return restored, nil
+}
+
+func (e *educationsMetricsRepositoryImpl) PurgeDeletedEducations(ctx context.Context, before time.Time) (int64, error) {
+	stat := e.statsd.Start("educations", "PurgeDeletedEducations")
+	defer stat.Finished()
+
+	purged, err := e.repo.PurgeDeletedEducations(ctx, before)
+	if err != nil {
+		stat.FailedWithError(err)
+		return 0, err
+	}
+
+	stat.Succeeded()
+	return purged, nil
+}
+
+func (e *educationsMetricsRepositoryImpl) ListEducationsPublishedSince(ctx context.Context, since time.Time) ([]int64, time.Time, error) {
+	stat := e.statsd.Start("educations", "ListEducationsPublishedSince")
+	defer stat.Finished()
+
+	ids, checkedAt, err := e.repo.ListEducationsPublishedSince(ctx, since)
+	if err != nil {
+		stat.FailedWithError(err)
+		return nil, time.Time{}, err
+	}
+
+	stat.Succeeded()
+	return ids, checkedAt, nil
+}
+
+func (e *educationsMetricsRepositoryImpl) ListEducationRevisions(ctx context.Context, educationID int, params ListRevisionsParams) ([]*portfolio_grpc.Revision, string, error) {
+	stat := e.statsd.Start("educations", "ListEducationRevisions")
+	defer stat.Finished()
+
+	revisions, nextPageToken, err := e.repo.ListEducationRevisions(ctx, educationID, params)
+	if err != nil {
+		stat.FailedWithError(err)
+		return nil, "", err
+	}
+
+	stat.Succeeded()
+	return revisions, nextPageToken, nil
+}
+
+func (e *educationsMetricsRepositoryImpl) RestoreEducationRevision(ctx context.Context, educationID int, revisionID int64) (*portfolio_grpc.Education, error) {
+	stat := e.statsd.Start("educations", "RestoreEducationRevision")
+	defer stat.Finished()
+
+	restored, err := e.repo.RestoreEducationRevision(ctx, educationID, revisionID)
+	if err != nil {
+		stat.FailedWithError(err)
+		return nil, err
+	}
+
+	stat.Succeeded()
+	return restored, nil
+}
+
 func newEducationsMetricsRepository(repo EducationsRepository, statsdClient statsd.Client) EducationsRepository {
 	return &educationsMetricsRepositoryImpl{
 		repo:   repo,
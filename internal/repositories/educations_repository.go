@@ -3,19 +3,68 @@ package repositories
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc"
 	"github.com/jorgejr568/portfolio-grpc/internal/client/statsd"
+	"github.com/jorgejr568/portfolio-grpc/internal/locale"
 )
 
 type EducationsRepository interface {
-	ListEducations(ctx context.Context) ([]*portfolio_grpc.Education, error)
-	GetEducation(ctx context.Context, id int) (*portfolio_grpc.Education, error)
+	// ListEducations returns a page of educations and the token of the next
+	// page, which is empty on the last page.
+	ListEducations(ctx context.Context, params ListEducationsParams) ([]*portfolio_grpc.Education, string, error)
+	// GetEducation returns the education with the given id. Deleted educations
+	// are only returned when showDeleted is set and educations that are not
+	// public when showUnpublished is.
+	GetEducation(ctx context.Context, id int, readMask []string, showDeleted, showUnpublished bool) (*portfolio_grpc.Education, error)
+	// BatchGetEducations returns the educations with the given ids in the
+	// order of ids, along with the ids that were not found. Deleted educations
+	// and educations that are not public count as not found.
+	BatchGetEducations(ctx context.Context, ids []int64, readMask []string) ([]*portfolio_grpc.Education, []int64, error)
+	CreateEducation(ctx context.Context, education *portfolio_grpc.Education) (*portfolio_grpc.Education, error)
+	// UpdateEducation updates the fields of education selected by paths.
+	// Empty paths update every mutable field.
+	UpdateEducation(ctx context.Context, education *portfolio_grpc.Education, paths []string) (*portfolio_grpc.Education, error)
+	// DeleteEducation marks the education as deleted. Deleted educations are
+	// hidden until restored by UndeleteEducation or removed by
+	// PurgeDeletedEducations.
+	DeleteEducation(ctx context.Context, id int) error
+	UndeleteEducation(ctx context.Context, id int) (*portfolio_grpc.Education, error)
+	// PurgeDeletedEducations permanently removes the educations deleted before
+	// the given time and returns how many were removed.
+	PurgeDeletedEducations(ctx context.Context, before time.Time) (int64, error)
+	// ListEducationsPublishedSince returns the ids of the public educations
+	// whose publish_at passed after since, along with the database time they
+	// were checked at, which is the since of the next call.
+	ListEducationsPublishedSince(ctx context.Context, since time.Time) ([]int64, time.Time, error)
+	// ListEducationRevisions returns a page of the revisions of an education,
+	// newest first, and the token of the next page.
+	ListEducationRevisions(ctx context.Context, educationID int, params ListRevisionsParams) ([]*portfolio_grpc.Revision, string, error)
+	// RestoreEducationRevision writes an education back as it was before the
+	// revision, recording the replaced state as a new revision.
+	RestoreEducationRevision(ctx context.Context, educationID int, revisionID int64) (*portfolio_grpc.Education, error)
 }
 
-func NewEducationsRepository(db *sql.DB, client statsd.Client) EducationsRepository {
+type ListEducationsParams struct {
+	// Filter is an AIP-160 filter, see compileFilter.
+	Filter string
+	// OrderBy is an AIP-132 order_by such as "started_at desc".
+	OrderBy   string
+	PageSize  int
+	PageToken string
+	// ReadMask limits the fields read. Empty reads every field.
+	ReadMask []string
+	// ShowDeleted includes deleted rows.
+	ShowDeleted bool
+	// ShowUnpublished includes drafts, archived rows and rows whose
+	// publish_at has not passed yet.
+	ShowUnpublished bool
+}
+
+func NewEducationsRepository(db *sql.DB, client statsd.Client, negotiator *locale.Negotiator) EducationsRepository {
 	return newEducationsMetricsRepository(
-		newEducationsDBRepository(db),
+		newEducationsDBRepository(db, negotiator),
 		client,
 	)
 }
@@ -3,45 +3,54 @@ package repositories
 import (
 	"context"
 	"database/sql"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
 	"time"
 
 	"github.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc"
+	"github.com/jorgejr568/portfolio-grpc/internal/locale"
 	"github.com/jorgejr568/portfolio-grpc/internal/utils"
+	"github.com/lib/pq"
 )
 
 const (
-	experiencesTableName = "experiences"
+	experiencesTableName      = "experiences"
+	experienceSkillsTableName = "experience_skills"
 )
 
 var (
 	ErrExperienceNotFound = errors.New("experience not found")
 )
 
-func newExperiencesDBRepository(db *sql.DB) ExperiencesRepository {
+func newExperiencesDBRepository(db *sql.DB, negotiator *locale.Negotiator) ExperiencesRepository {
 	return &experiencesRepositoryImpl{
-		db:        db,
-		tableName: experiencesTableName,
-		selectColumns: strings.Join([]string{
-			"id",
-			"title",
-			"description",
-			"company_name",
-			"company_url",
-			"company_logo_url",
-			"languages",
-			"frameworks",
-			"started_at",
-			"finished_at as ended_at",
-			"created_at",
-			"updated_at",
-		}, ","),
+		db:         db,
+		negotiator: negotiator,
+		tableName:  experiencesTableName,
+		columns:    experiencesColumns,
 	}
 }
 
+var experiencesColumns = columnSet[pgExperience]{
+	{expr: "id", paths: []string{"id"}, dest: func(p *pgExperience) any { return &p.ID }},
+	{expr: "title", paths: []string{"title"}, dest: func(p *pgExperience) any { return &p.Title }},
+	{expr: "description", paths: []string{"description"}, dest: func(p *pgExperience) any { return &p.Description }},
+	{expr: "company_name", paths: []string{"company.name"}, dest: func(p *pgExperience) any { return &p.CompanyName }},
+	{expr: "company_url", paths: []string{"company.url"}, dest: func(p *pgExperience) any { return &p.CompanyURL }},
+	{expr: "company_logo_url", paths: []string{"company.logo_url"}, dest: func(p *pgExperience) any { return &p.CompanyLogo }},
+	{expr: "languages", paths: []string{"technologies", "technology_details"}, dest: func(p *pgExperience) any { return &p.Languages }},
+	{expr: "frameworks", paths: []string{"technologies", "technology_details"}, dest: func(p *pgExperience) any { return &p.Frameworks }},
+	{expr: "tools", paths: []string{"technologies", "technology_details"}, dest: func(p *pgExperience) any { return &p.Tools }},
+	{expr: "started_at", paths: []string{"started_at"}, dest: func(p *pgExperience) any { return &p.StartedAt }},
+	{expr: "finished_at as ended_at", paths: []string{"ended_at"}, dest: func(p *pgExperience) any { return &p.EndedAt }},
+	{expr: "created_at", paths: []string{"created_at"}, dest: func(p *pgExperience) any { return &p.CreatedAt }},
+	{expr: "updated_at", paths: []string{"updated_at"}, dest: func(p *pgExperience) any { return &p.UpdatedAt }},
+	{expr: "deleted_at", paths: []string{"deleted_at"}, dest: func(p *pgExperience) any { return &p.DeletedAt }},
+	{expr: "visibility", paths: []string{"visibility"}, dest: func(p *pgExperience) any { return &p.Visibility }},
+	{expr: "publish_at", paths: []string{"publish_at"}, dest: func(p *pgExperience) any { return &p.PublishAt }},
+}
+
 type pgExperience struct {
 	ID          int64
 	Title       string
@@ -51,19 +60,41 @@ type pgExperience struct {
 	CompanyLogo string
 	Languages   string
 	Frameworks  string
+	Tools       string
 	StartedAt   *time.Time
 	EndedAt     *time.Time
 	CreatedAt   *time.Time
 	UpdatedAt   *time.Time
+	DeletedAt   *time.Time
+	Visibility  string
+	PublishAt   *time.Time
+}
+
+var experiencesUpdateFields = []updateField{
+	{path: "title", columns: []string{"title"}},
+	{path: "description", columns: []string{"description"}},
+	{path: "company", columns: []string{"company_name", "company_url", "company_logo_url"}},
+	{path: "company.name", columns: []string{"company_name"}},
+	{path: "company.url", columns: []string{"company_url"}},
+	{path: "company.logo_url", columns: []string{"company_logo_url"}},
+	{path: "technologies", columns: []string{"languages", "frameworks", "tools"}},
+	{path: "technology_details", columns: []string{"languages", "frameworks", "tools"}},
+	{path: "started_at", columns: []string{"started_at"}},
+	{path: "ended_at", columns: []string{"finished_at"}},
+	{path: "visibility", columns: []string{"visibility"}},
+	{path: "publish_at", columns: []string{"publish_at"}},
 }
 
-func (p *pgExperience) toProto() *portfolio_grpc.Experience {
+func (p *pgExperience) toProto() (*portfolio_grpc.Experience, error) {
 	exp := &portfolio_grpc.Experience{
 		Id:          p.ID,
 		Title:       p.Title,
 		Description: p.Description,
 		CreatedAt:   utils.TimeToProtoTimestamp(p.CreatedAt),
 		UpdatedAt:   utils.TimeToProtoTimestamp(p.UpdatedAt),
+		DeletedAt:   utils.TimeToProtoTimestamp(p.DeletedAt),
+		Visibility:  visibilities[p.Visibility],
+		PublishAt:   utils.TimeToProtoTimestamp(p.PublishAt),
 	}
 
 	exp.Company = &portfolio_grpc.Experience_Company{
@@ -72,31 +103,360 @@ func (p *pgExperience) toProto() *portfolio_grpc.Experience {
 		LogoUrl: p.CompanyLogo,
 	}
 
-	var langs, frameworks []string
-	_ = json.Unmarshal([]byte(p.Languages), &langs)
-	_ = json.Unmarshal([]byte(p.Frameworks), &frameworks)
+	columns := []struct {
+		name string
+		raw  string
+		kind portfolio_grpc.Experience_Technology_Kind
+	}{
+		{"languages", p.Languages, portfolio_grpc.Experience_Technology_KIND_LANGUAGE},
+		{"frameworks", p.Frameworks, portfolio_grpc.Experience_Technology_KIND_FRAMEWORK},
+		{"tools", p.Tools, portfolio_grpc.Experience_Technology_KIND_TOOL},
+	}
+	for _, column := range columns {
+		names, err := decodeJSONList(column.raw)
+		if err != nil {
+			return nil, fmt.Errorf("experience %d has malformed %s: %w", p.ID, column.name, err)
+		}
 
-	exp.Technologies = append(exp.Technologies, langs...)
+		for _, name := range names {
+			exp.Technologies = append(exp.Technologies, name)
+			exp.TechnologyDetails = append(exp.TechnologyDetails, &portfolio_grpc.Experience_Technology{
+				Name: name,
+				Kind: column.kind,
+			})
+		}
+	}
 
 	exp.StartedAt = utils.TimeToProtoDate(p.StartedAt)
 	exp.EndedAt = utils.TimeToProtoDate(p.EndedAt)
-	return exp
+	return exp, nil
 }
 
 type experiencesRepositoryImpl struct {
-	db            *sql.DB
-	tableName     string
-	selectColumns string
+	db         *sql.DB
+	negotiator *locale.Negotiator
+	tableName  string
+	columns    columnSet[pgExperience]
+}
+
+var experiencesFilterFields = map[string]filterField{
+	"id":           {column: "e.id", kind: filterInt},
+	"title":        {column: "e.title", kind: filterString},
+	"description":  {column: "e.description", kind: filterString},
+	"company.name": {column: "e.company_name", kind: filterString},
+	"technologies": {column: "(e.languages::jsonb || e.frameworks::jsonb || e.tools::jsonb)", kind: filterList},
+	"started_at":   {column: "e.started_at", kind: filterDate},
+	"ended_at":     {column: "e.finished_at", kind: filterDate},
+	"created_at":   {column: "e.created_at", kind: filterTimestamp},
+	"updated_at":   {column: "e.updated_at", kind: filterTimestamp},
+}
+
+var experiencesSortFields = map[string]sortField[*portfolio_grpc.Experience]{
+	"id": {column: "e.id", cast: "bigint", value: func(experience *portfolio_grpc.Experience) string {
+		return formatIDKey(experience.Id)
+	}},
+	"title": {column: "e.title", cast: "text", value: func(experience *portfolio_grpc.Experience) string {
+		return experience.Title
+	}},
+	"started_at": {column: "e.started_at", cast: "date", value: func(experience *portfolio_grpc.Experience) string {
+		return formatDateKey(experience.StartedAt)
+	}},
+	"created_at": {column: "e.created_at", cast: "timestamptz", value: func(experience *portfolio_grpc.Experience) string {
+		return formatTimestampKey(experience.CreatedAt)
+	}},
+	"updated_at": {column: "e.updated_at", cast: "timestamptz", value: func(experience *portfolio_grpc.Experience) string {
+		return formatTimestampKey(experience.UpdatedAt)
+	}},
+}
+
+func (e *experiencesRepositoryImpl) ListExperiences(ctx context.Context, params ListExperiencesParams) ([]*portfolio_grpc.Experience, string, error) {
+	order, err := parseOrderBy(params.OrderBy, experiencesSortFields, "started_at desc")
+	if err != nil {
+		return nil, "", err
+	}
+
+	fingerprint := queryFingerprint(params.Filter, params.OrderBy, params.ShowDeleted, params.ShowUnpublished)
+	token, err := decodePageToken(params.PageToken, fingerprint, order.keys)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var args queryArgs
+	filter, err := compileFilter(params.Filter, experiencesFilterFields, &args)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var conditions []string
+	if !params.ShowDeleted {
+		conditions = append(conditions, "e.deleted_at IS NULL")
+	}
+
+	if !params.ShowUnpublished {
+		conditions = append(conditions, publishedCondition("e."))
+	}
+
+	if filter != "" {
+		conditions = append(conditions, filter)
+	}
+
+	if token != nil {
+		conditions = append(conditions, keysetCondition(&args, order.keys, token.Keys))
+	}
+
+	columns := e.columns.selection(params.ReadMask, order.fields...)
+	pageSize := normalizePageSize(params.PageSize)
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM %s e
+		%s
+		%s
+		LIMIT %s`, columns.sql(), e.tableName, whereClause(conditions), orderByClause(order.keys), args.bind(pageSize+1))
+
+	rows, err := e.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	experiences := make([]*portfolio_grpc.Experience, 0)
+	for rows.Next() {
+		experience, err := e.decodeExperience(rows, columns)
+		if err != nil {
+			return nil, "", err
+		}
+		experiences = append(experiences, experience)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	experiences, nextPageToken := paginate(experiences, pageSize, fingerprint, order.keyValues)
+
+	// Localized after paginating so page tokens keep the stored values.
+	if err := e.localizeExperiences(ctx, experiences); err != nil {
+		return nil, "", err
+	}
+
+	return experiences, nextPageToken, nil
+}
+
+func (e *experiencesRepositoryImpl) GetExperience(ctx context.Context, id int, readMask []string, showDeleted, showUnpublished bool) (*portfolio_grpc.Experience, error) {
+	columns := e.columns.selection(readMask, "id")
+
+	conditions := []string{"id = $1"}
+	if !showDeleted {
+		conditions = append(conditions, "deleted_at IS NULL")
+	}
+
+	if !showUnpublished {
+		conditions = append(conditions, publishedCondition(""))
+	}
+
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM %s
+		%s`, columns.sql(), e.tableName, whereClause(conditions))
+
+	row := e.db.QueryRowContext(ctx, query, id)
+
+	experience, err := e.decodeExperience(row, columns)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := e.localizeExperiences(ctx, []*portfolio_grpc.Experience{experience}); err != nil {
+		return nil, err
+	}
+
+	return experience, nil
+}
+
+func (e *experiencesRepositoryImpl) BatchGetExperiences(ctx context.Context, ids []int64, readMask []string) ([]*portfolio_grpc.Experience, []int64, error) {
+	columns := e.columns.selection(readMask, "id")
+
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM %s
+		WHERE id = ANY($1) AND deleted_at IS NULL AND %s`, columns.sql(), e.tableName, publishedCondition(""))
+
+	rows, err := e.db.QueryContext(ctx, query, pq.Array(ids))
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	experiences := make([]*portfolio_grpc.Experience, 0, len(ids))
+	for rows.Next() {
+		experience, err := e.decodeExperience(rows, columns)
+		if err != nil {
+			return nil, nil, err
+		}
+		experiences = append(experiences, experience)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	if err := e.localizeExperiences(ctx, experiences); err != nil {
+		return nil, nil, err
+	}
+
+	experiences, missing := inRequestOrder(ids, experiences, func(experience *portfolio_grpc.Experience) int64 {
+		return experience.Id
+	})
+
+	return experiences, missing, nil
+}
+
+func (e *experiencesRepositoryImpl) CreateExperience(ctx context.Context, experience *portfolio_grpc.Experience) (*portfolio_grpc.Experience, error) {
+	if err := checkDateRange(experience.StartedAt, experience.EndedAt); err != nil {
+		return nil, err
+	}
+
+	technologies, err := encodeTechnologies(experience, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	visibility, err := visibilityColumn(experience.Visibility)
+	if err != nil {
+		return nil, err
+	}
+
+	query := fmt.Sprintf(`
+		INSERT INTO %s (
+			title, description, company_name, company_url, company_logo_url,
+			languages, frameworks, tools, started_at, finished_at,
+			visibility, publish_at, created_at, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, NOW(), NOW())
+		RETURNING %s`, e.tableName, e.columns.sql())
+
+	company := experience.GetCompany()
+	row := e.db.QueryRowContext(ctx, query,
+		experience.Title,
+		experience.Description,
+		company.GetName(),
+		company.GetUrl(),
+		company.GetLogoUrl(),
+		technologies.languages,
+		technologies.frameworks,
+		technologies.tools,
+		utils.ProtoDateToTime(experience.StartedAt),
+		utils.ProtoDateToTime(experience.EndedAt),
+		visibility,
+		utils.ProtoTimestampToTime(experience.PublishAt),
+	)
+
+	return e.decodeExperience(row, e.columns)
+}
+
+func (e *experiencesRepositoryImpl) UpdateExperience(ctx context.Context, experience *portfolio_grpc.Experience, paths []string) (*portfolio_grpc.Experience, error) {
+	var prior *portfolio_grpc.Experience
+	return revise(ctx, e.db, "experience", experience.Id, revisionUpdate,
+		func(tx *sql.Tx) (*portfolio_grpc.Experience, error) {
+			var err error
+			prior, err = e.lockExperience(ctx, tx, experience.Id, false)
+			return prior, err
+		},
+		func(tx *sql.Tx) (*portfolio_grpc.Experience, error) {
+			return e.writeExperience(ctx, tx, experience, paths, prior.TechnologyDetails)
+		},
+	)
+}
+
+func (e *experiencesRepositoryImpl) DeleteExperience(ctx context.Context, id int) error {
+	_, err := revise(ctx, e.db, "experience", int64(id), revisionDelete,
+		func(tx *sql.Tx) (*portfolio_grpc.Experience, error) {
+			return e.lockExperience(ctx, tx, int64(id), false)
+		},
+		func(tx *sql.Tx) (*portfolio_grpc.Experience, error) {
+			query := fmt.Sprintf("UPDATE %s SET deleted_at = NOW() WHERE id = $1", e.tableName)
+			_, err := tx.ExecContext(ctx, query, id)
+			return nil, err
+		},
+	)
+
+	return err
+}
+
+func (e *experiencesRepositoryImpl) UndeleteExperience(ctx context.Context, id int) (*portfolio_grpc.Experience, error) {
+	return revise(ctx, e.db, "experience", int64(id), revisionUndelete,
+		func(tx *sql.Tx) (*portfolio_grpc.Experience, error) {
+			return e.lockExperience(ctx, tx, int64(id), true)
+		},
+		func(tx *sql.Tx) (*portfolio_grpc.Experience, error) {
+			query := fmt.Sprintf(`
+				UPDATE %s
+				SET deleted_at = NULL
+				WHERE id = $1
+				RETURNING %s`, e.tableName, e.columns.sql())
+
+			return e.decodeExperience(tx.QueryRowContext(ctx, query, id), e.columns)
+		},
+	)
+}
+
+func (e *experiencesRepositoryImpl) ListExperienceRevisions(ctx context.Context, experienceID int, params ListRevisionsParams) ([]*portfolio_grpc.Revision, string, error) {
+	return listRevisions(ctx, e.db, "experience", int64(experienceID), params, func(revision *portfolio_grpc.Revision, snapshot []byte) error {
+		experience := new(portfolio_grpc.Experience)
+		if err := unmarshalSnapshot(snapshot, experience); err != nil {
+			return err
+		}
+
+		revision.Snapshot = &portfolio_grpc.Revision_Experience{Experience: experience}
+		return nil
+	})
+}
+
+func (e *experiencesRepositoryImpl) RestoreExperienceRevision(ctx context.Context, experienceID int, revisionID int64) (*portfolio_grpc.Experience, error) {
+	return revise(ctx, e.db, "experience", int64(experienceID), revisionRestore,
+		func(tx *sql.Tx) (*portfolio_grpc.Experience, error) {
+			return e.lockExperience(ctx, tx, int64(experienceID), false)
+		},
+		func(tx *sql.Tx) (*portfolio_grpc.Experience, error) {
+			snapshot := new(portfolio_grpc.Experience)
+			if err := loadRevision(ctx, tx, "experience", int64(experienceID), revisionID, snapshot); err != nil {
+				return nil, err
+			}
+
+			snapshot.Id = int64(experienceID)
+			return e.writeExperience(ctx, tx, snapshot, nil, nil)
+		},
+	)
+}
+
+func (e *experiencesRepositoryImpl) PurgeDeletedExperiences(ctx context.Context, before time.Time) (int64, error) {
+	return purgeDeleted(ctx, e.db, e.tableName, "experience", before)
+}
+
+func (e *experiencesRepositoryImpl) ListExperiencesPublishedSince(ctx context.Context, since time.Time) ([]int64, time.Time, error) {
+	return listPublishedSince(ctx, e.db, e.tableName, since)
 }
 
-func (e *experiencesRepositoryImpl) ListExperiences(ctx context.Context) ([]*portfolio_grpc.Experience, error) {
+// experienceSummaryPaths are the fields ListExperiencesBySkill reads.
+var experienceSummaryPaths = []string{"id", "title", "company.name", "started_at", "ended_at"}
+
+func (e *experiencesRepositoryImpl) ListExperiencesBySkill(ctx context.Context, skillID int, showUnpublished bool) ([]*portfolio_grpc.Experience, error) {
+	conditions := []string{
+		fmt.Sprintf("e.id IN (SELECT experience_id FROM %s WHERE skill_id = $1)", experienceSkillsTableName),
+		"e.deleted_at IS NULL",
+	}
+	if !showUnpublished {
+		conditions = append(conditions, publishedCondition("e."))
+	}
+
+	columns := e.columns.selection(experienceSummaryPaths)
 	query := fmt.Sprintf(`
 		SELECT %s
 		FROM %s e
-		ORDER BY e.started_at DESC
-		LIMIT 1000`, e.selectColumns, e.tableName)
+		%s
+		ORDER BY e.started_at DESC, e.id DESC`, columns.sql(), e.tableName, whereClause(conditions))
 
-	rows, err := e.db.QueryContext(ctx, query)
+	rows, err := e.db.QueryContext(ctx, query, skillID)
 	if err != nil {
 		return nil, err
 	}
@@ -104,7 +464,7 @@ func (e *experiencesRepositoryImpl) ListExperiences(ctx context.Context) ([]*por
 
 	experiences := make([]*portfolio_grpc.Experience, 0)
 	for rows.Next() {
-		experience, err := e.decodeExperience(rows)
+		experience, err := e.decodeExperience(rows, columns)
 		if err != nil {
 			return nil, err
 		}
@@ -115,41 +475,87 @@ func (e *experiencesRepositoryImpl) ListExperiences(ctx context.Context) ([]*por
 		return nil, err
 	}
 
+	if err := e.localizeExperiences(ctx, experiences); err != nil {
+		return nil, err
+	}
+
 	return experiences, nil
 }
 
-func (e *experiencesRepositoryImpl) GetExperience(ctx context.Context, id int) (*portfolio_grpc.Experience, error) {
+// lockExperience reads the experience with the given id and locks it until
+// tx ends. Deleted selects whether a deleted or a live experience is
+// expected.
+func (e *experiencesRepositoryImpl) lockExperience(ctx context.Context, tx *sql.Tx, id int64, deleted bool) (*portfolio_grpc.Experience, error) {
+	condition := "deleted_at IS NULL"
+	if deleted {
+		condition = "deleted_at IS NOT NULL"
+	}
+
 	query := fmt.Sprintf(`
 		SELECT %s
 		FROM %s
-		WHERE id = $1 LIMIT 1;`, e.selectColumns, e.tableName)
+		WHERE id = $1 AND %s
+		FOR UPDATE`, e.columns.sql(), e.tableName, condition)
 
-	row := e.db.QueryRowContext(ctx, query, id)
+	return e.decodeExperience(tx.QueryRowContext(ctx, query, id), e.columns)
+}
 
-	experience, err := e.decodeExperience(row)
+// writeExperience updates the fields of experience selected by paths within
+// tx. Known are the stored technologies, whose kinds a flat technologies
+// list keeps.
+func (e *experiencesRepositoryImpl) writeExperience(ctx context.Context, tx *sql.Tx, experience *portfolio_grpc.Experience, paths []string, known []*portfolio_grpc.Experience_Technology) (*portfolio_grpc.Experience, error) {
+	technologies, err := encodeTechnologies(experience, known)
 	if err != nil {
 		return nil, err
 	}
 
-	return experience, nil
+	visibility, err := visibilityColumn(experience.Visibility)
+	if err != nil {
+		return nil, err
+	}
+
+	company := experience.GetCompany()
+	setClause, args, err := buildSetClause(experiencesUpdateFields, paths, map[string]any{
+		"title":            experience.Title,
+		"description":      experience.Description,
+		"company_name":     company.GetName(),
+		"company_url":      company.GetUrl(),
+		"company_logo_url": company.GetLogoUrl(),
+		"languages":        technologies.languages,
+		"frameworks":       technologies.frameworks,
+		"tools":            technologies.tools,
+		"started_at":       utils.ProtoDateToTime(experience.StartedAt),
+		"finished_at":      utils.ProtoDateToTime(experience.EndedAt),
+		"visibility":       visibility,
+		"publish_at":       utils.ProtoTimestampToTime(experience.PublishAt),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	args = append(args, experience.Id)
+	query := fmt.Sprintf(`
+		UPDATE %s
+		SET %s
+		WHERE id = $%d
+		RETURNING %s`, e.tableName, setClause, len(args), e.columns.sql())
+
+	// A partial update may only touch one end of the date range, so the
+	// range is checked against the stored row before committing.
+	updated, err := e.decodeExperience(tx.QueryRowContext(ctx, query, args...), e.columns)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkDateRange(updated.StartedAt, updated.EndedAt); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
 }
 
-func (e *experiencesRepositoryImpl) decodeExperience(row rowScanner) (*portfolio_grpc.Experience, error) {
-	exp := new(pgExperience)
-	err := row.Scan(
-		&exp.ID,
-		&exp.Title,
-		&exp.Description,
-		&exp.CompanyName,
-		&exp.CompanyURL,
-		&exp.CompanyLogo,
-		&exp.Languages,
-		&exp.Frameworks,
-		&exp.StartedAt,
-		&exp.EndedAt,
-		&exp.CreatedAt,
-		&exp.UpdatedAt,
-	)
+func (e *experiencesRepositoryImpl) decodeExperience(row rowScanner, columns columnSet[pgExperience]) (*portfolio_grpc.Experience, error) {
+	exp, err := columns.scan(row)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, ErrExperienceNotFound
@@ -157,5 +563,79 @@ func (e *experiencesRepositoryImpl) decodeExperience(row rowScanner) (*portfolio
 		return nil, fmt.Errorf("failed to scan experience: %w", err)
 	}
 
-	return exp.toProto(), nil
+	return exp.toProto()
+}
+
+// pgTechnologies holds the JSON encoded technology columns of an experience.
+type pgTechnologies struct {
+	languages  string
+	frameworks string
+	tools      string
+}
+
+// encodeTechnologies splits the technologies of experience into their JSON
+// columns. Structured technology_details win; otherwise each name of the flat
+// technologies list sent by older clients keeps its kind among known and new
+// names are stored as languages.
+func encodeTechnologies(experience *portfolio_grpc.Experience, known []*portfolio_grpc.Experience_Technology) (pgTechnologies, error) {
+	details := experience.TechnologyDetails
+	if len(details) == 0 {
+		kinds := make(map[string]portfolio_grpc.Experience_Technology_Kind, len(known))
+		for _, technology := range known {
+			kinds[strings.ToLower(technology.Name)] = technology.Kind
+		}
+
+		for _, name := range experience.Technologies {
+			details = append(details, &portfolio_grpc.Experience_Technology{
+				Name: name,
+				Kind: kinds[strings.ToLower(name)],
+			})
+		}
+	}
+
+	var languages, frameworks, tools []string
+	for _, technology := range details {
+		switch technology.Kind {
+		case portfolio_grpc.Experience_Technology_KIND_FRAMEWORK:
+			frameworks = append(frameworks, technology.Name)
+		case portfolio_grpc.Experience_Technology_KIND_TOOL:
+			tools = append(tools, technology.Name)
+		default:
+			languages = append(languages, technology.Name)
+		}
+	}
+
+	var (
+		encoded pgTechnologies
+		err     error
+	)
+	if encoded.languages, err = encodeJSONList(languages); err != nil {
+		return pgTechnologies{}, err
+	}
+
+	if encoded.frameworks, err = encodeJSONList(frameworks); err != nil {
+		return pgTechnologies{}, err
+	}
+
+	if encoded.tools, err = encodeJSONList(tools); err != nil {
+		return pgTechnologies{}, err
+	}
+
+	return encoded, nil
+}
+
+func (e *experiencesRepositoryImpl) localizeExperiences(ctx context.Context, experiences []*portfolio_grpc.Experience) error {
+	items := make([]translatable, len(experiences))
+	for i, experience := range experiences {
+		items[i] = translatable{
+			id: experience.Id,
+			fields: map[string]*string{
+				"title":       &experience.Title,
+				"description": &experience.Description,
+			},
+			locales: &experience.AvailableLocales,
+		}
+	}
+
+	return localize(ctx, e.db, e.negotiator, "experience", items)
 }
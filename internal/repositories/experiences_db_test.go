@@ -0,0 +1,63 @@
+package repositories
+
+import (
+	"testing"
+
+	"github.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc"
+)
+
+func TestEncodeTechnologies(t *testing.T) {
+	known := []*portfolio_grpc.Experience_Technology{
+		{Name: "Go", Kind: portfolio_grpc.Experience_Technology_KIND_LANGUAGE},
+		{Name: "gRPC", Kind: portfolio_grpc.Experience_Technology_KIND_FRAMEWORK},
+		{Name: "Docker", Kind: portfolio_grpc.Experience_Technology_KIND_TOOL},
+	}
+
+	tests := []struct {
+		name       string
+		experience *portfolio_grpc.Experience
+		known      []*portfolio_grpc.Experience_Technology
+		want       pgTechnologies
+	}{
+		{
+			name:       "empty",
+			experience: &portfolio_grpc.Experience{},
+			want:       pgTechnologies{languages: "[]", frameworks: "[]", tools: "[]"},
+		},
+		{
+			name:       "flat list without known kinds",
+			experience: &portfolio_grpc.Experience{Technologies: []string{"Go", "gRPC"}},
+			want:       pgTechnologies{languages: `["Go","gRPC"]`, frameworks: "[]", tools: "[]"},
+		},
+		{
+			name:       "flat list keeps known kinds",
+			experience: &portfolio_grpc.Experience{Technologies: []string{"go", "gRPC", "Docker", "Rust"}},
+			known:      known,
+			want:       pgTechnologies{languages: `["go","Rust"]`, frameworks: `["gRPC"]`, tools: `["Docker"]`},
+		},
+		{
+			name: "details win",
+			experience: &portfolio_grpc.Experience{
+				Technologies: []string{"Go"},
+				TechnologyDetails: []*portfolio_grpc.Experience_Technology{
+					{Name: "Go", Kind: portfolio_grpc.Experience_Technology_KIND_TOOL},
+					{Name: "Python"},
+				},
+			},
+			known: known,
+			want:  pgTechnologies{languages: `["Python"]`, frameworks: "[]", tools: `["Go"]`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := encodeTechnologies(tt.experience, tt.known)
+			if err != nil {
+				t.Fatalf("encodeTechnologies() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("encodeTechnologies() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package repositories
 
 import (
 	"context"
+	"time"
 
 	"github.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc"
 	"github.com/jorgejr568/portfolio-grpc/internal/client/statsd"
@@ -12,25 +13,25 @@ type experiencesMetricsRepositoryImpl struct {
 	statsd statsd.Client
 }
 
-func (e *experiencesMetricsRepositoryImpl) ListExperiences(ctx context.Context) ([]*portfolio_grpc.Experience, error) {
+func (e *experiencesMetricsRepositoryImpl) ListExperiences(ctx context.Context, params ListExperiencesParams) ([]*portfolio_grpc.Experience, string, error) {
 	stat := e.statsd.Start("experiences", "ListExperiences")
 	defer stat.Finished()
 
-	experiences, err := e.repo.ListExperiences(ctx)
+	experiences, nextPageToken, err := e.repo.ListExperiences(ctx, params)
 	if err != nil {
 		stat.FailedWithError(err)
-		return nil, err
+		return nil, "", err
 	}
 
 	stat.Succeeded()
-	return experiences, nil
+	return experiences, nextPageToken, nil
 }
 
-func (e *experiencesMetricsRepositoryImpl) GetExperience(ctx context.Context, id int) (*portfolio_grpc.Experience, error) {
+func (e *experiencesMetricsRepositoryImpl) GetExperience(ctx context.Context, id int, readMask []string, showDeleted, showUnpublished bool) (*portfolio_grpc.Experience, error) {
 	stat := e.statsd.Start("experiences", "GetExperience")
 	defer stat.Finished()
 
-	experience, err := e.repo.GetExperience(ctx, id)
+	experience, err := e.repo.GetExperience(ctx, id, readMask, showDeleted, showUnpublished)
 	if err != nil {
 		stat.FailedWithError(err)
 		return nil, err
@@ -40,6 +41,146 @@ func (e *experiencesMetricsRepositoryImpl) GetExperience(ctx context.Context, id
 	return experience, nil
 }
 
+func (e *experiencesMetricsRepositoryImpl) BatchGetExperiences(ctx context.Context, ids []int64, readMask []string) ([]*portfolio_grpc.Experience, []int64, error) {
+	stat := e.statsd.Start("experiences", "BatchGetExperiences")
+	defer stat.Finished()
+
+	experiences, missing, err := e.repo.BatchGetExperiences(ctx, ids, readMask)
+	if err != nil {
+		stat.FailedWithError(err)
+		return nil, nil, err
+	}
+
+	stat.Succeeded()
+	return experiences, missing, nil
+}
+
+func (e *experiencesMetricsRepositoryImpl) CreateExperience(ctx context.Context, experience *portfolio_grpc.Experience) (*portfolio_grpc.Experience, error) {
+	stat := e.statsd.Start("experiences", "CreateExperience")
+	defer stat.Finished()
+
+	created, err := e.repo.CreateExperience(ctx, experience)
+	if err != nil {
+		stat.FailedWithError(err)
+		return nil, err
+	}
+
+	stat.Succeeded()
+	return created, nil
+}
+
+func (e *experiencesMetricsRepositoryImpl) UpdateExperience(ctx context.Context, experience *portfolio_grpc.Experience, paths []string) (*portfolio_grpc.Experience, error) {
+	stat := e.statsd.Start("experiences", "UpdateExperience")
+	defer stat.Finished()
+
+	updated, err := e.repo.UpdateExperience(ctx, experience, paths)
+	if err != nil {
+		stat.FailedWithError(err)
+		return nil, err
+	}
+
+	stat.Succeeded()
+	return updated, nil
+}
+
+func (e *experiencesMetricsRepositoryImpl) DeleteExperience(ctx context.Context, id int) error {
+	stat := e.statsd.Start("experiences", "DeleteExperience")
+	defer stat.Finished()
+
+	err := e.repo.DeleteExperience(ctx, id)
+	if err != nil {
+		stat.FailedWithError(err)
+		return err
+	}
+
+	stat.Succeeded()
+	return nil
+}
+
+func (e *experiencesMetricsRepositoryImpl) UndeleteExperience(ctx context.Context, id int) (*portfolio_grpc.Experience, error) {
+	stat := e.statsd.Start("experiences", "UndeleteExperience")
+	defer stat.Finished()
+
+	restored, err := e.repo.UndeleteExperience(ctx, id)
+	if err != nil {
+		stat.FailedWithError(err)
+		return nil, err
+	}
+
+	stat.Succeeded()
+	return restored, nil
+}
+
+func (e *experiencesMetricsRepositoryImpl) PurgeDeletedExperiences(ctx context.Context, before time.Time) (int64, error) {
+	stat := e.statsd.Start("experiences", "PurgeDeletedExperiences")
+	defer stat.Finished()
+
+	purged, err := e.repo.PurgeDeletedExperiences(ctx, before)
+	if err != nil {
+		stat.FailedWithError(err)
+		return 0, err
+	}
+
+	stat.Succeeded()
+	return purged, nil
+}
+
+func (e *experiencesMetricsRepositoryImpl) ListExperiencesPublishedSince(ctx context.Context, since time.Time) ([]int64, time.Time, error) {
+	stat := e.statsd.Start("experiences", "ListExperiencesPublishedSince")
+	defer stat.Finished()
+
+	ids, checkedAt, err := e.repo.ListExperiencesPublishedSince(ctx, since)
+	if err != nil {
+		stat.FailedWithError(err)
+		return nil, time.Time{}, err
+	}
+
+	stat.Succeeded()
+	return ids, checkedAt, nil
+}
+
+func (e *experiencesMetricsRepositoryImpl) ListExperienceRevisions(ctx context.Context, experienceID int, params ListRevisionsParams) ([]*portfolio_grpc.Revision, string, error) {
+	stat := e.statsd.Start("experiences", "ListExperienceRevisions")
+	defer stat.Finished()
+
+	revisions, nextPageToken, err := e.repo.ListExperienceRevisions(ctx, experienceID, params)
+	if err != nil {
+		stat.FailedWithError(err)
+		return nil, "", err
+	}
+
+	stat.Succeeded()
+	return revisions, nextPageToken, nil
+}
+
+func (e *experiencesMetricsRepositoryImpl) RestoreExperienceRevision(ctx context.Context, experienceID int, revisionID int64) (*portfolio_grpc.Experience, error) {
+	stat := e.statsd.Start("experiences", "RestoreExperienceRevision")
+	defer stat.Finished()
+
+	restored, err := e.repo.RestoreExperienceRevision(ctx, experienceID, revisionID)
+	if err != nil {
+		stat.FailedWithError(err)
+		return nil, err
+	}
+
+	stat.Succeeded()
+	return restored, nil
+}
+
+func (e *experiencesMetricsRepositoryImpl) ListExperiencesBySkill(ctx context.Context, skillID int, showUnpublished bool) ([]*portfolio_grpc.Experience, error) {
+	stat := e.statsd.Start("experiences", "ListExperiencesBySkill")
+	defer stat.Finished()
+
+	experiences, err := e.repo.ListExperiencesBySkill(ctx, skillID, showUnpublished)
+	if err != nil {
+		stat.FailedWithError(err)
+		return nil, err
+	}
+
+	stat.Succeeded()
+	return experiences, nil
+}
+
 func newExperiencesMetricsRepository(repo ExperiencesRepository, statsdClient statsd.Client) ExperiencesRepository {
 	return &experiencesMetricsRepositoryImpl{
 		repo:   repo,
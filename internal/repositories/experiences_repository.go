@@ -3,19 +3,73 @@ package repositories
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc"
 	"github.com/jorgejr568/portfolio-grpc/internal/client/statsd"
+	"github.com/jorgejr568/portfolio-grpc/internal/locale"
 )
 
 type ExperiencesRepository interface {
-	ListExperiences(ctx context.Context) ([]*portfolio_grpc.Experience, error)
-	GetExperience(ctx context.Context, id int) (*portfolio_grpc.Experience, error)
+	// ListExperiences returns a page of experiences and the token of the next
+	// page, which is empty on the last page.
+	ListExperiences(ctx context.Context, params ListExperiencesParams) ([]*portfolio_grpc.Experience, string, error)
+	// GetExperience returns the experience with the given id. Deleted
+	// experiences are only returned when showDeleted is set and experiences
+	// that are not public when showUnpublished is.
+	GetExperience(ctx context.Context, id int, readMask []string, showDeleted, showUnpublished bool) (*portfolio_grpc.Experience, error)
+	// BatchGetExperiences returns the experiences with the given ids in the
+	// order of ids, along with the ids that were not found. Deleted
+	// experiences and experiences that are not public count as not found.
+	BatchGetExperiences(ctx context.Context, ids []int64, readMask []string) ([]*portfolio_grpc.Experience, []int64, error)
+	CreateExperience(ctx context.Context, experience *portfolio_grpc.Experience) (*portfolio_grpc.Experience, error)
+	// UpdateExperience updates the fields of experience selected by paths.
+	// Empty paths update every mutable field.
+	UpdateExperience(ctx context.Context, experience *portfolio_grpc.Experience, paths []string) (*portfolio_grpc.Experience, error)
+	// DeleteExperience marks the experience as deleted. Deleted experiences
+	// are hidden until restored by UndeleteExperience or removed by
+	// PurgeDeletedExperiences.
+	DeleteExperience(ctx context.Context, id int) error
+	UndeleteExperience(ctx context.Context, id int) (*portfolio_grpc.Experience, error)
+	// PurgeDeletedExperiences permanently removes the experiences deleted
+	// before the given time and returns how many were removed.
+	PurgeDeletedExperiences(ctx context.Context, before time.Time) (int64, error)
+	// ListExperiencesPublishedSince returns the ids of the public experiences
+	// whose publish_at passed after since, along with the database time they
+	// were checked at, which is the since of the next call.
+	ListExperiencesPublishedSince(ctx context.Context, since time.Time) ([]int64, time.Time, error)
+	// ListExperienceRevisions returns a page of the revisions of an
+	// experience, newest first, and the token of the next page.
+	ListExperienceRevisions(ctx context.Context, experienceID int, params ListRevisionsParams) ([]*portfolio_grpc.Revision, string, error)
+	// RestoreExperienceRevision writes an experience back as it was before the
+	// revision, recording the replaced state as a new revision.
+	RestoreExperienceRevision(ctx context.Context, experienceID int, revisionID int64) (*portfolio_grpc.Experience, error)
+	// ListExperiencesBySkill returns the experiences that used a skill, most
+	// recent first, with only their id, title, company name and dates read.
+	// Experiences that are not public are only returned when showUnpublished
+	// is set.
+	ListExperiencesBySkill(ctx context.Context, skillID int, showUnpublished bool) ([]*portfolio_grpc.Experience, error)
 }
 
-func NewExperiencesRepository(db *sql.DB, client statsd.Client) ExperiencesRepository {
+type ListExperiencesParams struct {
+	// Filter is an AIP-160 filter, see compileFilter.
+	Filter string
+	// OrderBy is an AIP-132 order_by such as "started_at desc".
+	OrderBy   string
+	PageSize  int
+	PageToken string
+	// ReadMask limits the fields read. Empty reads every field.
+	ReadMask []string
+	// ShowDeleted includes deleted rows.
+	ShowDeleted bool
+	// ShowUnpublished includes drafts, archived rows and rows whose
+	// publish_at has not passed yet.
+	ShowUnpublished bool
+}
+
+func NewExperiencesRepository(db *sql.DB, client statsd.Client, negotiator *locale.Negotiator) ExperiencesRepository {
 	return newExperiencesMetricsRepository(
-		newExperiencesDBRepository(db),
+		newExperiencesDBRepository(db, negotiator),
 		client,
 	)
 }
@@ -0,0 +1,329 @@
+package repositories
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+var (
+	ErrInvalidFilter = errors.New("invalid filter")
+)
+
+type filterKind int
+
+const (
+	filterString filterKind = iota
+	filterInt
+	filterDate
+	filterTimestamp
+	// filterList is a JSON array of strings; only the has operator applies.
+	filterList
+)
+
+// filterField describes a field that can be referenced in a filter. Column
+// is the SQL expression the field compiles to.
+type filterField struct {
+	column string
+	kind   filterKind
+}
+
+// compileFilter compiles an AIP-160 style filter into a parameterized SQL
+// condition, binding every literal through args. Supported syntax:
+//
+//	title = "Go"              comparisons with =, !=, <, <=, > and >=
+//	title:"lang"              has; substring match on strings, element match on lists
+//	a AND b, a OR b           OR binds tighter than AND, as in AIP-160
+//	NOT a, -a, (a)            negation and grouping
+//
+// Only fields listed in fields can be referenced, so user input never
+// reaches the query text. An empty filter compiles to an empty condition.
+func compileFilter(filter string, fields map[string]filterField, args *queryArgs) (string, error) {
+	tokens, err := lexFilter(filter)
+	if err != nil {
+		return "", err
+	}
+
+	if len(tokens) == 0 {
+		return "", nil
+	}
+
+	p := &filterParser{tokens: tokens, fields: fields, args: args}
+	condition, err := p.parseExpression()
+	if err != nil {
+		return "", err
+	}
+
+	if !p.done() {
+		return "", p.errorf("unexpected %q", p.peek().text)
+	}
+
+	return condition, nil
+}
+
+type filterTokenKind int
+
+const (
+	// tokenEOF is returned past the last token, so running out of input
+	// never passes for a field name or a value.
+	tokenEOF filterTokenKind = iota
+	tokenText
+	tokenString
+	tokenOperator
+	tokenLeftParen
+	tokenRightParen
+)
+
+type filterToken struct {
+	kind filterTokenKind
+	text string
+}
+
+func lexFilter(filter string) ([]filterToken, error) {
+	var tokens []filterToken
+	runes := []rune(filter)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			tokens = append(tokens, filterToken{kind: tokenLeftParen, text: "("})
+			i++
+		case r == ')':
+			tokens = append(tokens, filterToken{kind: tokenRightParen, text: ")"})
+			i++
+		case r == '"':
+			var b strings.Builder
+			i++
+			for ; i < len(runes) && runes[i] != '"'; i++ {
+				if runes[i] == '\\' && i+1 < len(runes) {
+					i++
+				}
+				b.WriteRune(runes[i])
+			}
+			if i >= len(runes) {
+				return nil, fmt.Errorf("%w: unterminated string", ErrInvalidFilter)
+			}
+			tokens = append(tokens, filterToken{kind: tokenString, text: b.String()})
+			i++
+		case strings.ContainsRune("=!<>:", r):
+			op := string(r)
+			if i+1 < len(runes) && runes[i+1] == '=' && r != '=' && r != ':' {
+				op += "="
+			}
+			if op == "!" {
+				return nil, fmt.Errorf("%w: unexpected %q", ErrInvalidFilter, op)
+			}
+			tokens = append(tokens, filterToken{kind: tokenOperator, text: op})
+			i += len(op)
+		default:
+			start := i
+			for i < len(runes) && isFilterTextRune(runes[i]) {
+				i++
+			}
+			if start == i {
+				return nil, fmt.Errorf("%w: unexpected %q", ErrInvalidFilter, string(r))
+			}
+			tokens = append(tokens, filterToken{kind: tokenText, text: string(runes[start:i])})
+		}
+	}
+
+	return tokens, nil
+}
+
+func isFilterTextRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || strings.ContainsRune("._-+", r)
+}
+
+type filterParser struct {
+	tokens []filterToken
+	pos    int
+	fields map[string]filterField
+	args   *queryArgs
+}
+
+func (p *filterParser) done() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *filterParser) peek() filterToken {
+	if p.done() {
+		return filterToken{kind: tokenEOF}
+	}
+
+	return p.tokens[p.pos]
+}
+
+func (p *filterParser) next() filterToken {
+	token := p.peek()
+	p.pos++
+	return token
+}
+
+func (p *filterParser) peekKeyword(keyword string) bool {
+	token := p.peek()
+	return token.kind == tokenText && token.text == keyword
+}
+
+func (p *filterParser) errorf(format string, args ...any) error {
+	return fmt.Errorf("%w: %s", ErrInvalidFilter, fmt.Sprintf(format, args...))
+}
+
+// parseExpression parses terms joined by AND.
+func (p *filterParser) parseExpression() (string, error) {
+	return p.parseJoined("AND", p.parseSequence)
+}
+
+// parseSequence parses factors joined by OR.
+func (p *filterParser) parseSequence() (string, error) {
+	return p.parseJoined("OR", p.parseFactor)
+}
+
+func (p *filterParser) parseJoined(keyword string, parse func() (string, error)) (string, error) {
+	first, err := parse()
+	if err != nil {
+		return "", err
+	}
+
+	terms := []string{first}
+	for p.peekKeyword(keyword) {
+		p.next()
+		term, err := parse()
+		if err != nil {
+			return "", err
+		}
+		terms = append(terms, term)
+	}
+
+	if len(terms) == 1 {
+		return first, nil
+	}
+
+	return "(" + strings.Join(terms, " "+keyword+" ") + ")", nil
+}
+
+func (p *filterParser) parseFactor() (string, error) {
+	if p.peekKeyword("NOT") {
+		p.next()
+		factor, err := p.parseFactor()
+		if err != nil {
+			return "", err
+		}
+		return "NOT " + factor, nil
+	}
+
+	token := p.peek()
+	switch {
+	case p.done():
+		return "", p.errorf("unexpected end of filter")
+	case token.kind == tokenLeftParen:
+		p.next()
+		expression, err := p.parseExpression()
+		if err != nil {
+			return "", err
+		}
+		if p.next().kind != tokenRightParen {
+			return "", p.errorf("missing closing parenthesis")
+		}
+		return expression, nil
+	case token.kind == tokenText && strings.HasPrefix(token.text, "-") && len(token.text) > 1:
+		p.tokens[p.pos].text = strings.TrimPrefix(token.text, "-")
+		comparison, err := p.parseComparison()
+		if err != nil {
+			return "", err
+		}
+		return "NOT " + comparison, nil
+	default:
+		return p.parseComparison()
+	}
+}
+
+func (p *filterParser) parseComparison() (string, error) {
+	name := p.next()
+	if name.kind != tokenText {
+		return "", p.errorf("expected a field name, got %q", name.text)
+	}
+
+	field, ok := p.fields[name.text]
+	if !ok {
+		return "", p.errorf("unknown field %q", name.text)
+	}
+
+	operator := p.next()
+	if operator.kind != tokenOperator {
+		return "", p.errorf("expected an operator after %q", name.text)
+	}
+
+	value := p.next()
+	if value.kind != tokenText && value.kind != tokenString {
+		return "", p.errorf("expected value after %q", name.text)
+	}
+
+	return p.compileComparison(name.text, field, operator.text, value.text)
+}
+
+func (p *filterParser) compileComparison(name string, field filterField, operator, value string) (string, error) {
+	switch field.kind {
+	case filterList:
+		if operator != ":" && operator != "=" {
+			return "", p.errorf("operator %s is not supported on %q", operator, name)
+		}
+		return fmt.Sprintf(
+			"EXISTS (SELECT 1 FROM jsonb_array_elements_text(%s) AS item WHERE lower(item) = lower(%s))",
+			field.column, p.args.bind(value),
+		), nil
+	case filterString:
+		if operator == ":" {
+			return fmt.Sprintf("%s ILIKE %s", field.column, p.args.bind("%"+escapeLike(value)+"%")), nil
+		}
+		return fmt.Sprintf("%s %s %s", field.column, sqlOperator(operator), p.args.bind(value)), nil
+	}
+
+	if operator == ":" {
+		operator = "="
+	}
+
+	var bound any
+	switch field.kind {
+	case filterInt:
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return "", p.errorf("%q expects an integer, got %q", name, value)
+		}
+		bound = n
+	case filterDate:
+		d, err := time.Parse(time.DateOnly, value)
+		if err != nil {
+			return "", p.errorf("%q expects a YYYY-MM-DD date, got %q", name, value)
+		}
+		bound = d
+	case filterTimestamp:
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			d, dateErr := time.Parse(time.DateOnly, value)
+			if dateErr != nil {
+				return "", p.errorf("%q expects an RFC 3339 timestamp or a date, got %q", name, value)
+			}
+			t = d
+		}
+		bound = t
+	}
+
+	return fmt.Sprintf("%s %s %s", field.column, sqlOperator(operator), p.args.bind(bound)), nil
+}
+
+func sqlOperator(operator string) string {
+	if operator == "!=" {
+		return "<>"
+	}
+
+	return operator
+}
+
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value)
+}
@@ -0,0 +1,102 @@
+package repositories
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+var testFilterFields = map[string]filterField{
+	"title":      {column: "title", kind: filterString},
+	"level":      {column: "level", kind: filterInt},
+	"started_at": {column: "started_at", kind: filterDate},
+	"created_at": {column: "created_at", kind: filterTimestamp},
+	"languages":  {column: "languages", kind: filterList},
+}
+
+func TestCompileFilter(t *testing.T) {
+	tests := []struct {
+		name     string
+		filter   string
+		want     string
+		wantArgs queryArgs
+		wantErr  string
+	}{
+		{name: "empty", filter: "", want: ""},
+		{name: "blank", filter: "   ", want: ""},
+		{name: "equals string", filter: `title = "Go"`, want: "title = $1", wantArgs: queryArgs{"Go"}},
+		{name: "bare value", filter: "title=Go", want: "title = $1", wantArgs: queryArgs{"Go"}},
+		{name: "not equals", filter: `title != "Go"`, want: "title <> $1", wantArgs: queryArgs{"Go"}},
+		{name: "escaped quote", filter: `title = "say \"hi\""`, want: "title = $1", wantArgs: queryArgs{`say "hi"`}},
+		{name: "has string", filter: `title:"50%_off"`, want: "title ILIKE $1", wantArgs: queryArgs{`%50\%\_off%`}},
+		{name: "has list", filter: `languages:"Go"`, want: "EXISTS (SELECT 1 FROM jsonb_array_elements_text(languages) AS item WHERE lower(item) = lower($1))", wantArgs: queryArgs{"Go"}},
+		{name: "int", filter: "level >= 3", want: "level >= $1", wantArgs: queryArgs{int64(3)}},
+		{name: "int has", filter: "level:3", want: "level = $1", wantArgs: queryArgs{int64(3)}},
+		{name: "date", filter: "started_at < 2020-01-31", want: "started_at < $1", wantArgs: queryArgs{time.Date(2020, 1, 31, 0, 0, 0, 0, time.UTC)}},
+		{name: "timestamp", filter: `created_at > "2020-01-31T10:00:00Z"`, want: "created_at > $1", wantArgs: queryArgs{time.Date(2020, 1, 31, 10, 0, 0, 0, time.UTC)}},
+		{name: "timestamp as date", filter: "created_at > 2020-01-31", want: "created_at > $1", wantArgs: queryArgs{time.Date(2020, 1, 31, 0, 0, 0, 0, time.UTC)}},
+		{name: "and", filter: "level > 1 AND level < 5", want: "(level > $1 AND level < $2)", wantArgs: queryArgs{int64(1), int64(5)}},
+		{
+			name:     "or binds tighter than and",
+			filter:   "level = 1 OR level = 2 AND title = Go",
+			want:     "((level = $1 OR level = $2) AND title = $3)",
+			wantArgs: queryArgs{int64(1), int64(2), "Go"},
+		},
+		{name: "not", filter: "NOT level = 1", want: "NOT level = $1", wantArgs: queryArgs{int64(1)}},
+		{name: "minus", filter: "-title:Go", want: "NOT title ILIKE $1", wantArgs: queryArgs{"%Go%"}},
+		{name: "grouping", filter: "(level = 1 OR level = 2) AND NOT (title = Go)", want: "((level = $1 OR level = $2) AND NOT title = $3)", wantArgs: queryArgs{int64(1), int64(2), "Go"}},
+		{name: "missing value", filter: "title =", wantErr: `invalid filter: expected value after "title"`},
+		{name: "missing value in group", filter: "(title = )", wantErr: `invalid filter: expected value after "title"`},
+		{name: "missing operator", filter: "title", wantErr: `invalid filter: expected an operator after "title"`},
+		{name: "operator as value", filter: "title = =", wantErr: `invalid filter: expected value after "title"`},
+		{name: "unknown field", filter: "salary > 1", wantErr: `invalid filter: unknown field "salary"`},
+		{name: "dangling and", filter: "level = 1 AND", wantErr: "invalid filter: unexpected end of filter"},
+		{name: "unclosed parenthesis", filter: "(level = 1", wantErr: "invalid filter: missing closing parenthesis"},
+		{name: "trailing token", filter: "level = 1 2", wantErr: `invalid filter: unexpected "2"`},
+		{name: "unterminated string", filter: `title = "Go`, wantErr: "invalid filter: unterminated string"},
+		{name: "lone bang", filter: "title ! Go", wantErr: `invalid filter: unexpected "!"`},
+		{name: "unexpected rune", filter: "title = Go;", wantErr: `invalid filter: unexpected ";"`},
+		{name: "bad int", filter: "level = high", wantErr: `invalid filter: "level" expects an integer, got "high"`},
+		{name: "bad date", filter: "started_at = 2020", wantErr: `invalid filter: "started_at" expects a YYYY-MM-DD date, got "2020"`},
+		{name: "list comparison", filter: "languages > Go", wantErr: `invalid filter: operator > is not supported on "languages"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var args queryArgs
+			got, err := compileFilter(tt.filter, testFilterFields, &args)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("compileFilter(%q) error = %v, want %q", tt.filter, err, tt.wantErr)
+				}
+				if !errors.Is(err, ErrInvalidFilter) {
+					t.Errorf("compileFilter(%q) error = %v, want it to wrap ErrInvalidFilter", tt.filter, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("compileFilter(%q) error = %v", tt.filter, err)
+			}
+			if got != tt.want {
+				t.Errorf("compileFilter(%q) = %q, want %q", tt.filter, got, tt.want)
+			}
+			if !reflect.DeepEqual(args, tt.wantArgs) {
+				t.Errorf("compileFilter(%q) args = %#v, want %#v", tt.filter, args, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestCompileFilterContinuesArgs(t *testing.T) {
+	args := queryArgs{"existing"}
+	got, err := compileFilter("level = 1", testFilterFields, &args)
+	if err != nil {
+		t.Fatalf("compileFilter() error = %v", err)
+	}
+
+	if got != "level = $2" {
+		t.Errorf("compileFilter() = %q, want %q", got, "level = $2")
+	}
+}
@@ -0,0 +1,35 @@
+package repositories
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// decodeJSONList decodes a JSON array of strings stored in a text or JSON
+// column. Empty columns decode to an empty list.
+func decodeJSONList(raw string) ([]string, error) {
+	if strings.TrimSpace(raw) == "" {
+		return nil, nil
+	}
+
+	var values []string
+	if err := json.Unmarshal([]byte(raw), &values); err != nil {
+		return nil, err
+	}
+
+	return values, nil
+}
+
+// encodeJSONList encodes values as a JSON array, never as null.
+func encodeJSONList(values []string) (string, error) {
+	if values == nil {
+		values = []string{}
+	}
+
+	raw, err := json.Marshal(values)
+	if err != nil {
+		return "", err
+	}
+
+	return string(raw), nil
+}
@@ -0,0 +1,107 @@
+package repositories
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	ErrInvalidOrderBy = errors.New("invalid order_by")
+)
+
+// sortField describes a field that can be used in order_by. Value renders
+// the field of a result row as the text form stored in page tokens. Null is
+// set on nullable columns and reports whether the field of a row is NULL.
+type sortField[T any] struct {
+	column string
+	cast   string
+	value  func(T) string
+	null   func(T) bool
+}
+
+// sortOrder is a parsed order_by clause, always ending with the id
+// tiebreaker so keyset pagination is stable.
+type sortOrder[T any] struct {
+	fields []string
+	keys   []sortKey
+	values []func(T) string
+	nulls  []func(T) bool
+}
+
+func (o sortOrder[T]) keyValues(item T) []*string {
+	values := make([]*string, len(o.values))
+	for i, value := range o.values {
+		if null := o.nulls[i]; null != nil && null(item) {
+			continue
+		}
+		v := value(item)
+		values[i] = &v
+	}
+
+	return values
+}
+
+// parseOrderBy parses an AIP-132 order_by such as "level desc, title".
+// Fields default to ascending order. An empty orderBy uses fallback.
+func parseOrderBy[T any](orderBy string, fields map[string]sortField[T], fallback string) (sortOrder[T], error) {
+	if strings.TrimSpace(orderBy) == "" {
+		orderBy = fallback
+	}
+
+	var (
+		order sortOrder[T]
+		seen  = make(map[string]bool)
+	)
+	for _, term := range strings.Split(orderBy, ",") {
+		parts := strings.Fields(term)
+		if len(parts) == 0 || len(parts) > 2 {
+			return sortOrder[T]{}, fmt.Errorf("%w: malformed term %q", ErrInvalidOrderBy, strings.TrimSpace(term))
+		}
+
+		name := parts[0]
+		field, ok := fields[name]
+		if !ok {
+			return sortOrder[T]{}, fmt.Errorf("%w: unknown field %q", ErrInvalidOrderBy, name)
+		}
+
+		if seen[name] {
+			return sortOrder[T]{}, fmt.Errorf("%w: duplicate field %q", ErrInvalidOrderBy, name)
+		}
+		seen[name] = true
+
+		desc := false
+		if len(parts) == 2 {
+			switch strings.ToLower(parts[1]) {
+			case "asc":
+			case "desc":
+				desc = true
+			default:
+				return sortOrder[T]{}, fmt.Errorf("%w: unknown direction %q", ErrInvalidOrderBy, parts[1])
+			}
+		}
+
+		order.fields = append(order.fields, name)
+		order.keys = append(order.keys, sortKey{column: field.column, cast: field.cast, desc: desc, nullable: field.null != nil})
+		order.values = append(order.values, field.value)
+		order.nulls = append(order.nulls, field.null)
+	}
+
+	if !seen["id"] {
+		id := fields["id"]
+		last := order.keys[len(order.keys)-1]
+		order.fields = append(order.fields, "id")
+		order.keys = append(order.keys, sortKey{column: id.column, cast: id.cast, desc: last.desc})
+		order.values = append(order.values, id.value)
+		order.nulls = append(order.nulls, nil)
+	}
+
+	return order, nil
+}
+
+func formatTimestampKey(ts *timestamppb.Timestamp) string {
+	return ts.AsTime().Format(time.RFC3339Nano)
+}
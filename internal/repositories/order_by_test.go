@@ -0,0 +1,151 @@
+package repositories
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+type testSortItem struct {
+	id        int64
+	level     int64
+	title     string
+	startedAt string
+}
+
+var testSortFields = map[string]sortField[testSortItem]{
+	"id": {column: "id", cast: "bigint", value: func(item testSortItem) string {
+		return strconv.FormatInt(item.id, 10)
+	}},
+	"level": {column: "level", cast: "integer", value: func(item testSortItem) string {
+		return strconv.FormatInt(item.level, 10)
+	}},
+	"title": {column: "lower(title)", cast: "text", value: func(item testSortItem) string {
+		return item.title
+	}},
+	"started_at": {column: "started_at", cast: "date", value: func(item testSortItem) string {
+		return item.startedAt
+	}, null: func(item testSortItem) bool {
+		return item.startedAt == ""
+	}},
+}
+
+func TestParseOrderBy(t *testing.T) {
+	tests := []struct {
+		name       string
+		orderBy    string
+		wantFields []string
+		wantKeys   []sortKey
+		wantErr    string
+	}{
+		{
+			name:       "fallback",
+			orderBy:    "",
+			wantFields: []string{"title", "id"},
+			wantKeys:   []sortKey{{column: "lower(title)", cast: "text"}, {column: "id", cast: "bigint"}},
+		},
+		{
+			name:       "blank uses fallback",
+			orderBy:    "  ",
+			wantFields: []string{"title", "id"},
+			wantKeys:   []sortKey{{column: "lower(title)", cast: "text"}, {column: "id", cast: "bigint"}},
+		},
+		{
+			name:       "desc then asc",
+			orderBy:    "level desc, title",
+			wantFields: []string{"level", "title", "id"},
+			wantKeys: []sortKey{
+				{column: "level", cast: "integer", desc: true},
+				{column: "lower(title)", cast: "text"},
+				{column: "id", cast: "bigint"},
+			},
+		},
+		{
+			name:       "id follows the last direction",
+			orderBy:    "level DESC",
+			wantFields: []string{"level", "id"},
+			wantKeys:   []sortKey{{column: "level", cast: "integer", desc: true}, {column: "id", cast: "bigint", desc: true}},
+		},
+		{
+			name:       "explicit id",
+			orderBy:    "id desc, level asc",
+			wantFields: []string{"id", "level"},
+			wantKeys:   []sortKey{{column: "id", cast: "bigint", desc: true}, {column: "level", cast: "integer"}},
+		},
+		{
+			name:       "nullable field",
+			orderBy:    "started_at desc",
+			wantFields: []string{"started_at", "id"},
+			wantKeys: []sortKey{
+				{column: "started_at", cast: "date", desc: true, nullable: true},
+				{column: "id", cast: "bigint", desc: true},
+			},
+		},
+		{name: "unknown field", orderBy: "salary", wantErr: `invalid order_by: unknown field "salary"`},
+		{name: "duplicate field", orderBy: "level, level desc", wantErr: `invalid order_by: duplicate field "level"`},
+		{name: "unknown direction", orderBy: "level down", wantErr: `invalid order_by: unknown direction "down"`},
+		{name: "empty term", orderBy: "level,", wantErr: `invalid order_by: malformed term ""`},
+		{name: "too many words", orderBy: "level desc nulls", wantErr: `invalid order_by: malformed term "level desc nulls"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseOrderBy(tt.orderBy, testSortFields, "title")
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("parseOrderBy(%q) error = %v, want %q", tt.orderBy, err, tt.wantErr)
+				}
+				if !errors.Is(err, ErrInvalidOrderBy) {
+					t.Errorf("parseOrderBy(%q) error = %v, want it to wrap ErrInvalidOrderBy", tt.orderBy, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("parseOrderBy(%q) error = %v", tt.orderBy, err)
+			}
+			if !reflect.DeepEqual(got.fields, tt.wantFields) {
+				t.Errorf("parseOrderBy(%q) fields = %v, want %v", tt.orderBy, got.fields, tt.wantFields)
+			}
+			if !reflect.DeepEqual(got.keys, tt.wantKeys) {
+				t.Errorf("parseOrderBy(%q) keys = %+v, want %+v", tt.orderBy, got.keys, tt.wantKeys)
+			}
+		})
+	}
+}
+
+func TestSortOrderKeyValues(t *testing.T) {
+	order, err := parseOrderBy("level desc, title", testSortFields, "title")
+	if err != nil {
+		t.Fatalf("parseOrderBy() error = %v", err)
+	}
+
+	got := order.keyValues(testSortItem{id: 7, level: 3, title: "Go"})
+	want := tokenKeys("3", "Go", "7")
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("keyValues() = %v, want %v", got, want)
+	}
+}
+
+func TestSortOrderKeyValuesNull(t *testing.T) {
+	order, err := parseOrderBy("started_at", testSortFields, "title")
+	if err != nil {
+		t.Fatalf("parseOrderBy() error = %v", err)
+	}
+
+	tests := []struct {
+		item testSortItem
+		want []*string
+	}{
+		{item: testSortItem{id: 7, startedAt: "2020-01-31"}, want: tokenKeys("2020-01-31", "7")},
+		{item: testSortItem{id: 7}, want: []*string{nil, tokenKeys("7")[0]}},
+	}
+
+	for _, tt := range tests {
+		got := order.keyValues(tt.item)
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("keyValues(%+v) = %v, want %v", tt.item, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,207 @@
+package repositories
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/type/date"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 1000
+)
+
+var (
+	ErrInvalidPageToken = errors.New("invalid page token")
+)
+
+// pageToken is the decoded form of the opaque page tokens handed to clients.
+// Keys holds the sort key values of the last row of the previous page, so
+// the next page starts right after it even if rows were inserted meanwhile.
+// A nil key stands for NULL.
+type pageToken struct {
+	Keys  []*string `json:"k"`
+	Query string    `json:"q,omitempty"`
+}
+
+// sortKey is a column of a keyset ordered query. Cast is the Postgres type
+// the text encoded cursor value is converted to before comparing. Nullable
+// columns sort NULLs as if they were greater than every other value, which
+// is the Postgres default.
+type sortKey struct {
+	column   string
+	cast     string
+	desc     bool
+	nullable bool
+}
+
+// queryArgs collects positional arguments while a query is being built.
+type queryArgs []any
+
+func (a *queryArgs) bind(value any) string {
+	*a = append(*a, value)
+	return fmt.Sprintf("$%d", len(*a))
+}
+
+// normalizePageSize applies the default page size and caps oversized pages.
+func normalizePageSize(size int) int {
+	if size <= 0 {
+		return defaultPageSize
+	}
+
+	if size > maxPageSize {
+		return maxPageSize
+	}
+
+	return size
+}
+
+// queryFingerprint identifies the filters a page token was issued for, so a
+// token cannot be replayed against a different query.
+func queryFingerprint(parts ...any) string {
+	if len(parts) == 0 {
+		return ""
+	}
+
+	sum := sha256.Sum256([]byte(fmt.Sprint(parts...)))
+	return hex.EncodeToString(sum[:8])
+}
+
+func encodePageToken(token pageToken) string {
+	raw, err := json.Marshal(token)
+	if err != nil {
+		return ""
+	}
+
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// decodePageToken decodes a page token issued for query. An empty token
+// decodes to nil, meaning the first page.
+func decodePageToken(raw string, query string, keys []sortKey) (*pageToken, error) {
+	if raw == "" {
+		return nil, nil
+	}
+
+	decoded, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	token := new(pageToken)
+	if err := json.Unmarshal(decoded, token); err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	if token.Query != query || len(token.Keys) != len(keys) {
+		return nil, ErrInvalidPageToken
+	}
+
+	return token, nil
+}
+
+// keysetCondition builds the condition selecting the rows that sort after
+// the row identified by values.
+func keysetCondition(args *queryArgs, keys []sortKey, values []*string) string {
+	alternatives := make([]string, 0, len(keys))
+	for i, key := range keys {
+		// Nothing sorts after a NULL in ascending order.
+		if values[i] == nil && !key.desc {
+			continue
+		}
+
+		terms := make([]string, 0, i+1)
+		for j, previous := range keys[:i] {
+			terms = append(terms, keyEqual(args, previous, values[j]))
+		}
+		terms = append(terms, keyAfter(args, key, values[i]))
+
+		alternatives = append(alternatives, "("+strings.Join(terms, " AND ")+")")
+	}
+
+	return "(" + strings.Join(alternatives, " OR ") + ")"
+}
+
+func keyEqual(args *queryArgs, key sortKey, value *string) string {
+	if value == nil {
+		return key.column + " IS NULL"
+	}
+
+	return fmt.Sprintf("%s = %s::%s", key.column, args.bind(*value), key.cast)
+}
+
+// keyAfter builds the condition selecting the values of key that sort after
+// value.
+func keyAfter(args *queryArgs, key sortKey, value *string) string {
+	if value == nil {
+		return key.column + " IS NOT NULL"
+	}
+
+	if key.desc {
+		return fmt.Sprintf("%s < %s::%s", key.column, args.bind(*value), key.cast)
+	}
+
+	after := fmt.Sprintf("%s > %s::%s", key.column, args.bind(*value), key.cast)
+	if key.nullable {
+		after = "(" + after + " OR " + key.column + " IS NULL)"
+	}
+
+	return after
+}
+
+func orderByClause(keys []sortKey) string {
+	terms := make([]string, 0, len(keys))
+	for _, key := range keys {
+		direction := "ASC"
+		if key.desc {
+			direction = "DESC"
+		}
+		if key.nullable {
+			if key.desc {
+				direction += " NULLS FIRST"
+			} else {
+				direction += " NULLS LAST"
+			}
+		}
+		terms = append(terms, key.column+" "+direction)
+	}
+
+	return "ORDER BY " + strings.Join(terms, ", ")
+}
+
+func whereClause(conditions []string) string {
+	if len(conditions) == 0 {
+		return ""
+	}
+
+	return "WHERE " + strings.Join(conditions, " AND ")
+}
+
+// paginate trims a result fetched with one extra row down to pageSize and
+// returns the token of the following page, if there is one.
+func paginate[T any](items []T, pageSize int, query string, keyValues func(T) []*string) ([]T, string) {
+	if len(items) <= pageSize {
+		return items, ""
+	}
+
+	items = items[:pageSize]
+	return items, encodePageToken(pageToken{
+		Keys:  keyValues(items[len(items)-1]),
+		Query: query,
+	})
+}
+
+func formatIDKey(id int64) string {
+	return strconv.FormatInt(id, 10)
+}
+
+func formatDateKey(d *date.Date) string {
+	return fmt.Sprintf("%04d-%02d-%02d", d.GetYear(), d.GetMonth(), d.GetDay())
+}
@@ -0,0 +1,304 @@
+package repositories
+
+import (
+	"encoding/base64"
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/type/date"
+)
+
+var testPageKeys = []sortKey{
+	{column: "level", cast: "integer", desc: true},
+	{column: "id", cast: "bigint", desc: true},
+}
+
+// tokenKeys builds the keys of a page token, none of them NULL.
+func tokenKeys(values ...string) []*string {
+	keys := make([]*string, len(values))
+	for i := range values {
+		keys[i] = &values[i]
+	}
+
+	return keys
+}
+
+func TestNormalizePageSize(t *testing.T) {
+	tests := []struct {
+		size int
+		want int
+	}{
+		{size: -1, want: defaultPageSize},
+		{size: 0, want: defaultPageSize},
+		{size: 1, want: 1},
+		{size: maxPageSize, want: maxPageSize},
+		{size: maxPageSize + 1, want: maxPageSize},
+	}
+
+	for _, tt := range tests {
+		if got := normalizePageSize(tt.size); got != tt.want {
+			t.Errorf("normalizePageSize(%d) = %d, want %d", tt.size, got, tt.want)
+		}
+	}
+}
+
+func TestQueryFingerprint(t *testing.T) {
+	if got := queryFingerprint(); got != "" {
+		t.Errorf("queryFingerprint() = %q, want empty", got)
+	}
+
+	a := queryFingerprint(int64(1), "level > 3")
+	if a != queryFingerprint(int64(1), "level > 3") {
+		t.Error("queryFingerprint is not deterministic")
+	}
+	if a == queryFingerprint(int64(2), "level > 3") {
+		t.Error("queryFingerprint does not depend on its parts")
+	}
+	if len(a) != 16 {
+		t.Errorf("queryFingerprint() = %q, want 16 hex characters", a)
+	}
+}
+
+func TestPageTokenRoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		token pageToken
+	}{
+		{name: "keys", token: pageToken{Keys: tokenKeys("5", "42")}},
+		{name: "keys and query", token: pageToken{Keys: tokenKeys("5", "42"), Query: "abc"}},
+		{name: "unicode key", token: pageToken{Keys: tokenKeys("Go, \"the\" language ✓", "1"), Query: "q"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw := encodePageToken(tt.token)
+			got, err := decodePageToken(raw, tt.token.Query, testPageKeys)
+			if err != nil {
+				t.Fatalf("decodePageToken(%q) error = %v", raw, err)
+			}
+			if !reflect.DeepEqual(*got, tt.token) {
+				t.Errorf("decodePageToken(encodePageToken(%+v)) = %+v", tt.token, *got)
+			}
+		})
+	}
+}
+
+func TestDecodePageToken(t *testing.T) {
+	valid := encodePageToken(pageToken{Keys: tokenKeys("5", "42"), Query: "abc"})
+
+	tests := []struct {
+		name    string
+		raw     string
+		query   string
+		want    *pageToken
+		wantErr error
+	}{
+		{name: "empty", raw: "", query: "abc", want: nil},
+		{name: "valid", raw: valid, query: "abc", want: &pageToken{Keys: tokenKeys("5", "42"), Query: "abc"}},
+		{name: "other query", raw: valid, query: "xyz", wantErr: ErrInvalidPageToken},
+		{name: "not base64", raw: "!!!", query: "abc", wantErr: ErrInvalidPageToken},
+		{name: "padded base64", raw: base64.URLEncoding.EncodeToString([]byte(`{"k":["5","42"],"q":"abc"}`)), query: "abc", wantErr: ErrInvalidPageToken},
+		{name: "not json", raw: base64.RawURLEncoding.EncodeToString([]byte("nope")), query: "abc", wantErr: ErrInvalidPageToken},
+		{name: "too few keys", raw: encodePageToken(pageToken{Keys: tokenKeys("5"), Query: "abc"}), query: "abc", wantErr: ErrInvalidPageToken},
+		{name: "too many keys", raw: encodePageToken(pageToken{Keys: tokenKeys("5", "42", "7"), Query: "abc"}), query: "abc", wantErr: ErrInvalidPageToken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := decodePageToken(tt.raw, tt.query, testPageKeys)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("decodePageToken(%q) error = %v, want %v", tt.raw, err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("decodePageToken(%q) = %+v, want %+v", tt.raw, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKeysetCondition(t *testing.T) {
+	tests := []struct {
+		name     string
+		keys     []sortKey
+		values   []*string
+		want     string
+		wantArgs queryArgs
+	}{
+		{
+			name:     "single key",
+			keys:     []sortKey{{column: "id", cast: "bigint"}},
+			values:   tokenKeys("42"),
+			want:     "((id > $1::bigint))",
+			wantArgs: queryArgs{"42"},
+		},
+		{
+			name:     "descending keys",
+			keys:     testPageKeys,
+			values:   tokenKeys("5", "42"),
+			want:     "((level < $1::integer) OR (level = $2::integer AND id < $3::bigint))",
+			wantArgs: queryArgs{"5", "5", "42"},
+		},
+		{
+			name: "mixed directions",
+			keys: []sortKey{
+				{column: "started_at", cast: "date", desc: true},
+				{column: "lower(title)", cast: "text"},
+				{column: "id", cast: "bigint"},
+			},
+			values: tokenKeys("2020-01-31", "go", "7"),
+			want: "((started_at < $1::date) OR " +
+				"(started_at = $2::date AND lower(title) > $3::text) OR " +
+				"(started_at = $4::date AND lower(title) = $5::text AND id > $6::bigint))",
+			wantArgs: queryArgs{"2020-01-31", "2020-01-31", "go", "2020-01-31", "go", "7"},
+		},
+		{
+			name:   "ascending nullable key",
+			keys:   []sortKey{{column: "started_at", cast: "date", nullable: true}, {column: "id", cast: "bigint"}},
+			values: tokenKeys("2020-01-31", "7"),
+			want: "(((started_at > $1::date OR started_at IS NULL)) OR " +
+				"(started_at = $2::date AND id > $3::bigint))",
+			wantArgs: queryArgs{"2020-01-31", "2020-01-31", "7"},
+		},
+		{
+			name:     "ascending null key",
+			keys:     []sortKey{{column: "started_at", cast: "date", nullable: true}, {column: "id", cast: "bigint"}},
+			values:   []*string{nil, tokenKeys("7")[0]},
+			want:     "((started_at IS NULL AND id > $1::bigint))",
+			wantArgs: queryArgs{"7"},
+		},
+		{
+			name:   "descending nullable key",
+			keys:   []sortKey{{column: "started_at", cast: "date", desc: true, nullable: true}, {column: "id", cast: "bigint", desc: true}},
+			values: tokenKeys("2020-01-31", "7"),
+			want: "((started_at < $1::date) OR " +
+				"(started_at = $2::date AND id < $3::bigint))",
+			wantArgs: queryArgs{"2020-01-31", "2020-01-31", "7"},
+		},
+		{
+			name:   "descending null key",
+			keys:   []sortKey{{column: "started_at", cast: "date", desc: true, nullable: true}, {column: "id", cast: "bigint", desc: true}},
+			values: []*string{nil, tokenKeys("7")[0]},
+			want: "((started_at IS NOT NULL) OR " +
+				"(started_at IS NULL AND id < $1::bigint))",
+			wantArgs: queryArgs{"7"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var args queryArgs
+			if got := keysetCondition(&args, tt.keys, tt.values); got != tt.want {
+				t.Errorf("keysetCondition() = %q, want %q", got, tt.want)
+			}
+			if !reflect.DeepEqual(args, tt.wantArgs) {
+				t.Errorf("keysetCondition() args = %#v, want %#v", args, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestOrderByClause(t *testing.T) {
+	tests := []struct {
+		keys []sortKey
+		want string
+	}{
+		{keys: testPageKeys, want: "ORDER BY level DESC, id DESC"},
+		{
+			keys: []sortKey{{column: "started_at", nullable: true}, {column: "id"}},
+			want: "ORDER BY started_at ASC NULLS LAST, id ASC",
+		},
+		{
+			keys: []sortKey{{column: "started_at", desc: true, nullable: true}, {column: "id", desc: true}},
+			want: "ORDER BY started_at DESC NULLS FIRST, id DESC",
+		},
+	}
+
+	for _, tt := range tests {
+		if got := orderByClause(tt.keys); got != tt.want {
+			t.Errorf("orderByClause(%+v) = %q, want %q", tt.keys, got, tt.want)
+		}
+	}
+}
+
+func TestWhereClause(t *testing.T) {
+	tests := []struct {
+		conditions []string
+		want       string
+	}{
+		{conditions: nil, want: ""},
+		{conditions: []string{"a"}, want: "WHERE a"},
+		{conditions: []string{"a", "b"}, want: "WHERE a AND b"},
+	}
+
+	for _, tt := range tests {
+		if got := whereClause(tt.conditions); got != tt.want {
+			t.Errorf("whereClause(%q) = %q, want %q", tt.conditions, got, tt.want)
+		}
+	}
+}
+
+func TestPaginate(t *testing.T) {
+	keyValues := func(id int) []*string {
+		return tokenKeys(strconv.Itoa(id*10), strconv.Itoa(id))
+	}
+
+	tests := []struct {
+		name      string
+		items     []int
+		pageSize  int
+		wantItems []int
+		wantKeys  []*string
+	}{
+		{name: "empty", items: nil, pageSize: 2, wantItems: nil},
+		{name: "short page", items: []int{1}, pageSize: 2, wantItems: []int{1}},
+		{name: "full last page", items: []int{1, 2}, pageSize: 2, wantItems: []int{1, 2}},
+		{name: "more pages", items: []int{1, 2, 3}, pageSize: 2, wantItems: []int{1, 2}, wantKeys: tokenKeys("20", "2")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			items, next := paginate(tt.items, tt.pageSize, "abc", keyValues)
+			if !reflect.DeepEqual(items, tt.wantItems) {
+				t.Errorf("paginate() items = %v, want %v", items, tt.wantItems)
+			}
+
+			if tt.wantKeys == nil {
+				if next != "" {
+					t.Errorf("paginate() token = %q, want empty", next)
+				}
+				return
+			}
+
+			token, err := decodePageToken(next, "abc", testPageKeys)
+			if err != nil {
+				t.Fatalf("decodePageToken(paginate() token) error = %v", err)
+			}
+			if !reflect.DeepEqual(token.Keys, tt.wantKeys) {
+				t.Errorf("paginate() token keys = %v, want %v", token.Keys, tt.wantKeys)
+			}
+		})
+	}
+}
+
+func TestFormatKeys(t *testing.T) {
+	if got := formatIDKey(-42); got != "-42" {
+		t.Errorf("formatIDKey(-42) = %q, want %q", got, "-42")
+	}
+
+	tests := []struct {
+		date *date.Date
+		want string
+	}{
+		{date: &date.Date{Year: 2020, Month: 1, Day: 5}, want: "2020-01-05"},
+		{date: &date.Date{Year: 999, Month: 12, Day: 31}, want: "0999-12-31"},
+		{date: nil, want: "0000-00-00"},
+	}
+
+	for _, tt := range tests {
+		if got := formatDateKey(tt.date); got != tt.want {
+			t.Errorf("formatDateKey(%v) = %q, want %q", tt.date, got, tt.want)
+		}
+	}
+}
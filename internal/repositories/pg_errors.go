@@ -0,0 +1,20 @@
+package repositories
+
+import (
+	"errors"
+
+	"github.com/lib/pq"
+)
+
+const (
+	pgForeignKeyViolation = "23503"
+)
+
+func isPgError(err error, code pq.ErrorCode) bool {
+	var pgErr *pq.Error
+	if errors.As(err, &pgErr) {
+		return pgErr.Code == code
+	}
+
+	return false
+}
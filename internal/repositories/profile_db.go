@@ -0,0 +1,197 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc"
+	"github.com/jorgejr568/portfolio-grpc/internal/utils"
+)
+
+const (
+	profileTableName = "profile"
+	// profileID is the id of the only row of the profile table.
+	profileID = 1
+)
+
+var (
+	ErrProfileNotFound = errors.New("profile not found")
+)
+
+// socialLinkKinds maps the kind stored in social_links to its proto enum.
+var socialLinkKinds = map[string]portfolio_grpc.Profile_SocialLink_Kind{
+	"github":   portfolio_grpc.Profile_SocialLink_KIND_GITHUB,
+	"linkedin": portfolio_grpc.Profile_SocialLink_KIND_LINKEDIN,
+	"mastodon": portfolio_grpc.Profile_SocialLink_KIND_MASTODON,
+	"twitter":  portfolio_grpc.Profile_SocialLink_KIND_TWITTER,
+	"website":  portfolio_grpc.Profile_SocialLink_KIND_WEBSITE,
+	"other":    portfolio_grpc.Profile_SocialLink_KIND_OTHER,
+}
+
+func newProfileDBRepository(db *sql.DB) ProfileRepository {
+	return &profileRepositoryImpl{
+		db:        db,
+		tableName: profileTableName,
+		columns:   profileColumns,
+	}
+}
+
+var profileUpdateFields = []updateField{
+	{path: "name", columns: []string{"name"}},
+	{path: "headline", columns: []string{"headline"}},
+	{path: "bio", columns: []string{"bio"}},
+	{path: "avatar_url", columns: []string{"avatar_url"}},
+	{path: "location", columns: []string{"location"}},
+	{path: "contact_email", columns: []string{"contact_email"}},
+	{path: "social_links", columns: []string{"social_links"}},
+}
+
+var profileColumns = columnSet[pgProfile]{
+	{expr: "name", paths: []string{"name"}, dest: func(p *pgProfile) any { return &p.Name }},
+	{expr: "headline", paths: []string{"headline"}, dest: func(p *pgProfile) any { return &p.Headline }},
+	{expr: "bio", paths: []string{"bio"}, dest: func(p *pgProfile) any { return &p.Bio }},
+	{expr: "avatar_url", paths: []string{"avatar_url"}, dest: func(p *pgProfile) any { return &p.AvatarURL }},
+	{expr: "location", paths: []string{"location"}, dest: func(p *pgProfile) any { return &p.Location }},
+	{expr: "contact_email", paths: []string{"contact_email"}, dest: func(p *pgProfile) any { return &p.ContactEmail }},
+	{expr: "social_links", paths: []string{"social_links"}, dest: func(p *pgProfile) any { return &p.SocialLinks }},
+	{expr: "updated_at", paths: []string{"updated_at"}, dest: func(p *pgProfile) any { return &p.UpdatedAt }},
+}
+
+type pgProfile struct {
+	Name         string
+	Headline     string
+	Bio          string
+	AvatarURL    string
+	Location     string
+	ContactEmail string
+	SocialLinks  string
+	UpdatedAt    *time.Time
+}
+
+// pgSocialLink is the JSON form of a social link in the social_links column.
+type pgSocialLink struct {
+	Kind  string `json:"kind"`
+	URL   string `json:"url"`
+	Label string `json:"label,omitempty"`
+}
+
+func (p *pgProfile) toProto() (*portfolio_grpc.Profile, error) {
+	profile := &portfolio_grpc.Profile{
+		Name:         p.Name,
+		Headline:     p.Headline,
+		Bio:          p.Bio,
+		AvatarUrl:    p.AvatarURL,
+		Location:     p.Location,
+		ContactEmail: p.ContactEmail,
+		UpdatedAt:    utils.TimeToProtoTimestamp(p.UpdatedAt),
+	}
+
+	var links []pgSocialLink
+	if strings.TrimSpace(p.SocialLinks) != "" {
+		if err := json.Unmarshal([]byte(p.SocialLinks), &links); err != nil {
+			return nil, fmt.Errorf("profile has malformed social_links: %w", err)
+		}
+	}
+
+	for _, link := range links {
+		kind, ok := socialLinkKinds[link.Kind]
+		if !ok {
+			kind = portfolio_grpc.Profile_SocialLink_KIND_OTHER
+		}
+
+		profile.SocialLinks = append(profile.SocialLinks, &portfolio_grpc.Profile_SocialLink{
+			Kind:  kind,
+			Url:   link.URL,
+			Label: link.Label,
+		})
+	}
+
+	return profile, nil
+}
+
+// encodeSocialLinks encodes the social links of profile as stored in the
+// social_links column.
+func encodeSocialLinks(profile *portfolio_grpc.Profile) (string, error) {
+	links := make([]pgSocialLink, 0, len(profile.GetSocialLinks()))
+	for _, link := range profile.GetSocialLinks() {
+		kind := "other"
+		for name, value := range socialLinkKinds {
+			if value == link.Kind {
+				kind = name
+				break
+			}
+		}
+
+		links = append(links, pgSocialLink{Kind: kind, URL: link.Url, Label: link.Label})
+	}
+
+	raw, err := json.Marshal(links)
+	if err != nil {
+		return "", err
+	}
+
+	return string(raw), nil
+}
+
+type profileRepositoryImpl struct {
+	db        *sql.DB
+	tableName string
+	columns   columnSet[pgProfile]
+}
+
+func (p *profileRepositoryImpl) GetProfile(ctx context.Context, readMask []string) (*portfolio_grpc.Profile, error) {
+	columns := p.columns.selection(readMask)
+
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE id = $1", columns.sql(), p.tableName)
+	row := p.db.QueryRowContext(ctx, query, profileID)
+
+	return p.decodeProfile(row, columns)
+}
+
+func (p *profileRepositoryImpl) UpdateProfile(ctx context.Context, profile *portfolio_grpc.Profile, paths []string) (*portfolio_grpc.Profile, error) {
+	socialLinks, err := encodeSocialLinks(profile)
+	if err != nil {
+		return nil, err
+	}
+
+	setClause, args, err := buildSetClause(profileUpdateFields, paths, map[string]any{
+		"name":          profile.Name,
+		"headline":      profile.Headline,
+		"bio":           profile.Bio,
+		"avatar_url":    profile.AvatarUrl,
+		"location":      profile.Location,
+		"contact_email": profile.ContactEmail,
+		"social_links":  socialLinks,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	args = append(args, profileID)
+	query := fmt.Sprintf(`
+		UPDATE %s
+		SET %s
+		WHERE id = $%d
+		RETURNING %s`, p.tableName, setClause, len(args), p.columns.sql())
+
+	row := p.db.QueryRowContext(ctx, query, args...)
+
+	return p.decodeProfile(row, p.columns)
+}
+
+func (p *profileRepositoryImpl) decodeProfile(row rowScanner, columns columnSet[pgProfile]) (*portfolio_grpc.Profile, error) {
+	profile, err := columns.scan(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrProfileNotFound
+		}
+		return nil, fmt.Errorf("failed to scan profile: %w", err)
+	}
+
+	return profile.toProto()
+}
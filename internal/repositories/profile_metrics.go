@@ -0,0 +1,48 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc"
+	"github.com/jorgejr568/portfolio-grpc/internal/client/statsd"
+)
+
+type profileMetricsRepositoryImpl struct {
+	repo   ProfileRepository
+	statsd statsd.Client
+}
+
+func (p *profileMetricsRepositoryImpl) GetProfile(ctx context.Context, readMask []string) (*portfolio_grpc.Profile, error) {
+	stat := p.statsd.Start("profile", "GetProfile")
+	defer stat.Finished()
+
+	profile, err := p.repo.GetProfile(ctx, readMask)
+	if err != nil {
+		stat.FailedWithError(err)
+		return nil, err
+	}
+
+	stat.Succeeded()
+	return profile, nil
+}
+
+func (p *profileMetricsRepositoryImpl) UpdateProfile(ctx context.Context, profile *portfolio_grpc.Profile, paths []string) (*portfolio_grpc.Profile, error) {
+	stat := p.statsd.Start("profile", "UpdateProfile")
+	defer stat.Finished()
+
+	updated, err := p.repo.UpdateProfile(ctx, profile, paths)
+	if err != nil {
+		stat.FailedWithError(err)
+		return nil, err
+	}
+
+	stat.Succeeded()
+	return updated, nil
+}
+
+func newProfileMetricsRepository(repo ProfileRepository, statsdClient statsd.Client) ProfileRepository {
+	return &profileMetricsRepositoryImpl{
+		repo:   repo,
+		statsd: statsdClient,
+	}
+}
@@ -0,0 +1,24 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc"
+	"github.com/jorgejr568/portfolio-grpc/internal/client/statsd"
+)
+
+type ProfileRepository interface {
+	// GetProfile returns the profile.
+	GetProfile(ctx context.Context, readMask []string) (*portfolio_grpc.Profile, error)
+	// UpdateProfile updates the fields of profile selected by paths.
+	// Empty paths update every mutable field.
+	UpdateProfile(ctx context.Context, profile *portfolio_grpc.Profile, paths []string) (*portfolio_grpc.Profile, error)
+}
+
+func NewProfileRepository(db *sql.DB, client statsd.Client) ProfileRepository {
+	return newProfileMetricsRepository(
+		newProfileDBRepository(db),
+		client,
+	)
+}
@@ -0,0 +1,204 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc"
+	"github.com/jorgejr568/portfolio-grpc/internal/utils"
+)
+
+const (
+	projectsTableName = "projects"
+)
+
+var (
+	ErrProjectNotFound = errors.New("project not found")
+)
+
+func newProjectsDBRepository(db *sql.DB) ProjectsRepository {
+	return &projectsRepositoryImpl{
+		db:        db,
+		tableName: projectsTableName,
+		columns:   projectsColumns,
+	}
+}
+
+var projectsColumns = columnSet[pgProject]{
+	{expr: "id", paths: []string{"id"}, dest: func(p *pgProject) any { return &p.ID }},
+	{expr: "title", paths: []string{"title"}, dest: func(p *pgProject) any { return &p.Title }},
+	{expr: "description", paths: []string{"description"}, dest: func(p *pgProject) any { return &p.Description }},
+	{expr: "repo_url", paths: []string{"repo_url"}, dest: func(p *pgProject) any { return &p.RepoURL }},
+	{expr: "demo_url", paths: []string{"demo_url"}, dest: func(p *pgProject) any { return &p.DemoURL }},
+	{expr: "cover_image_url", paths: []string{"cover_image_url"}, dest: func(p *pgProject) any { return &p.CoverImageURL }},
+	{expr: "technologies", paths: []string{"technologies"}, dest: func(p *pgProject) any { return &p.Technologies }},
+	{expr: "started_at", paths: []string{"started_at"}, dest: func(p *pgProject) any { return &p.StartedAt }},
+	{expr: "finished_at as ended_at", paths: []string{"ended_at"}, dest: func(p *pgProject) any { return &p.EndedAt }},
+	{expr: "created_at", paths: []string{"created_at"}, dest: func(p *pgProject) any { return &p.CreatedAt }},
+	{expr: "updated_at", paths: []string{"updated_at"}, dest: func(p *pgProject) any { return &p.UpdatedAt }},
+}
+
+type pgProject struct {
+	ID            int64
+	Title         string
+	Description   string
+	RepoURL       string
+	DemoURL       string
+	CoverImageURL string
+	Technologies  string
+	StartedAt     *time.Time
+	EndedAt       *time.Time
+	CreatedAt     *time.Time
+	UpdatedAt     *time.Time
+}
+
+func (p *pgProject) toProto() (*portfolio_grpc.Project, error) {
+	technologies, err := decodeJSONList(p.Technologies)
+	if err != nil {
+		return nil, fmt.Errorf("project %d has malformed technologies: %w", p.ID, err)
+	}
+
+	return &portfolio_grpc.Project{
+		Id:            p.ID,
+		Title:         p.Title,
+		Description:   p.Description,
+		RepoUrl:       p.RepoURL,
+		DemoUrl:       p.DemoURL,
+		CoverImageUrl: p.CoverImageURL,
+		Technologies:  technologies,
+		StartedAt:     utils.TimeToProtoDate(p.StartedAt),
+		EndedAt:       utils.TimeToProtoDate(p.EndedAt),
+		CreatedAt:     utils.TimeToProtoTimestamp(p.CreatedAt),
+		UpdatedAt:     utils.TimeToProtoTimestamp(p.UpdatedAt),
+	}, nil
+}
+
+type projectsRepositoryImpl struct {
+	db        *sql.DB
+	tableName string
+	columns   columnSet[pgProject]
+}
+
+var projectsFilterFields = map[string]filterField{
+	"id":           {column: "p.id", kind: filterInt},
+	"title":        {column: "p.title", kind: filterString},
+	"description":  {column: "p.description", kind: filterString},
+	"technologies": {column: "p.technologies", kind: filterList},
+	"started_at":   {column: "p.started_at", kind: filterDate},
+	"ended_at":     {column: "p.finished_at", kind: filterDate},
+	"created_at":   {column: "p.created_at", kind: filterTimestamp},
+	"updated_at":   {column: "p.updated_at", kind: filterTimestamp},
+}
+
+var projectsSortFields = map[string]sortField[*portfolio_grpc.Project]{
+	"id": {column: "p.id", cast: "bigint", value: func(project *portfolio_grpc.Project) string {
+		return formatIDKey(project.Id)
+	}},
+	"title": {column: "p.title", cast: "text", value: func(project *portfolio_grpc.Project) string {
+		return project.Title
+	}},
+	"started_at": {column: "p.started_at", cast: "date", value: func(project *portfolio_grpc.Project) string {
+		return formatDateKey(project.StartedAt)
+	}, null: func(project *portfolio_grpc.Project) bool {
+		return project.StartedAt == nil
+	}},
+	"created_at": {column: "p.created_at", cast: "timestamptz", value: func(project *portfolio_grpc.Project) string {
+		return formatTimestampKey(project.CreatedAt)
+	}},
+	"updated_at": {column: "p.updated_at", cast: "timestamptz", value: func(project *portfolio_grpc.Project) string {
+		return formatTimestampKey(project.UpdatedAt)
+	}},
+}
+
+func (p *projectsRepositoryImpl) ListProjects(ctx context.Context, params ListProjectsParams) ([]*portfolio_grpc.Project, string, error) {
+	order, err := parseOrderBy(params.OrderBy, projectsSortFields, "started_at desc")
+	if err != nil {
+		return nil, "", err
+	}
+
+	fingerprint := queryFingerprint(params.Filter, params.OrderBy)
+	token, err := decodePageToken(params.PageToken, fingerprint, order.keys)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var args queryArgs
+	filter, err := compileFilter(params.Filter, projectsFilterFields, &args)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var conditions []string
+	if filter != "" {
+		conditions = append(conditions, filter)
+	}
+
+	if token != nil {
+		conditions = append(conditions, keysetCondition(&args, order.keys, token.Keys))
+	}
+
+	columns := p.columns.selection(params.ReadMask, order.fields...)
+	pageSize := normalizePageSize(params.PageSize)
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM %s p
+		%s
+		%s
+		LIMIT %s`, columns.sql(), p.tableName, whereClause(conditions), orderByClause(order.keys), args.bind(pageSize+1))
+
+	rows, err := p.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	projects := make([]*portfolio_grpc.Project, 0)
+	for rows.Next() {
+		project, err := p.decodeProject(rows, columns)
+		if err != nil {
+			return nil, "", err
+		}
+		projects = append(projects, project)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	projects, nextPageToken := paginate(projects, pageSize, fingerprint, order.keyValues)
+
+	return projects, nextPageToken, nil
+}
+
+func (p *projectsRepositoryImpl) GetProject(ctx context.Context, id int, readMask []string) (*portfolio_grpc.Project, error) {
+	columns := p.columns.selection(readMask, "id")
+
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM %s
+		WHERE id = $1`, columns.sql(), p.tableName)
+
+	row := p.db.QueryRowContext(ctx, query, id)
+
+	project, err := p.decodeProject(row, columns)
+	if err != nil {
+		return nil, err
+	}
+
+	return project, nil
+}
+
+func (p *projectsRepositoryImpl) decodeProject(row rowScanner, columns columnSet[pgProject]) (*portfolio_grpc.Project, error) {
+	project, err := columns.scan(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrProjectNotFound
+		}
+		return nil, fmt.Errorf("failed to scan project: %w", err)
+	}
+
+	return project.toProto()
+}
@@ -0,0 +1,48 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc"
+	"github.com/jorgejr568/portfolio-grpc/internal/client/statsd"
+)
+
+type projectsMetricsRepositoryImpl struct {
+	repo   ProjectsRepository
+	statsd statsd.Client
+}
+
+func (p *projectsMetricsRepositoryImpl) ListProjects(ctx context.Context, params ListProjectsParams) ([]*portfolio_grpc.Project, string, error) {
+	stat := p.statsd.Start("projects", "ListProjects")
+	defer stat.Finished()
+
+	projects, nextPageToken, err := p.repo.ListProjects(ctx, params)
+	if err != nil {
+		stat.FailedWithError(err)
+		return nil, "", err
+	}
+
+	stat.Succeeded()
+	return projects, nextPageToken, nil
+}
+
+func (p *projectsMetricsRepositoryImpl) GetProject(ctx context.Context, id int, readMask []string) (*portfolio_grpc.Project, error) {
+	stat := p.statsd.Start("projects", "GetProject")
+	defer stat.Finished()
+
+	project, err := p.repo.GetProject(ctx, id, readMask)
+	if err != nil {
+		stat.FailedWithError(err)
+		return nil, err
+	}
+
+	stat.Succeeded()
+	return project, nil
+}
+
+func newProjectsMetricsRepository(repo ProjectsRepository, statsdClient statsd.Client) ProjectsRepository {
+	return &projectsMetricsRepositoryImpl{
+		repo:   repo,
+		statsd: statsdClient,
+	}
+}
@@ -0,0 +1,35 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc"
+	"github.com/jorgejr568/portfolio-grpc/internal/client/statsd"
+)
+
+type ProjectsRepository interface {
+	// ListProjects returns a page of projects and the token of the next page,
+	// which is empty on the last page.
+	ListProjects(ctx context.Context, params ListProjectsParams) ([]*portfolio_grpc.Project, string, error)
+	// GetProject returns the project with the given id.
+	GetProject(ctx context.Context, id int, readMask []string) (*portfolio_grpc.Project, error)
+}
+
+type ListProjectsParams struct {
+	// Filter is an AIP-160 filter, see compileFilter.
+	Filter string
+	// OrderBy is an AIP-132 order_by such as "started_at desc".
+	OrderBy   string
+	PageSize  int
+	PageToken string
+	// ReadMask limits the fields read. Empty reads every field.
+	ReadMask []string
+}
+
+func NewProjectsRepository(db *sql.DB, client statsd.Client) ProjectsRepository {
+	return newProjectsMetricsRepository(
+		newProjectsDBRepository(db),
+		client,
+	)
+}
@@ -0,0 +1,213 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc"
+	"github.com/jorgejr568/portfolio-grpc/internal/utils"
+)
+
+const (
+	publicationsTableName = "publications"
+)
+
+var (
+	ErrPublicationNotFound = errors.New("publication not found")
+)
+
+// publicationKinds maps the kind column to its proto enum.
+var publicationKinds = map[string]portfolio_grpc.Publication_Kind{
+	"talk":    portfolio_grpc.Publication_KIND_TALK,
+	"article": portfolio_grpc.Publication_KIND_ARTICLE,
+	"paper":   portfolio_grpc.Publication_KIND_PAPER,
+}
+
+func newPublicationsDBRepository(db *sql.DB) PublicationsRepository {
+	return &publicationsRepositoryImpl{
+		db:        db,
+		tableName: publicationsTableName,
+		columns:   publicationsColumns,
+	}
+}
+
+var publicationsColumns = columnSet[pgPublication]{
+	{expr: "id", paths: []string{"id"}, dest: func(p *pgPublication) any { return &p.ID }},
+	{expr: "kind", paths: []string{"kind"}, dest: func(p *pgPublication) any { return &p.Kind }},
+	{expr: "title", paths: []string{"title"}, dest: func(p *pgPublication) any { return &p.Title }},
+	{expr: "description", paths: []string{"description"}, dest: func(p *pgPublication) any { return &p.Description }},
+	{expr: "venue", paths: []string{"venue"}, dest: func(p *pgPublication) any { return &p.Venue }},
+	{expr: "published_at", paths: []string{"published_at"}, dest: func(p *pgPublication) any { return &p.PublishedAt }},
+	{expr: "slides_url", paths: []string{"slides_url"}, dest: func(p *pgPublication) any { return &p.SlidesURL }},
+	{expr: "video_url", paths: []string{"video_url"}, dest: func(p *pgPublication) any { return &p.VideoURL }},
+	{expr: "co_authors", paths: []string{"co_authors"}, dest: func(p *pgPublication) any { return &p.CoAuthors }},
+	{expr: "created_at", paths: []string{"created_at"}, dest: func(p *pgPublication) any { return &p.CreatedAt }},
+	{expr: "updated_at", paths: []string{"updated_at"}, dest: func(p *pgPublication) any { return &p.UpdatedAt }},
+}
+
+type pgPublication struct {
+	ID          int64
+	Kind        string
+	Title       string
+	Description string
+	Venue       string
+	PublishedAt *time.Time
+	SlidesURL   string
+	VideoURL    string
+	CoAuthors   string
+	CreatedAt   *time.Time
+	UpdatedAt   *time.Time
+}
+
+func (p *pgPublication) toProto() (*portfolio_grpc.Publication, error) {
+	coAuthors, err := decodeJSONList(p.CoAuthors)
+	if err != nil {
+		return nil, fmt.Errorf("publication %d has malformed co_authors: %w", p.ID, err)
+	}
+
+	return &portfolio_grpc.Publication{
+		Id:          p.ID,
+		Kind:        publicationKinds[p.Kind],
+		Title:       p.Title,
+		Description: p.Description,
+		Venue:       p.Venue,
+		PublishedAt: utils.TimeToProtoDate(p.PublishedAt),
+		SlidesUrl:   p.SlidesURL,
+		VideoUrl:    p.VideoURL,
+		CoAuthors:   coAuthors,
+		CreatedAt:   utils.TimeToProtoTimestamp(p.CreatedAt),
+		UpdatedAt:   utils.TimeToProtoTimestamp(p.UpdatedAt),
+	}, nil
+}
+
+type publicationsRepositoryImpl struct {
+	db        *sql.DB
+	tableName string
+	columns   columnSet[pgPublication]
+}
+
+var publicationsFilterFields = map[string]filterField{
+	"id":           {column: "p.id", kind: filterInt},
+	"kind":         {column: "p.kind", kind: filterString},
+	"title":        {column: "p.title", kind: filterString},
+	"description":  {column: "p.description", kind: filterString},
+	"venue":        {column: "p.venue", kind: filterString},
+	"co_authors":   {column: "p.co_authors", kind: filterList},
+	"published_at": {column: "p.published_at", kind: filterDate},
+	"created_at":   {column: "p.created_at", kind: filterTimestamp},
+	"updated_at":   {column: "p.updated_at", kind: filterTimestamp},
+}
+
+var publicationsSortFields = map[string]sortField[*portfolio_grpc.Publication]{
+	"id": {column: "p.id", cast: "bigint", value: func(publication *portfolio_grpc.Publication) string {
+		return formatIDKey(publication.Id)
+	}},
+	"title": {column: "p.title", cast: "text", value: func(publication *portfolio_grpc.Publication) string {
+		return publication.Title
+	}},
+	"venue": {column: "p.venue", cast: "text", value: func(publication *portfolio_grpc.Publication) string {
+		return publication.Venue
+	}},
+	"published_at": {column: "p.published_at", cast: "date", value: func(publication *portfolio_grpc.Publication) string {
+		return formatDateKey(publication.PublishedAt)
+	}},
+	"created_at": {column: "p.created_at", cast: "timestamptz", value: func(publication *portfolio_grpc.Publication) string {
+		return formatTimestampKey(publication.CreatedAt)
+	}},
+	"updated_at": {column: "p.updated_at", cast: "timestamptz", value: func(publication *portfolio_grpc.Publication) string {
+		return formatTimestampKey(publication.UpdatedAt)
+	}},
+}
+
+func (p *publicationsRepositoryImpl) ListPublications(ctx context.Context, params ListPublicationsParams) ([]*portfolio_grpc.Publication, string, error) {
+	order, err := parseOrderBy(params.OrderBy, publicationsSortFields, "published_at desc")
+	if err != nil {
+		return nil, "", err
+	}
+
+	fingerprint := queryFingerprint(params.Filter, params.OrderBy)
+	token, err := decodePageToken(params.PageToken, fingerprint, order.keys)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var args queryArgs
+	filter, err := compileFilter(params.Filter, publicationsFilterFields, &args)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var conditions []string
+	if filter != "" {
+		conditions = append(conditions, filter)
+	}
+
+	if token != nil {
+		conditions = append(conditions, keysetCondition(&args, order.keys, token.Keys))
+	}
+
+	columns := p.columns.selection(params.ReadMask, order.fields...)
+	pageSize := normalizePageSize(params.PageSize)
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM %s p
+		%s
+		%s
+		LIMIT %s`, columns.sql(), p.tableName, whereClause(conditions), orderByClause(order.keys), args.bind(pageSize+1))
+
+	rows, err := p.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	publications := make([]*portfolio_grpc.Publication, 0)
+	for rows.Next() {
+		publication, err := p.decodePublication(rows, columns)
+		if err != nil {
+			return nil, "", err
+		}
+		publications = append(publications, publication)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	publications, nextPageToken := paginate(publications, pageSize, fingerprint, order.keyValues)
+
+	return publications, nextPageToken, nil
+}
+
+func (p *publicationsRepositoryImpl) GetPublication(ctx context.Context, id int, readMask []string) (*portfolio_grpc.Publication, error) {
+	columns := p.columns.selection(readMask, "id")
+
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM %s
+		WHERE id = $1`, columns.sql(), p.tableName)
+
+	row := p.db.QueryRowContext(ctx, query, id)
+
+	publication, err := p.decodePublication(row, columns)
+	if err != nil {
+		return nil, err
+	}
+
+	return publication, nil
+}
+
+func (p *publicationsRepositoryImpl) decodePublication(row rowScanner, columns columnSet[pgPublication]) (*portfolio_grpc.Publication, error) {
+	publication, err := columns.scan(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrPublicationNotFound
+		}
+		return nil, fmt.Errorf("failed to scan publication: %w", err)
+	}
+
+	return publication.toProto()
+}
@@ -0,0 +1,48 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc"
+	"github.com/jorgejr568/portfolio-grpc/internal/client/statsd"
+)
+
+type publicationsMetricsRepositoryImpl struct {
+	repo   PublicationsRepository
+	statsd statsd.Client
+}
+
+func (p *publicationsMetricsRepositoryImpl) ListPublications(ctx context.Context, params ListPublicationsParams) ([]*portfolio_grpc.Publication, string, error) {
+	stat := p.statsd.Start("publications", "ListPublications")
+	defer stat.Finished()
+
+	publications, nextPageToken, err := p.repo.ListPublications(ctx, params)
+	if err != nil {
+		stat.FailedWithError(err)
+		return nil, "", err
+	}
+
+	stat.Succeeded()
+	return publications, nextPageToken, nil
+}
+
+func (p *publicationsMetricsRepositoryImpl) GetPublication(ctx context.Context, id int, readMask []string) (*portfolio_grpc.Publication, error) {
+	stat := p.statsd.Start("publications", "GetPublication")
+	defer stat.Finished()
+
+	publication, err := p.repo.GetPublication(ctx, id, readMask)
+	if err != nil {
+		stat.FailedWithError(err)
+		return nil, err
+	}
+
+	stat.Succeeded()
+	return publication, nil
+}
+
+func newPublicationsMetricsRepository(repo PublicationsRepository, statsdClient statsd.Client) PublicationsRepository {
+	return &publicationsMetricsRepositoryImpl{
+		repo:   repo,
+		statsd: statsdClient,
+	}
+}
@@ -0,0 +1,35 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc"
+	"github.com/jorgejr568/portfolio-grpc/internal/client/statsd"
+)
+
+type PublicationsRepository interface {
+	// ListPublications returns a page of publications and the token of the
+	// next page, which is empty on the last page.
+	ListPublications(ctx context.Context, params ListPublicationsParams) ([]*portfolio_grpc.Publication, string, error)
+	// GetPublication returns the publication with the given id.
+	GetPublication(ctx context.Context, id int, readMask []string) (*portfolio_grpc.Publication, error)
+}
+
+type ListPublicationsParams struct {
+	// Filter is an AIP-160 filter, see compileFilter.
+	Filter string
+	// OrderBy is an AIP-132 order_by such as "published_at desc".
+	OrderBy   string
+	PageSize  int
+	PageToken string
+	// ReadMask limits the fields read. Empty reads every field.
+	ReadMask []string
+}
+
+func NewPublicationsRepository(db *sql.DB, client statsd.Client) PublicationsRepository {
+	return newPublicationsMetricsRepository(
+		newPublicationsDBRepository(db),
+		client,
+	)
+}
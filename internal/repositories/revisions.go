@@ -0,0 +1,229 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc"
+	"github.com/jorgejr568/portfolio-grpc/internal/auth"
+	"github.com/jorgejr568/portfolio-grpc/internal/utils"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	revisionsTableName = "revisions"
+)
+
+// Operations recorded in the revisions table.
+const (
+	revisionUpdate   = "update"
+	revisionDelete   = "delete"
+	revisionUndelete = "undelete"
+	revisionRestore  = "restore"
+)
+
+var (
+	ErrRevisionNotFound = errors.New("revision not found")
+	ErrMissingActor     = errors.New("revision has no actor")
+)
+
+// revisionOperations maps the operation stored in revisions to its proto
+// enum.
+var revisionOperations = map[string]portfolio_grpc.Revision_Operation{
+	revisionUpdate:   portfolio_grpc.Revision_OPERATION_UPDATE,
+	revisionDelete:   portfolio_grpc.Revision_OPERATION_DELETE,
+	revisionUndelete: portfolio_grpc.Revision_OPERATION_UNDELETE,
+	revisionRestore:  portfolio_grpc.Revision_OPERATION_RESTORE,
+}
+
+var revisionsSortFields = map[string]sortField[*portfolio_grpc.Revision]{
+	"id": {column: "id", cast: "bigint", value: func(revision *portfolio_grpc.Revision) string {
+		return formatIDKey(revision.Id)
+	}},
+}
+
+type ListRevisionsParams struct {
+	PageSize  int
+	PageToken string
+}
+
+type pgRevision struct {
+	ID        int64
+	EntityID  int64
+	Operation string
+	Actor     string
+	Snapshot  string
+	CreatedAt *time.Time
+}
+
+// revise runs write in a transaction and records the entity as it was
+// before, as read by lock, in a revision. Lock must lock the row it reads so
+// the revision matches what write changed.
+func revise[T proto.Message](
+	ctx context.Context,
+	db *sql.DB,
+	entityType string,
+	entityID int64,
+	operation string,
+	lock func(tx *sql.Tx) (T, error),
+	write func(tx *sql.Tx) (T, error),
+) (T, error) {
+	var zero T
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return zero, err
+	}
+	defer tx.Rollback()
+
+	prior, err := lock(tx)
+	if err != nil {
+		return zero, err
+	}
+
+	written, err := write(tx)
+	if err != nil {
+		return zero, err
+	}
+
+	if err := insertRevision(ctx, tx, entityType, entityID, operation, prior); err != nil {
+		return zero, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return zero, err
+	}
+
+	return written, nil
+}
+
+// insertRevision records prior, the entity before a change, along with the
+// admin making the change. Every revised RPC is admin only, so a change
+// without an admin is refused rather than recorded anonymously.
+func insertRevision(ctx context.Context, tx *sql.Tx, entityType string, entityID int64, operation string, prior proto.Message) error {
+	admin, ok := auth.FromContext(ctx)
+	if !ok {
+		return ErrMissingActor
+	}
+
+	snapshot, err := protojson.Marshal(prior)
+	if err != nil {
+		return fmt.Errorf("failed to encode revision: %w", err)
+	}
+
+	query := fmt.Sprintf(`
+		INSERT INTO %s (entity_type, entity_id, operation, actor, snapshot)
+		VALUES ($1, $2, $3, $4, $5)`, revisionsTableName)
+
+	_, err = tx.ExecContext(ctx, query, entityType, entityID, operation, admin.Name, string(snapshot))
+	return err
+}
+
+// loadRevision decodes the snapshot of a revision of the given entity into
+// snapshot.
+func loadRevision(ctx context.Context, tx *sql.Tx, entityType string, entityID int64, revisionID int64, snapshot proto.Message) error {
+	query := fmt.Sprintf(`
+		SELECT snapshot
+		FROM %s
+		WHERE id = $1 AND entity_type = $2 AND entity_id = $3`, revisionsTableName)
+
+	var raw string
+	if err := tx.QueryRowContext(ctx, query, revisionID, entityType, entityID).Scan(&raw); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrRevisionNotFound
+		}
+		return fmt.Errorf("failed to scan revision: %w", err)
+	}
+
+	if err := unmarshalSnapshot([]byte(raw), snapshot); err != nil {
+		return fmt.Errorf("revision %d has a malformed snapshot: %w", revisionID, err)
+	}
+
+	return nil
+}
+
+// listRevisions returns a page of the revisions of an entity, newest first.
+// setSnapshot decodes the stored snapshot into the revision.
+func listRevisions(
+	ctx context.Context,
+	db *sql.DB,
+	entityType string,
+	entityID int64,
+	params ListRevisionsParams,
+	setSnapshot func(revision *portfolio_grpc.Revision, snapshot []byte) error,
+) ([]*portfolio_grpc.Revision, string, error) {
+	order, err := parseOrderBy("", revisionsSortFields, "id desc")
+	if err != nil {
+		return nil, "", err
+	}
+
+	fingerprint := queryFingerprint(entityType, entityID)
+	token, err := decodePageToken(params.PageToken, fingerprint, order.keys)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var args queryArgs
+	conditions := []string{
+		"entity_type = " + args.bind(entityType),
+		"entity_id = " + args.bind(entityID),
+	}
+
+	if token != nil {
+		conditions = append(conditions, keysetCondition(&args, order.keys, token.Keys))
+	}
+
+	pageSize := normalizePageSize(params.PageSize)
+	query := fmt.Sprintf(`
+		SELECT id, entity_id, operation, actor, snapshot, created_at
+		FROM %s
+		%s
+		%s
+		LIMIT %s`, revisionsTableName, whereClause(conditions), orderByClause(order.keys), args.bind(pageSize+1))
+
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	revisions := make([]*portfolio_grpc.Revision, 0)
+	for rows.Next() {
+		var row pgRevision
+		if err := rows.Scan(&row.ID, &row.EntityID, &row.Operation, &row.Actor, &row.Snapshot, &row.CreatedAt); err != nil {
+			return nil, "", fmt.Errorf("failed to scan revision: %w", err)
+		}
+
+		revision := &portfolio_grpc.Revision{
+			Id:        row.ID,
+			EntityId:  row.EntityID,
+			Operation: revisionOperations[row.Operation],
+			Actor:     row.Actor,
+			CreatedAt: utils.TimeToProtoTimestamp(row.CreatedAt),
+		}
+
+		if err := setSnapshot(revision, []byte(row.Snapshot)); err != nil {
+			return nil, "", fmt.Errorf("revision %d has a malformed snapshot: %w", row.ID, err)
+		}
+
+		revisions = append(revisions, revision)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	revisions, nextPageToken := paginate(revisions, pageSize, fingerprint, order.keyValues)
+
+	return revisions, nextPageToken, nil
+}
+
+// unmarshalSnapshot decodes a revision snapshot, ignoring fields removed
+// since it was written.
+func unmarshalSnapshot(snapshot []byte, message proto.Message) error {
+	return protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(snapshot, message)
+}
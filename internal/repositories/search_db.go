@@ -0,0 +1,117 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc"
+)
+
+const (
+	searchConfig = "simple"
+
+	// The documents below must match the expression indexes created by
+	// migrations/0003_add_search_indexes.sql.
+	experiencesSearchDocument = `(
+		setweight(to_tsvector('simple', coalesce(e.title, '')), 'A') ||
+		setweight(to_tsvector('simple', coalesce(e.company_name, '')), 'A') ||
+		setweight(to_tsvector('simple', coalesce(e.description, '')), 'B'))`
+	educationsSearchDocument = `(
+		setweight(to_tsvector('simple', coalesce(e.title, '')), 'A') ||
+		setweight(to_tsvector('simple', coalesce(e.institution_name, '')), 'B'))`
+	skillsSearchDocument = `(
+		setweight(to_tsvector('simple', coalesce(s.title, '')), 'A'))`
+
+	searchHeadlineOptions = "StartSel=<mark>, StopSel=</mark>, MaxWords=25, MinWords=8, MaxFragments=2"
+)
+
+var searchHitTypes = map[string]portfolio_grpc.SearchHit_Type{
+	"skill":      portfolio_grpc.SearchHit_TYPE_SKILL,
+	"experience": portfolio_grpc.SearchHit_TYPE_EXPERIENCE,
+	"education":  portfolio_grpc.SearchHit_TYPE_EDUCATION,
+}
+
+func newSearchDBRepository(db *sql.DB) SearchRepository {
+	return &searchRepositoryImpl{
+		db: db,
+	}
+}
+
+type searchRepositoryImpl struct {
+	db *sql.DB
+}
+
+func (s *searchRepositoryImpl) Search(ctx context.Context, query string, limit int) ([]*portfolio_grpc.SearchHit, error) {
+	sqlQuery := fmt.Sprintf(`
+		WITH q AS (SELECT websearch_to_tsquery('%[1]s', $1) AS query)
+		SELECT type, id, title, snippet, rank
+		FROM (
+			SELECT 'experience' AS type, e.id, e.title,
+				ts_headline('%[1]s', %[10]s, q.query, $3) AS snippet,
+				ts_rank(%[2]s, q.query) AS rank
+			FROM %[5]s e, q
+			WHERE %[2]s @@ q.query AND e.deleted_at IS NULL AND %[8]s
+			UNION ALL
+			SELECT 'education', e.id, e.title,
+				ts_headline('%[1]s', %[11]s, q.query, $3),
+				ts_rank(%[3]s, q.query)
+			FROM %[6]s e, q
+			WHERE %[3]s @@ q.query AND e.deleted_at IS NULL AND %[8]s
+			UNION ALL
+			SELECT 'skill', s.id, s.title,
+				ts_headline('%[1]s', %[12]s, q.query, $3),
+				ts_rank(%[4]s, q.query)
+			FROM %[7]s s, q
+			WHERE %[4]s @@ q.query AND s.deleted_at IS NULL AND %[9]s
+		) hits
+		ORDER BY rank DESC, type, id
+		LIMIT $2`,
+		searchConfig,
+		experiencesSearchDocument,
+		educationsSearchDocument,
+		skillsSearchDocument,
+		experiencesTableName,
+		educationsTableName,
+		skillsTableName,
+		publishedCondition("e."),
+		publishedCondition("s."),
+		escapeHTML("concat_ws(' ', e.title, e.company_name, e.description)"),
+		escapeHTML("concat_ws(' ', e.title, e.institution_name)"),
+		escapeHTML("s.title"),
+	)
+
+	rows, err := s.db.QueryContext(ctx, sqlQuery, query, limit, searchHeadlineOptions)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	hits := make([]*portfolio_grpc.SearchHit, 0)
+	for rows.Next() {
+		var (
+			hitType string
+			hit     = new(portfolio_grpc.SearchHit)
+		)
+		if err := rows.Scan(&hitType, &hit.Id, &hit.Title, &hit.Snippet, &hit.Rank); err != nil {
+			return nil, fmt.Errorf("failed to scan search hit: %w", err)
+		}
+
+		hit.Type = searchHitTypes[hitType]
+		hits = append(hits, hit)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return hits, nil
+}
+
+// escapeHTML wraps the SQL text expression expr so it evaluates HTML
+// escaped. ts_headline adds the <mark> tags of the snippet but copies the
+// rest of the text as is, so the text is escaped before highlighting.
+// Quotes are left alone since snippets are never used in attributes.
+func escapeHTML(expr string) string {
+	return fmt.Sprintf("replace(replace(replace(%s, '&', '&amp;'), '<', '&lt;'), '>', '&gt;')", expr)
+}
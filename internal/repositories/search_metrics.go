@@ -0,0 +1,34 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc"
+	"github.com/jorgejr568/portfolio-grpc/internal/client/statsd"
+)
+
+type searchMetricsRepositoryImpl struct {
+	repo   SearchRepository
+	statsd statsd.Client
+}
+
+func (s *searchMetricsRepositoryImpl) Search(ctx context.Context, query string, limit int) ([]*portfolio_grpc.SearchHit, error) {
+	stat := s.statsd.Start("search", "Search")
+	defer stat.Finished()
+
+	hits, err := s.repo.Search(ctx, query, limit)
+	if err != nil {
+		stat.FailedWithError(err)
+		return nil, err
+	}
+
+	stat.Succeeded()
+	return hits, nil
+}
+
+func newSearchMetricsRepository(repo SearchRepository, statsdClient statsd.Client) SearchRepository {
+	return &searchMetricsRepositoryImpl{
+		repo:   repo,
+		statsd: statsdClient,
+	}
+}
@@ -0,0 +1,22 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc"
+	"github.com/jorgejr568/portfolio-grpc/internal/client/statsd"
+)
+
+type SearchRepository interface {
+	// Search runs a full-text query over skills, experiences and educations
+	// and returns at most limit hits ordered by relevance.
+	Search(ctx context.Context, query string, limit int) ([]*portfolio_grpc.SearchHit, error)
+}
+
+func NewSearchRepository(db *sql.DB, client statsd.Client) SearchRepository {
+	return newSearchMetricsRepository(
+		newSearchDBRepository(db),
+		client,
+	)
+}
@@ -4,60 +4,410 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"strconv"
 	"time"
 
 	"fmt"
 
 	"github.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc"
+	"github.com/jorgejr568/portfolio-grpc/internal/locale"
 	"github.com/jorgejr568/portfolio-grpc/internal/utils"
+	"github.com/lib/pq"
 )
 
 const (
-	skillsTableName = "skills"
+	skillsTableName          = "skills"
+	skillCategoriesTableName = "skill_categories"
 )
 
 var (
-	ErrSkillNotFound = errors.New("skill not found")
+	ErrSkillNotFound         = errors.New("skill not found")
+	ErrSkillCategoryNotFound = errors.New("skill category not found")
 	//errSkillMalformed = errors.New("skill malformed")
 	//errFailedToListSkills = errors.New("failed to list skills")
 	//errFailedToGetSkill = errors.New("failed to get skill")
 )
 
-func newSkillsDBRepository(db *sql.DB) SkillsRepository {
+func newSkillsDBRepository(db *sql.DB, negotiator *locale.Negotiator) SkillsRepository {
 	return &skillsRepositoryImpl{
-		db:        db,
-		tableName: skillsTableName,
+		db:                  db,
+		negotiator:          negotiator,
+		tableName:           skillsTableName,
+		categoriesTableName: skillCategoriesTableName,
+		columns:             skillsColumns,
 	}
 }
 
+var skillsColumns = columnSet[pgSkill]{
+	{expr: "s.id", paths: []string{"id"}, dest: func(p *pgSkill) any { return &p.ID }},
+	{expr: "s.title", paths: []string{"title"}, dest: func(p *pgSkill) any { return &p.Title }},
+	{expr: "s.level", paths: []string{"level"}, dest: func(p *pgSkill) any { return &p.Level }},
+	{expr: "s.created_at", paths: []string{"created_at"}, dest: func(p *pgSkill) any { return &p.CreatedAt }},
+	{expr: "s.updated_at", paths: []string{"updated_at"}, dest: func(p *pgSkill) any { return &p.UpdatedAt }},
+	{expr: "s.deleted_at", paths: []string{"deleted_at"}, dest: func(p *pgSkill) any { return &p.DeletedAt }},
+	{expr: "s.visibility", paths: []string{"visibility"}, dest: func(p *pgSkill) any { return &p.Visibility }},
+	{expr: "s.publish_at", paths: []string{"publish_at"}, dest: func(p *pgSkill) any { return &p.PublishAt }},
+	{expr: "c.id", paths: []string{"category.id"}, dest: func(p *pgSkill) any { return &p.CategoryID }},
+	{expr: "c.title", paths: []string{"category.title"}, dest: func(p *pgSkill) any { return &p.CategoryTitle }},
+}
+
+var skillsUpdateFields = []updateField{
+	{path: "title", columns: []string{"title"}},
+	{path: "level", columns: []string{"level"}},
+	{path: "category", columns: []string{"category_id"}},
+	{path: "category.id", columns: []string{"category_id"}},
+	{path: "visibility", columns: []string{"visibility"}},
+	{path: "publish_at", columns: []string{"publish_at"}},
+}
+
 type pgSkill struct {
-	ID        int64
-	Title     string
-	Level     int32
-	CreatedAt *time.Time
-	UpdatedAt *time.Time
+	ID            int64
+	Title         string
+	Level         int32
+	CreatedAt     *time.Time
+	UpdatedAt     *time.Time
+	DeletedAt     *time.Time
+	Visibility    string
+	PublishAt     *time.Time
+	CategoryID    *int64
+	CategoryTitle *string
 }
 
 func (p *pgSkill) toProto() *portfolio_grpc.Skill {
+	skill := &portfolio_grpc.Skill{
+		Id:         p.ID,
+		Title:      p.Title,
+		Level:      p.Level,
+		CreatedAt:  utils.TimeToProtoTimestamp(p.CreatedAt),
+		UpdatedAt:  utils.TimeToProtoTimestamp(p.UpdatedAt),
+		DeletedAt:  utils.TimeToProtoTimestamp(p.DeletedAt),
+		Visibility: visibilities[p.Visibility],
+		PublishAt:  utils.TimeToProtoTimestamp(p.PublishAt),
+	}
 
-	return &portfolio_grpc.Skill{
-		Id:        p.ID,
-		Title:     p.Title,
-		Level:     p.Level,
-		CreatedAt: utils.TimeToProtoTimestamp(p.CreatedAt),
-		UpdatedAt: utils.TimeToProtoTimestamp(p.UpdatedAt),
+	if p.CategoryID != nil {
+		skill.Category = &portfolio_grpc.Skill_Category{
+			Id: *p.CategoryID,
+		}
+
+		if p.CategoryTitle != nil {
+			skill.Category.Title = *p.CategoryTitle
+		}
 	}
+
+	return skill
 }
 
 type skillsRepositoryImpl struct {
-	db        *sql.DB
-	tableName string
+	db                  *sql.DB
+	negotiator          *locale.Negotiator
+	tableName           string
+	categoriesTableName string
+	columns             columnSet[pgSkill]
+}
+
+// fromClause joins source, aliased as s, with its category, aliased as c.
+func (s *skillsRepositoryImpl) fromClause(source string) string {
+	return fmt.Sprintf("%s s LEFT JOIN %s c ON c.id = s.category_id", source, s.categoriesTableName)
 }
 
-func (s *skillsRepositoryImpl) ListSkills(ctx context.Context) ([]*portfolio_grpc.Skill, error) {
+var skillsFilterFields = map[string]filterField{
+	"id":             {column: "s.id", kind: filterInt},
+	"title":          {column: "s.title", kind: filterString},
+	"level":          {column: "s.level", kind: filterInt},
+	"category_id":    {column: "s.category_id", kind: filterInt},
+	"category.title": {column: "c.title", kind: filterString},
+	"created_at":     {column: "s.created_at", kind: filterTimestamp},
+	"updated_at":     {column: "s.updated_at", kind: filterTimestamp},
+}
+
+var skillsSortFields = map[string]sortField[*portfolio_grpc.Skill]{
+	"id": {column: "s.id", cast: "bigint", value: func(skill *portfolio_grpc.Skill) string {
+		return formatIDKey(skill.Id)
+	}},
+	"title": {column: "s.title", cast: "text", value: func(skill *portfolio_grpc.Skill) string {
+		return skill.Title
+	}},
+	"level": {column: "s.level", cast: "integer", value: func(skill *portfolio_grpc.Skill) string {
+		return strconv.Itoa(int(skill.Level))
+	}},
+	"created_at": {column: "s.created_at", cast: "timestamptz", value: func(skill *portfolio_grpc.Skill) string {
+		return formatTimestampKey(skill.CreatedAt)
+	}},
+	"updated_at": {column: "s.updated_at", cast: "timestamptz", value: func(skill *portfolio_grpc.Skill) string {
+		return formatTimestampKey(skill.UpdatedAt)
+	}},
+}
+
+func (s *skillsRepositoryImpl) ListSkills(ctx context.Context, params ListSkillsParams) ([]*portfolio_grpc.Skill, string, error) {
+	order, err := parseOrderBy(params.OrderBy, skillsSortFields, "id")
+	if err != nil {
+		return nil, "", err
+	}
+
+	fingerprint := queryFingerprint(params.CategoryID, params.Filter, params.OrderBy, params.ShowDeleted, params.ShowUnpublished)
+	token, err := decodePageToken(params.PageToken, fingerprint, order.keys)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var (
+		args       queryArgs
+		conditions []string
+	)
+	if !params.ShowDeleted {
+		conditions = append(conditions, "s.deleted_at IS NULL")
+	}
+
+	if !params.ShowUnpublished {
+		conditions = append(conditions, publishedCondition("s."))
+	}
+
+	if params.CategoryID != 0 {
+		conditions = append(conditions, "s.category_id = "+args.bind(params.CategoryID))
+	}
+
+	filter, err := compileFilter(params.Filter, skillsFilterFields, &args)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if filter != "" {
+		conditions = append(conditions, filter)
+	}
+
+	if token != nil {
+		conditions = append(conditions, keysetCondition(&args, order.keys, token.Keys))
+	}
+
 	// Using constant table name is safe, but parameterized query is best practice
-	query := fmt.Sprintf("SELECT id, title, level, created_at, updated_at FROM %s LIMIT 1000", s.tableName)
-	rows, err := s.db.QueryContext(ctx, query)
+	columns := s.columns.selection(params.ReadMask, order.fields...)
+	pageSize := normalizePageSize(params.PageSize)
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM %s
+		%s
+		%s
+		LIMIT %s`, columns.sql(), s.fromClause(s.tableName), whereClause(conditions), orderByClause(order.keys), args.bind(pageSize+1))
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	skills := make([]*portfolio_grpc.Skill, 0)
+	for rows.Next() {
+		skill, err := s.decodeSkill(rows, columns)
+		if err != nil {
+			return nil, "", err
+		}
+		skills = append(skills, skill)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	skills, nextPageToken := paginate(skills, pageSize, fingerprint, order.keyValues)
+
+	// Localized after paginating so page tokens keep the stored values.
+	if err := s.localizeSkills(ctx, skills); err != nil {
+		return nil, "", err
+	}
+
+	return skills, nextPageToken, nil
+}
+
+func (s *skillsRepositoryImpl) GetSkill(ctx context.Context, id int, readMask []string, showDeleted, showUnpublished bool) (*portfolio_grpc.Skill, error) {
+	columns := s.columns.selection(readMask, "id")
+
+	conditions := []string{"s.id = $1"}
+	if !showDeleted {
+		conditions = append(conditions, "s.deleted_at IS NULL")
+	}
+
+	if !showUnpublished {
+		conditions = append(conditions, publishedCondition("s."))
+	}
+
+	// Use parameterized query to prevent SQL injection
+	query := fmt.Sprintf("SELECT %s FROM %s %s", columns.sql(), s.fromClause(s.tableName), whereClause(conditions))
+	row := s.db.QueryRowContext(ctx, query, id)
+
+	skill, err := s.decodeSkill(row, columns)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.localizeSkills(ctx, []*portfolio_grpc.Skill{skill}); err != nil {
+		return nil, err
+	}
+
+	return skill, nil
+}
+
+func (s *skillsRepositoryImpl) BatchGetSkills(ctx context.Context, ids []int64, readMask []string) ([]*portfolio_grpc.Skill, []int64, error) {
+	columns := s.columns.selection(readMask, "id")
+
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM %s
+		WHERE s.id = ANY($1) AND s.deleted_at IS NULL AND %s`, columns.sql(), s.fromClause(s.tableName), publishedCondition("s."))
+
+	rows, err := s.db.QueryContext(ctx, query, pq.Array(ids))
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	skills := make([]*portfolio_grpc.Skill, 0, len(ids))
+	for rows.Next() {
+		skill, err := s.decodeSkill(rows, columns)
+		if err != nil {
+			return nil, nil, err
+		}
+		skills = append(skills, skill)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	if err := s.localizeSkills(ctx, skills); err != nil {
+		return nil, nil, err
+	}
+
+	skills, missing := inRequestOrder(ids, skills, func(skill *portfolio_grpc.Skill) int64 {
+		return skill.Id
+	})
+
+	return skills, missing, nil
+}
+
+func (s *skillsRepositoryImpl) CreateSkill(ctx context.Context, skill *portfolio_grpc.Skill) (*portfolio_grpc.Skill, error) {
+	visibility, err := visibilityColumn(skill.Visibility)
+	if err != nil {
+		return nil, err
+	}
+
+	query := fmt.Sprintf(`
+		WITH written AS (
+			INSERT INTO %s (title, level, category_id, visibility, publish_at, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5, NOW(), NOW())
+			RETURNING *
+		)
+		SELECT %s FROM %s`, s.tableName, s.columns.sql(), s.fromClause("written"))
+
+	row := s.db.QueryRowContext(ctx, query,
+		skill.Title,
+		skill.Level,
+		categoryID(skill),
+		visibility,
+		utils.ProtoTimestampToTime(skill.PublishAt),
+	)
+
+	return s.decodeWrittenSkill(row)
+}
+
+func (s *skillsRepositoryImpl) UpdateSkill(ctx context.Context, skill *portfolio_grpc.Skill, paths []string) (*portfolio_grpc.Skill, error) {
+	return revise(ctx, s.db, "skill", skill.Id, revisionUpdate,
+		func(tx *sql.Tx) (*portfolio_grpc.Skill, error) {
+			return s.lockSkill(ctx, tx, skill.Id, false)
+		},
+		func(tx *sql.Tx) (*portfolio_grpc.Skill, error) {
+			return s.writeSkill(ctx, tx, skill, paths)
+		},
+	)
+}
+
+func (s *skillsRepositoryImpl) DeleteSkill(ctx context.Context, id int) error {
+	_, err := revise(ctx, s.db, "skill", int64(id), revisionDelete,
+		func(tx *sql.Tx) (*portfolio_grpc.Skill, error) {
+			return s.lockSkill(ctx, tx, int64(id), false)
+		},
+		func(tx *sql.Tx) (*portfolio_grpc.Skill, error) {
+			query := fmt.Sprintf("UPDATE %s SET deleted_at = NOW() WHERE id = $1", s.tableName)
+			_, err := tx.ExecContext(ctx, query, id)
+			return nil, err
+		},
+	)
+
+	return err
+}
+
+func (s *skillsRepositoryImpl) UndeleteSkill(ctx context.Context, id int) (*portfolio_grpc.Skill, error) {
+	return revise(ctx, s.db, "skill", int64(id), revisionUndelete,
+		func(tx *sql.Tx) (*portfolio_grpc.Skill, error) {
+			return s.lockSkill(ctx, tx, int64(id), true)
+		},
+		func(tx *sql.Tx) (*portfolio_grpc.Skill, error) {
+			query := fmt.Sprintf(`
+				WITH written AS (
+					UPDATE %s
+					SET deleted_at = NULL
+					WHERE id = $1
+					RETURNING *
+				)
+				SELECT %s FROM %s`, s.tableName, s.columns.sql(), s.fromClause("written"))
+
+			return s.decodeSkill(tx.QueryRowContext(ctx, query, id), s.columns)
+		},
+	)
+}
+
+func (s *skillsRepositoryImpl) ListSkillRevisions(ctx context.Context, skillID int, params ListRevisionsParams) ([]*portfolio_grpc.Revision, string, error) {
+	return listRevisions(ctx, s.db, "skill", int64(skillID), params, func(revision *portfolio_grpc.Revision, snapshot []byte) error {
+		skill := new(portfolio_grpc.Skill)
+		if err := unmarshalSnapshot(snapshot, skill); err != nil {
+			return err
+		}
+
+		revision.Snapshot = &portfolio_grpc.Revision_Skill{Skill: skill}
+		return nil
+	})
+}
+
+func (s *skillsRepositoryImpl) RestoreSkillRevision(ctx context.Context, skillID int, revisionID int64) (*portfolio_grpc.Skill, error) {
+	return revise(ctx, s.db, "skill", int64(skillID), revisionRestore,
+		func(tx *sql.Tx) (*portfolio_grpc.Skill, error) {
+			return s.lockSkill(ctx, tx, int64(skillID), false)
+		},
+		func(tx *sql.Tx) (*portfolio_grpc.Skill, error) {
+			snapshot := new(portfolio_grpc.Skill)
+			if err := loadRevision(ctx, tx, "skill", int64(skillID), revisionID, snapshot); err != nil {
+				return nil, err
+			}
+
+			snapshot.Id = int64(skillID)
+			return s.writeSkill(ctx, tx, snapshot, nil)
+		},
+	)
+}
+
+func (s *skillsRepositoryImpl) PurgeDeletedSkills(ctx context.Context, before time.Time) (int64, error) {
+	return purgeDeleted(ctx, s.db, s.tableName, "skill", before)
+}
+
+func (s *skillsRepositoryImpl) ListSkillsPublishedSince(ctx context.Context, since time.Time) ([]int64, time.Time, error) {
+	return listPublishedSince(ctx, s.db, s.tableName, since)
+}
+
+func (s *skillsRepositoryImpl) ListSkillsByExperience(ctx context.Context, experienceID int, showUnpublished bool) ([]*portfolio_grpc.Skill, error) {
+	conditions := []string{
+		fmt.Sprintf("s.id IN (SELECT skill_id FROM %s WHERE experience_id = $1)", experienceSkillsTableName),
+		"s.deleted_at IS NULL",
+	}
+	if !showUnpublished {
+		conditions = append(conditions, publishedCondition("s."))
+	}
+
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM %s
+		%s
+		ORDER BY s.level DESC, s.title, s.id`, s.columns.sql(), s.fromClause(s.tableName), whereClause(conditions))
+
+	rows, err := s.db.QueryContext(ctx, query, experienceID)
 	if err != nil {
 		return nil, err
 	}
@@ -65,7 +415,7 @@ func (s *skillsRepositoryImpl) ListSkills(ctx context.Context) ([]*portfolio_grp
 
 	skills := make([]*portfolio_grpc.Skill, 0)
 	for rows.Next() {
-		skill, err := s.decodeSkill(rows)
+		skill, err := s.decodeSkill(rows, s.columns)
 		if err != nil {
 			return nil, err
 		}
@@ -76,25 +426,98 @@ func (s *skillsRepositoryImpl) ListSkills(ctx context.Context) ([]*portfolio_grp
 		return nil, err
 	}
 
+	if err := s.localizeSkills(ctx, skills); err != nil {
+		return nil, err
+	}
+
 	return skills, nil
 }
 
-func (s *skillsRepositoryImpl) GetSkill(ctx context.Context, id int) (*portfolio_grpc.Skill, error) {
-	// Use parameterized query to prevent SQL injection
-	query := fmt.Sprintf("SELECT id, title, level, created_at, updated_at FROM %s WHERE id = $1", s.tableName)
-	row := s.db.QueryRowContext(ctx, query, id)
+func (s *skillsRepositoryImpl) ListSkillCategories(ctx context.Context) ([]*portfolio_grpc.Skill_Category, error) {
+	query := fmt.Sprintf("SELECT id, title FROM %s ORDER BY title", s.categoriesTableName)
+	rows, err := s.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	categories := make([]*portfolio_grpc.Skill_Category, 0)
+	for rows.Next() {
+		category := new(portfolio_grpc.Skill_Category)
+		if err := rows.Scan(&category.Id, &category.Title); err != nil {
+			return nil, fmt.Errorf("failed to scan skill category: %w", err)
+		}
+		categories = append(categories, category)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return categories, nil
+}
+
+// lockSkill reads the skill with the given id and locks it until tx ends.
+// Deleted selects whether a deleted or a live skill is expected.
+func (s *skillsRepositoryImpl) lockSkill(ctx context.Context, tx *sql.Tx, id int64, deleted bool) (*portfolio_grpc.Skill, error) {
+	condition := "s.deleted_at IS NULL"
+	if deleted {
+		condition = "s.deleted_at IS NOT NULL"
+	}
+
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM %s
+		WHERE s.id = $1 AND %s
+		FOR UPDATE OF s`, s.columns.sql(), s.fromClause(s.tableName), condition)
 
-	skill, err := s.decodeSkill(row)
+	return s.decodeSkill(tx.QueryRowContext(ctx, query, id), s.columns)
+}
+
+// writeSkill updates the fields of skill selected by paths within tx.
+func (s *skillsRepositoryImpl) writeSkill(ctx context.Context, tx *sql.Tx, skill *portfolio_grpc.Skill, paths []string) (*portfolio_grpc.Skill, error) {
+	visibility, err := visibilityColumn(skill.Visibility)
 	if err != nil {
 		return nil, err
 	}
 
-	return skill, nil
+	setClause, args, err := buildSetClause(skillsUpdateFields, paths, map[string]any{
+		"title":       skill.Title,
+		"level":       skill.Level,
+		"category_id": categoryID(skill),
+		"visibility":  visibility,
+		"publish_at":  utils.ProtoTimestampToTime(skill.PublishAt),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	args = append(args, skill.Id)
+	query := fmt.Sprintf(`
+		WITH written AS (
+			UPDATE %s
+			SET %s
+			WHERE id = $%d
+			RETURNING *
+		)
+		SELECT %s FROM %s`, s.tableName, setClause, len(args), s.columns.sql(), s.fromClause("written"))
+
+	return s.decodeWrittenSkill(tx.QueryRowContext(ctx, query, args...))
 }
 
-func (s *skillsRepositoryImpl) decodeSkill(row rowScanner) (*portfolio_grpc.Skill, error) {
-	skill := new(pgSkill)
-	err := row.Scan(&skill.ID, &skill.Title, &skill.Level, &skill.CreatedAt, &skill.UpdatedAt)
+// decodeWrittenSkill decodes the result of an insert or update, translating
+// a dangling category reference into ErrSkillCategoryNotFound.
+func (s *skillsRepositoryImpl) decodeWrittenSkill(row rowScanner) (*portfolio_grpc.Skill, error) {
+	skill, err := s.decodeSkill(row, s.columns)
+	if err != nil && isPgError(err, pgForeignKeyViolation) {
+		return nil, ErrSkillCategoryNotFound
+	}
+
+	return skill, err
+}
+
+func (s *skillsRepositoryImpl) decodeSkill(row rowScanner, columns columnSet[pgSkill]) (*portfolio_grpc.Skill, error) {
+	skill, err := columns.scan(row)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, ErrSkillNotFound
@@ -104,3 +527,28 @@ func (s *skillsRepositoryImpl) decodeSkill(row rowScanner) (*portfolio_grpc.Skil
 
 	return skill.toProto(), nil
 }
+
+// categoryID returns the category reference to store for skill, or nil when
+// the skill is uncategorized.
+func categoryID(skill *portfolio_grpc.Skill) *int64 {
+	if id := skill.GetCategory().GetId(); id != 0 {
+		return &id
+	}
+
+	return nil
+}
+
+func (s *skillsRepositoryImpl) localizeSkills(ctx context.Context, skills []*portfolio_grpc.Skill) error {
+	items := make([]translatable, len(skills))
+	for i, skill := range skills {
+		items[i] = translatable{
+			id: skill.Id,
+			fields: map[string]*string{
+				"title": &skill.Title,
+			},
+			locales: &skill.AvailableLocales,
+		}
+	}
+
+	return localize(ctx, s.db, s.negotiator, "skill", items)
+}
@@ -2,6 +2,7 @@ package repositories
 
 import (
 	"context"
+	"time"
 
 	"github.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc"
 	"github.com/jorgejr568/portfolio-grpc/internal/client/statsd"
@@ -12,25 +13,25 @@ type skillsMetricsRepositoryImpl struct {
 	statsd statsd.Client
 }
 
-func (s *skillsMetricsRepositoryImpl) ListSkills(ctx context.Context) ([]*portfolio_grpc.Skill, error) {
+func (s *skillsMetricsRepositoryImpl) ListSkills(ctx context.Context, params ListSkillsParams) ([]*portfolio_grpc.Skill, string, error) {
 	stat := s.statsd.Start("skills", "ListSkills")
 	defer stat.Finished()
 
-	skills, err := s.repo.ListSkills(ctx)
+	skills, nextPageToken, err := s.repo.ListSkills(ctx, params)
 	if err != nil {
 		stat.FailedWithError(err)
-		return nil, err
+		return nil, "", err
 	}
 
 	stat.Succeeded()
-	return skills, nil
+	return skills, nextPageToken, nil
 }
 
-func (s *skillsMetricsRepositoryImpl) GetSkill(ctx context.Context, id int) (*portfolio_grpc.Skill, error) {
+func (s *skillsMetricsRepositoryImpl) GetSkill(ctx context.Context, id int, readMask []string, showDeleted, showUnpublished bool) (*portfolio_grpc.Skill, error) {
 	stat := s.statsd.Start("skills", "GetSkill")
 	defer stat.Finished()
 
-	skill, err := s.repo.GetSkill(ctx, id)
+	skill, err := s.repo.GetSkill(ctx, id, readMask, showDeleted, showUnpublished)
 	if err != nil {
 		stat.FailedWithError(err)
 		return nil, err
@@ -40,6 +41,160 @@ func (s *skillsMetricsRepositoryImpl) GetSkill(ctx context.Context, id int) (*po
 	return skill, nil
 }
 
+func (s *skillsMetricsRepositoryImpl) BatchGetSkills(ctx context.Context, ids []int64, readMask []string) ([]*portfolio_grpc.Skill, []int64, error) {
+	stat := s.statsd.Start("skills", "BatchGetSkills")
+	defer stat.Finished()
+
+	skills, missing, err := s.repo.BatchGetSkills(ctx, ids, readMask)
+	if err != nil {
+		stat.FailedWithError(err)
+		return nil, nil, err
+	}
+
+	stat.Succeeded()
+	return skills, missing, nil
+}
+
+func (s *skillsMetricsRepositoryImpl) CreateSkill(ctx context.Context, skill *portfolio_grpc.Skill) (*portfolio_grpc.Skill, error) {
+	stat := s.statsd.Start("skills", "CreateSkill")
+	defer stat.Finished()
+
+	created, err := s.repo.CreateSkill(ctx, skill)
+	if err != nil {
+		stat.FailedWithError(err)
+		return nil, err
+	}
+
+	stat.Succeeded()
+	return created, nil
+}
+
+func (s *skillsMetricsRepositoryImpl) UpdateSkill(ctx context.Context, skill *portfolio_grpc.Skill, paths []string) (*portfolio_grpc.Skill, error) {
+	stat := s.statsd.Start("skills", "UpdateSkill")
+	defer stat.Finished()
+
+	updated, err := s.repo.UpdateSkill(ctx, skill, paths)
+	if err != nil {
+		stat.FailedWithError(err)
+		return nil, err
+	}
+
+	stat.Succeeded()
+	return updated, nil
+}
+
+func (s *skillsMetricsRepositoryImpl) DeleteSkill(ctx context.Context, id int) error {
+	stat := s.statsd.Start("skills", "DeleteSkill")
+	defer stat.Finished()
+
+	err := s.repo.DeleteSkill(ctx, id)
+	if err != nil {
+		stat.FailedWithError(err)
+		return err
+	}
+
+	stat.Succeeded()
+	return nil
+}
+
+func (s *skillsMetricsRepositoryImpl) UndeleteSkill(ctx context.Context, id int) (*portfolio_grpc.Skill, error) {
+	stat := s.statsd.Start("skills", "UndeleteSkill")
+	defer stat.Finished()
+
+	restored, err := s.repo.UndeleteSkill(ctx, id)
+	if err != nil {
+		stat.FailedWithError(err)
+		return nil, err
+	}
+
+	stat.Succeeded()
+	return restored, nil
+}
+
+func (s *skillsMetricsRepositoryImpl) PurgeDeletedSkills(ctx context.Context, before time.Time) (int64, error) {
+	stat := s.statsd.Start("skills", "PurgeDeletedSkills")
+	defer stat.Finished()
+
+	purged, err := s.repo.PurgeDeletedSkills(ctx, before)
+	if err != nil {
+		stat.FailedWithError(err)
+		return 0, err
+	}
+
+	stat.Succeeded()
+	return purged, nil
+}
+
+func (s *skillsMetricsRepositoryImpl) ListSkillsPublishedSince(ctx context.Context, since time.Time) ([]int64, time.Time, error) {
+	stat := s.statsd.Start("skills", "ListSkillsPublishedSince")
+	defer stat.Finished()
+
+	ids, checkedAt, err := s.repo.ListSkillsPublishedSince(ctx, since)
+	if err != nil {
+		stat.FailedWithError(err)
+		return nil, time.Time{}, err
+	}
+
+	stat.Succeeded()
+	return ids, checkedAt, nil
+}
+
+func (s *skillsMetricsRepositoryImpl) ListSkillRevisions(ctx context.Context, skillID int, params ListRevisionsParams) ([]*portfolio_grpc.Revision, string, error) {
+	stat := s.statsd.Start("skills", "ListSkillRevisions")
+	defer stat.Finished()
+
+	revisions, nextPageToken, err := s.repo.ListSkillRevisions(ctx, skillID, params)
+	if err != nil {
+		stat.FailedWithError(err)
+		return nil, "", err
+	}
+
+	stat.Succeeded()
+	return revisions, nextPageToken, nil
+}
+
+func (s *skillsMetricsRepositoryImpl) RestoreSkillRevision(ctx context.Context, skillID int, revisionID int64) (*portfolio_grpc.Skill, error) {
+	stat := s.statsd.Start("skills", "RestoreSkillRevision")
+	defer stat.Finished()
+
+	restored, err := s.repo.RestoreSkillRevision(ctx, skillID, revisionID)
+	if err != nil {
+		stat.FailedWithError(err)
+		return nil, err
+	}
+
+	stat.Succeeded()
+	return restored, nil
+}
+
+func (s *skillsMetricsRepositoryImpl) ListSkillsByExperience(ctx context.Context, experienceID int, showUnpublished bool) ([]*portfolio_grpc.Skill, error) {
+	stat := s.statsd.Start("skills", "ListSkillsByExperience")
+	defer stat.Finished()
+
+	skills, err := s.repo.ListSkillsByExperience(ctx, experienceID, showUnpublished)
+	if err != nil {
+		stat.FailedWithError(err)
+		return nil, err
+	}
+
+	stat.Succeeded()
+	return skills, nil
+}
+
+func (s *skillsMetricsRepositoryImpl) ListSkillCategories(ctx context.Context) ([]*portfolio_grpc.Skill_Category, error) {
+	stat := s.statsd.Start("skills", "ListSkillCategories")
+	defer stat.Finished()
+
+	categories, err := s.repo.ListSkillCategories(ctx)
+	if err != nil {
+		stat.FailedWithError(err)
+		return nil, err
+	}
+
+	stat.Succeeded()
+	return categories, nil
+}
+
 func newSkillsMetricsRepository(repo SkillsRepository, statsdClient statsd.Client) SkillsRepository {
 	return &skillsMetricsRepositoryImpl{
 		repo:   repo,
@@ -3,19 +3,74 @@ package repositories
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc"
 	"github.com/jorgejr568/portfolio-grpc/internal/client/statsd"
+	"github.com/jorgejr568/portfolio-grpc/internal/locale"
 )
 
 type SkillsRepository interface {
-	ListSkills(ctx context.Context) ([]*portfolio_grpc.Skill, error)
-	GetSkill(ctx context.Context, id int) (*portfolio_grpc.Skill, error)
+	// ListSkills returns a page of skills and the token of the next page,
+	// which is empty on the last page.
+	ListSkills(ctx context.Context, params ListSkillsParams) ([]*portfolio_grpc.Skill, string, error)
+	// GetSkill returns the skill with the given id. Deleted skills are only
+	// returned when showDeleted is set and skills that are not public when
+	// showUnpublished is.
+	GetSkill(ctx context.Context, id int, readMask []string, showDeleted, showUnpublished bool) (*portfolio_grpc.Skill, error)
+	// BatchGetSkills returns the skills with the given ids in the order of ids,
+	// along with the ids that were not found. Deleted skills and skills that
+	// are not public count as not found.
+	BatchGetSkills(ctx context.Context, ids []int64, readMask []string) ([]*portfolio_grpc.Skill, []int64, error)
+	CreateSkill(ctx context.Context, skill *portfolio_grpc.Skill) (*portfolio_grpc.Skill, error)
+	// UpdateSkill updates the fields of skill selected by paths. Empty paths
+	// update every mutable field.
+	UpdateSkill(ctx context.Context, skill *portfolio_grpc.Skill, paths []string) (*portfolio_grpc.Skill, error)
+	// DeleteSkill marks the skill as deleted. Deleted skills are hidden until
+	// restored by UndeleteSkill or removed by PurgeDeletedSkills.
+	DeleteSkill(ctx context.Context, id int) error
+	UndeleteSkill(ctx context.Context, id int) (*portfolio_grpc.Skill, error)
+	// PurgeDeletedSkills permanently removes the skills deleted before the
+	// given time and returns how many were removed.
+	PurgeDeletedSkills(ctx context.Context, before time.Time) (int64, error)
+	// ListSkillsPublishedSince returns the ids of the public skills whose
+	// publish_at passed after since, along with the database time they were
+	// checked at, which is the since of the next call.
+	ListSkillsPublishedSince(ctx context.Context, since time.Time) ([]int64, time.Time, error)
+	// ListSkillRevisions returns a page of the revisions of a skill, newest
+	// first, and the token of the next page.
+	ListSkillRevisions(ctx context.Context, skillID int, params ListRevisionsParams) ([]*portfolio_grpc.Revision, string, error)
+	// RestoreSkillRevision writes a skill back as it was before the revision,
+	// recording the replaced state as a new revision.
+	RestoreSkillRevision(ctx context.Context, skillID int, revisionID int64) (*portfolio_grpc.Skill, error)
+	// ListSkillsByExperience returns the skills among the technologies of an
+	// experience. Skills that are not public are only returned when
+	// showUnpublished is set.
+	ListSkillsByExperience(ctx context.Context, experienceID int, showUnpublished bool) ([]*portfolio_grpc.Skill, error)
+	ListSkillCategories(ctx context.Context) ([]*portfolio_grpc.Skill_Category, error)
 }
 
-func NewSkillsRepository(db *sql.DB, client statsd.Client) SkillsRepository {
+type ListSkillsParams struct {
+	// CategoryID restricts the result to a single category when non-zero.
+	CategoryID int64
+	// Filter is an AIP-160 filter, see compileFilter.
+	Filter string
+	// OrderBy is an AIP-132 order_by such as "level desc, title".
+	OrderBy   string
+	PageSize  int
+	PageToken string
+	// ReadMask limits the fields read. Empty reads every field.
+	ReadMask []string
+	// ShowDeleted includes deleted rows.
+	ShowDeleted bool
+	// ShowUnpublished includes drafts, archived rows and rows whose
+	// publish_at has not passed yet.
+	ShowUnpublished bool
+}
+
+func NewSkillsRepository(db *sql.DB, client statsd.Client, negotiator *locale.Negotiator) SkillsRepository {
 	return newSkillsMetricsRepository(
-		newSkillsDBRepository(db),
+		newSkillsDBRepository(db, negotiator),
 		client,
 	)
 }
@@ -0,0 +1,270 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc"
+	"github.com/jorgejr568/portfolio-grpc/internal/utils"
+)
+
+const (
+	testimonialsTableName = "testimonials"
+)
+
+var (
+	ErrTestimonialNotFound           = errors.New("testimonial not found")
+	ErrTestimonialExperienceNotFound = errors.New("testimonial experience not found")
+	ErrTestimonialRateLimited        = errors.New("too many testimonials")
+	ErrInvalidTestimonialState       = errors.New("invalid testimonial state")
+)
+
+// testimonialStates maps the state column to its proto enum.
+var testimonialStates = map[string]portfolio_grpc.Testimonial_State{
+	"pending":  portfolio_grpc.Testimonial_STATE_PENDING,
+	"approved": portfolio_grpc.Testimonial_STATE_APPROVED,
+	"rejected": portfolio_grpc.Testimonial_STATE_REJECTED,
+}
+
+func testimonialStateColumn(state portfolio_grpc.Testimonial_State) (string, error) {
+	for column, value := range testimonialStates {
+		if value == state {
+			return column, nil
+		}
+	}
+
+	return "", fmt.Errorf("%w: %s", ErrInvalidTestimonialState, state)
+}
+
+func newTestimonialsDBRepository(db *sql.DB) TestimonialsRepository {
+	return &testimonialsRepositoryImpl{
+		db:        db,
+		tableName: testimonialsTableName,
+		columns:   testimonialsColumns,
+	}
+}
+
+var testimonialsColumns = columnSet[pgTestimonial]{
+	{expr: "id", paths: []string{"id"}, dest: func(p *pgTestimonial) any { return &p.ID }},
+	{expr: "author_name", paths: []string{"author_name"}, dest: func(p *pgTestimonial) any { return &p.AuthorName }},
+	{expr: "author_role", paths: []string{"author_role"}, dest: func(p *pgTestimonial) any { return &p.AuthorRole }},
+	{expr: "relationship", paths: []string{"relationship"}, dest: func(p *pgTestimonial) any { return &p.Relationship }},
+	{expr: "content", paths: []string{"content"}, dest: func(p *pgTestimonial) any { return &p.Content }},
+	{expr: "experience_id", paths: []string{"experience_id"}, dest: func(p *pgTestimonial) any { return &p.ExperienceID }},
+	{expr: "state", paths: []string{"state"}, dest: func(p *pgTestimonial) any { return &p.State }},
+	{expr: "created_at", paths: []string{"created_at"}, dest: func(p *pgTestimonial) any { return &p.CreatedAt }},
+	{expr: "updated_at", paths: []string{"updated_at"}, dest: func(p *pgTestimonial) any { return &p.UpdatedAt }},
+}
+
+type pgTestimonial struct {
+	ID           int64
+	AuthorName   string
+	AuthorRole   string
+	Relationship string
+	Content      string
+	ExperienceID *int64
+	State        string
+	CreatedAt    *time.Time
+	UpdatedAt    *time.Time
+}
+
+func (p *pgTestimonial) toProto() *portfolio_grpc.Testimonial {
+	testimonial := &portfolio_grpc.Testimonial{
+		Id:           p.ID,
+		AuthorName:   p.AuthorName,
+		AuthorRole:   p.AuthorRole,
+		Relationship: p.Relationship,
+		Content:      p.Content,
+		State:        testimonialStates[p.State],
+		CreatedAt:    utils.TimeToProtoTimestamp(p.CreatedAt),
+		UpdatedAt:    utils.TimeToProtoTimestamp(p.UpdatedAt),
+	}
+
+	if p.ExperienceID != nil {
+		testimonial.ExperienceId = *p.ExperienceID
+	}
+
+	return testimonial
+}
+
+type testimonialsRepositoryImpl struct {
+	db        *sql.DB
+	tableName string
+	columns   columnSet[pgTestimonial]
+}
+
+var testimonialsSortFields = map[string]sortField[*portfolio_grpc.Testimonial]{
+	"id": {column: "id", cast: "bigint", value: func(testimonial *portfolio_grpc.Testimonial) string {
+		return formatIDKey(testimonial.Id)
+	}},
+	"created_at": {column: "created_at", cast: "timestamptz", value: func(testimonial *portfolio_grpc.Testimonial) string {
+		return formatTimestampKey(testimonial.CreatedAt)
+	}},
+}
+
+func (t *testimonialsRepositoryImpl) ListTestimonials(ctx context.Context, params ListTestimonialsParams) ([]*portfolio_grpc.Testimonial, string, error) {
+	state, err := testimonialStateColumn(params.State)
+	if err != nil {
+		return nil, "", err
+	}
+
+	order, err := parseOrderBy("", testimonialsSortFields, "created_at desc")
+	if err != nil {
+		return nil, "", err
+	}
+
+	fingerprint := queryFingerprint(state)
+	token, err := decodePageToken(params.PageToken, fingerprint, order.keys)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var args queryArgs
+	conditions := []string{"state = " + args.bind(state)}
+	if token != nil {
+		conditions = append(conditions, keysetCondition(&args, order.keys, token.Keys))
+	}
+
+	columns := t.columns.selection(params.ReadMask, order.fields...)
+	pageSize := normalizePageSize(params.PageSize)
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM %s
+		%s
+		%s
+		LIMIT %s`, columns.sql(), t.tableName, whereClause(conditions), orderByClause(order.keys), args.bind(pageSize+1))
+
+	rows, err := t.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	testimonials := make([]*portfolio_grpc.Testimonial, 0)
+	for rows.Next() {
+		testimonial, err := t.decodeTestimonial(rows, columns)
+		if err != nil {
+			return nil, "", err
+		}
+		testimonials = append(testimonials, testimonial)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	testimonials, nextPageToken := paginate(testimonials, pageSize, fingerprint, order.keyValues)
+
+	return testimonials, nextPageToken, nil
+}
+
+func (t *testimonialsRepositoryImpl) CreateTestimonial(ctx context.Context, testimonial *portfolio_grpc.Testimonial, senderIP string, limit int, since time.Time) (*portfolio_grpc.Testimonial, error) {
+	tx, err := t.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if senderIP != "" {
+		if err := advisoryXactLock(ctx, tx, "testimonials:ip:"+senderIP); err != nil {
+			return nil, err
+		}
+
+		countQuery := fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE sender_ip = $1 AND created_at >= $2", t.tableName)
+
+		var count int
+		if err := tx.QueryRowContext(ctx, countQuery, senderIP, since).Scan(&count); err != nil {
+			return nil, fmt.Errorf("failed to count testimonials: %w", err)
+		}
+
+		if count >= limit {
+			return nil, ErrTestimonialRateLimited
+		}
+	}
+
+	var experienceID *int64
+	if testimonial.ExperienceId != 0 {
+		experienceID = &testimonial.ExperienceId
+
+		// Drafts and deleted experiences are reported like missing ones, so
+		// anonymous callers cannot probe for them.
+		existsQuery := fmt.Sprintf(`
+			SELECT EXISTS (
+				SELECT 1
+				FROM %s e
+				WHERE e.id = $1 AND e.deleted_at IS NULL AND %s
+			)`, experiencesTableName, publishedCondition("e."))
+
+		var exists bool
+		if err := tx.QueryRowContext(ctx, existsQuery, testimonial.ExperienceId).Scan(&exists); err != nil {
+			return nil, fmt.Errorf("failed to check testimonial experience: %w", err)
+		}
+
+		if !exists {
+			return nil, ErrTestimonialExperienceNotFound
+		}
+	}
+
+	insertQuery := fmt.Sprintf(`
+		INSERT INTO %s (
+			author_name, author_role, relationship, content,
+			experience_id, sender_ip, state, created_at, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, 'pending', NOW(), NOW())
+		RETURNING %s`, t.tableName, t.columns.sql())
+
+	row := tx.QueryRowContext(ctx, insertQuery,
+		testimonial.AuthorName,
+		testimonial.AuthorRole,
+		testimonial.Relationship,
+		testimonial.Content,
+		experienceID,
+		senderIP,
+	)
+
+	created, err := t.decodeTestimonial(row, t.columns)
+	if err != nil {
+		if isPgError(err, pgForeignKeyViolation) {
+			return nil, ErrTestimonialExperienceNotFound
+		}
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (t *testimonialsRepositoryImpl) SetTestimonialState(ctx context.Context, id int, state portfolio_grpc.Testimonial_State) (*portfolio_grpc.Testimonial, error) {
+	column, err := testimonialStateColumn(state)
+	if err != nil {
+		return nil, err
+	}
+
+	query := fmt.Sprintf(`
+		UPDATE %s
+		SET state = $1, updated_at = NOW()
+		WHERE id = $2
+		RETURNING %s`, t.tableName, t.columns.sql())
+
+	row := t.db.QueryRowContext(ctx, query, column, id)
+
+	return t.decodeTestimonial(row, t.columns)
+}
+
+func (t *testimonialsRepositoryImpl) decodeTestimonial(row rowScanner, columns columnSet[pgTestimonial]) (*portfolio_grpc.Testimonial, error) {
+	testimonial, err := columns.scan(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrTestimonialNotFound
+		}
+		return nil, fmt.Errorf("failed to scan testimonial: %w", err)
+	}
+
+	return testimonial.toProto(), nil
+}
@@ -0,0 +1,63 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc"
+	"github.com/jorgejr568/portfolio-grpc/internal/client/statsd"
+)
+
+type testimonialsMetricsRepositoryImpl struct {
+	repo   TestimonialsRepository
+	statsd statsd.Client
+}
+
+func (t *testimonialsMetricsRepositoryImpl) ListTestimonials(ctx context.Context, params ListTestimonialsParams) ([]*portfolio_grpc.Testimonial, string, error) {
+	stat := t.statsd.Start("testimonials", "ListTestimonials")
+	defer stat.Finished()
+
+	testimonials, nextPageToken, err := t.repo.ListTestimonials(ctx, params)
+	if err != nil {
+		stat.FailedWithError(err)
+		return nil, "", err
+	}
+
+	stat.Succeeded()
+	return testimonials, nextPageToken, nil
+}
+
+func (t *testimonialsMetricsRepositoryImpl) CreateTestimonial(ctx context.Context, testimonial *portfolio_grpc.Testimonial, senderIP string, limit int, since time.Time) (*portfolio_grpc.Testimonial, error) {
+	stat := t.statsd.Start("testimonials", "CreateTestimonial")
+	defer stat.Finished()
+
+	created, err := t.repo.CreateTestimonial(ctx, testimonial, senderIP, limit, since)
+	if err != nil {
+		stat.FailedWithError(err)
+		return nil, err
+	}
+
+	stat.Succeeded()
+	return created, nil
+}
+
+func (t *testimonialsMetricsRepositoryImpl) SetTestimonialState(ctx context.Context, id int, state portfolio_grpc.Testimonial_State) (*portfolio_grpc.Testimonial, error) {
+	stat := t.statsd.Start("testimonials", "SetTestimonialState")
+	defer stat.Finished()
+
+	updated, err := t.repo.SetTestimonialState(ctx, id, state)
+	if err != nil {
+		stat.FailedWithError(err)
+		return nil, err
+	}
+
+	stat.Succeeded()
+	return updated, nil
+}
+
+func newTestimonialsMetricsRepository(repo TestimonialsRepository, statsdClient statsd.Client) TestimonialsRepository {
+	return &testimonialsMetricsRepositoryImpl{
+		repo:   repo,
+		statsd: statsdClient,
+	}
+}
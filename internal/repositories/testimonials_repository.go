@@ -0,0 +1,37 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc"
+	"github.com/jorgejr568/portfolio-grpc/internal/client/statsd"
+)
+
+type TestimonialsRepository interface {
+	// ListTestimonials returns a page of testimonials in the given state and
+	// the token of the next page, which is empty on the last page.
+	ListTestimonials(ctx context.Context, params ListTestimonialsParams) ([]*portfolio_grpc.Testimonial, string, error)
+	// CreateTestimonial stores a new testimonial in the pending state. It
+	// fails with ErrTestimonialRateLimited when senderIP already submitted
+	// limit testimonials since the given time, and with
+	// ErrTestimonialExperienceNotFound when the experience is not public.
+	CreateTestimonial(ctx context.Context, testimonial *portfolio_grpc.Testimonial, senderIP string, limit int, since time.Time) (*portfolio_grpc.Testimonial, error)
+	SetTestimonialState(ctx context.Context, id int, state portfolio_grpc.Testimonial_State) (*portfolio_grpc.Testimonial, error)
+}
+
+type ListTestimonialsParams struct {
+	State     portfolio_grpc.Testimonial_State
+	PageSize  int
+	PageToken string
+	// ReadMask limits the fields read. Empty reads every field.
+	ReadMask []string
+}
+
+func NewTestimonialsRepository(db *sql.DB, client statsd.Client) TestimonialsRepository {
+	return newTestimonialsMetricsRepository(
+		newTestimonialsDBRepository(db),
+		client,
+	)
+}
@@ -0,0 +1,116 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/jorgejr568/portfolio-grpc/internal/locale"
+	"github.com/lib/pq"
+)
+
+const (
+	translationsTableName = "translations"
+)
+
+// translatable is a loaded entity whose fields may be translated. Fields maps
+// a translatable field name to the value to overwrite, and locales receives
+// the locales the entity is available in.
+type translatable struct {
+	id      int64
+	fields  map[string]*string
+	locales *[]string
+}
+
+// localize rewrites items in the locale that best matches the request. The
+// entity columns hold the default locale; fields missing a translation keep
+// that value.
+func localize(ctx context.Context, db *sql.DB, negotiator *locale.Negotiator, entityType string, items []translatable) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, len(items))
+	for i, item := range items {
+		ids[i] = item.id
+	}
+
+	query := fmt.Sprintf(`
+		SELECT entity_id, locale, field, value
+		FROM %s
+		WHERE entity_type = $1 AND entity_id = ANY($2)`, translationsTableName)
+
+	rows, err := db.QueryContext(ctx, query, entityType, pq.Array(ids))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	// entity id -> locale -> field -> value
+	translations := make(map[int64]map[string]map[string]string)
+	for rows.Next() {
+		var (
+			id                int64
+			tag, field, value string
+		)
+		if err := rows.Scan(&id, &tag, &field, &value); err != nil {
+			return fmt.Errorf("failed to scan translation: %w", err)
+		}
+
+		if translations[id] == nil {
+			translations[id] = make(map[string]map[string]string)
+		}
+		if translations[id][tag] == nil {
+			translations[id][tag] = make(map[string]string)
+		}
+		translations[id][tag][field] = value
+	}
+
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		byLocale := translations[item.id]
+
+		available := []string{negotiator.Default()}
+		for tag := range byLocale {
+			if tag != negotiator.Default() {
+				available = append(available, tag)
+			}
+		}
+		sort.Strings(available[1:])
+		*item.locales = available
+
+		for field, value := range byLocale[negotiator.Best(ctx, available)] {
+			if target, ok := item.fields[field]; ok {
+				*target = value
+			}
+		}
+	}
+
+	return nil
+}
+
+// purgeDeleted permanently removes the rows of tableName deleted before the
+// given time along with their translations, which have no foreign key to
+// cascade from, and returns how many rows were removed. Both deletes run in
+// a single statement so neither happens without the other.
+func purgeDeleted(ctx context.Context, db *sql.DB, tableName, entityType string, before time.Time) (int64, error) {
+	query := fmt.Sprintf(`
+		WITH purged AS (
+			DELETE FROM %s WHERE deleted_at < $1 RETURNING id
+		), purged_translations AS (
+			DELETE FROM %s WHERE entity_type = $2 AND entity_id IN (SELECT id FROM purged)
+		)
+		SELECT COUNT(*) FROM purged`, tableName, translationsTableName)
+
+	var purged int64
+	if err := db.QueryRowContext(ctx, query, before, entityType).Scan(&purged); err != nil {
+		return 0, err
+	}
+
+	return purged, nil
+}
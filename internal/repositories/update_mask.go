@@ -0,0 +1,63 @@
+package repositories
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	ErrInvalidUpdateMask = errors.New("invalid update mask")
+)
+
+// updateField maps an update mask path to the columns it writes.
+type updateField struct {
+	path    string
+	columns []string
+}
+
+// buildSetClause builds the SET clause of an UPDATE statement for the given
+// update mask paths. An empty mask updates every field. Placeholders are
+// numbered from $1, so callers should append their own arguments after the
+// returned ones.
+func buildSetClause(fields []updateField, paths []string, values map[string]any) (string, []any, error) {
+	selected := fields
+	if len(paths) > 0 {
+		selected = make([]updateField, 0, len(paths))
+		for _, path := range paths {
+			field, ok := findUpdateField(fields, path)
+			if !ok {
+				return "", nil, fmt.Errorf("%w: unknown path %q", ErrInvalidUpdateMask, path)
+			}
+			selected = append(selected, field)
+		}
+	}
+
+	seen := make(map[string]bool)
+	assignments := make([]string, 0, len(selected)+1)
+	args := make([]any, 0, len(selected))
+	for _, field := range selected {
+		for _, column := range field.columns {
+			if seen[column] {
+				continue
+			}
+			seen[column] = true
+
+			args = append(args, values[column])
+			assignments = append(assignments, fmt.Sprintf("%s = $%d", column, len(args)))
+		}
+	}
+	assignments = append(assignments, "updated_at = NOW()")
+
+	return strings.Join(assignments, ", "), args, nil
+}
+
+func findUpdateField(fields []updateField, path string) (updateField, bool) {
+	for _, field := range fields {
+		if field.path == path {
+			return field, true
+		}
+	}
+
+	return updateField{}, false
+}
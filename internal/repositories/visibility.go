@@ -0,0 +1,71 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc"
+	"github.com/lib/pq"
+)
+
+var (
+	ErrInvalidVisibility = errors.New("invalid visibility")
+)
+
+// visibilities maps the visibility column to its proto enum.
+var visibilities = map[string]portfolio_grpc.Visibility{
+	"draft":     portfolio_grpc.Visibility_VISIBILITY_DRAFT,
+	"published": portfolio_grpc.Visibility_VISIBILITY_PUBLISHED,
+	"archived":  portfolio_grpc.Visibility_VISIBILITY_ARCHIVED,
+}
+
+// visibilityColumn returns the visibility column value of visibility. An
+// unspecified visibility is stored as published, which is what entries
+// written before visibility existed are.
+func visibilityColumn(visibility portfolio_grpc.Visibility) (string, error) {
+	if visibility == portfolio_grpc.Visibility_VISIBILITY_UNSPECIFIED {
+		return "published", nil
+	}
+
+	for column, value := range visibilities {
+		if value == visibility {
+			return column, nil
+		}
+	}
+
+	return "", fmt.Errorf("%w: %s", ErrInvalidVisibility, visibility)
+}
+
+// publishedCondition matches the rows public callers may see: published rows
+// whose publish_at, if any, has passed. Prefix qualifies the columns, e.g.
+// "s." for an aliased table.
+func publishedCondition(prefix string) string {
+	return fmt.Sprintf("%[1]svisibility = 'published' AND (%[1]spublish_at IS NULL OR %[1]spublish_at <= NOW())", prefix)
+}
+
+// listPublishedSince returns the ids of the public rows of table whose
+// publish_at passed after since, oldest first, along with the database time
+// they were checked at. Using the database clock, as publishedCondition does,
+// keeps consecutive calls from skipping or repeating rows.
+func listPublishedSince(ctx context.Context, db *sql.DB, table string, since time.Time) ([]int64, time.Time, error) {
+	query := fmt.Sprintf(`
+		SELECT NOW(), ARRAY(
+			SELECT id
+			FROM %s
+			WHERE deleted_at IS NULL AND %s AND publish_at > $1
+			ORDER BY publish_at, id
+		)`, table, publishedCondition(""))
+
+	var (
+		checkedAt time.Time
+		ids       pq.Int64Array
+	)
+	if err := db.QueryRowContext(ctx, query, since).Scan(&checkedAt, &ids); err != nil {
+		return nil, time.Time{}, err
+	}
+
+	return ids, checkedAt, nil
+}
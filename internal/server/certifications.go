@@ -0,0 +1,69 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc"
+	"github.com/jorgejr568/portfolio-grpc/internal/repositories"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *serverImpl) GetAllCertifications(ctx context.Context, request *portfolio_grpc.GetAllCertificationsRequest) (*portfolio_grpc.GetAllCertificationsResponse, error) {
+	if request.PageSize < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page_size must not be negative")
+	}
+
+	readMask, err := readMaskPaths(request.ReadMask, &portfolio_grpc.Certification{})
+	if err != nil {
+		return nil, err
+	}
+
+	certifications, nextPageToken, err := s.certificationsRepository.ListCertifications(ctx, repositories.ListCertificationsParams{
+		Filter:         request.Filter,
+		OrderBy:        request.OrderBy,
+		PageSize:       int(request.PageSize),
+		PageToken:      request.PageToken,
+		ExcludeExpired: request.ExcludeExpired,
+		ReadMask:       readMask,
+	})
+	if err != nil {
+		if isInvalidListRequest(err) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	for _, certification := range certifications {
+		applyReadMask(certification, readMask)
+	}
+
+	return &portfolio_grpc.GetAllCertificationsResponse{
+		Certifications: certifications,
+		NextPageToken:  nextPageToken,
+	}, nil
+}
+
+func (s *serverImpl) GetCertification(ctx context.Context, request *portfolio_grpc.GetCertificationRequest) (*portfolio_grpc.GetCertificationResponse, error) {
+	readMask, err := readMaskPaths(request.ReadMask, &portfolio_grpc.Certification{})
+	if err != nil {
+		return nil, err
+	}
+
+	certification, err := s.certificationsRepository.GetCertification(ctx, int(request.Id), readMask)
+	if err != nil {
+		if errors.Is(err, repositories.ErrCertificationNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	applyReadMask(certification, readMask)
+
+	return &portfolio_grpc.GetCertificationResponse{
+		Certification: certification,
+	}, nil
+}
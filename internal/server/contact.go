@@ -0,0 +1,92 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"github.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc"
+	"github.com/jorgejr568/portfolio-grpc/internal/client/mailer"
+	"github.com/jorgejr568/portfolio-grpc/internal/repositories"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// contactRateLimit is how many messages a sender, identified by IP
+	// address or email, may submit within contactRateWindow.
+	contactRateLimit  = 3
+	contactRateWindow = time.Hour
+)
+
+func (s *serverImpl) SubmitContactMessage(ctx context.Context, request *portfolio_grpc.SubmitContactMessageRequest) (*portfolio_grpc.SubmitContactMessageResponse, error) {
+	logger := ctxzap.Extract(ctx)
+
+	// Bots get the same answer as people so they cannot tell they were caught.
+	if request.Website != "" {
+		logger.Info("contact.honeypot")
+		return &portfolio_grpc.SubmitContactMessageResponse{}, nil
+	}
+
+	email, err := validateContactMessage(request)
+	if err != nil {
+		return nil, err
+	}
+
+	message, err := s.contactMessagesRepository.CreateContactMessage(ctx, &portfolio_grpc.ContactMessage{
+		Name:     strings.TrimSpace(request.Name),
+		Email:    email,
+		Subject:  strings.TrimSpace(request.Subject),
+		Message:  request.Message,
+		SenderIp: s.clientIP.Resolve(ctx),
+	}, contactRateLimit, time.Now().Add(-contactRateWindow))
+	if err != nil {
+		if errors.Is(err, repositories.ErrContactRateLimited) {
+			return nil, status.Error(codes.ResourceExhausted, "too many messages, try again later")
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// The message is already stored, so a delivery failure is logged rather
+	// than returned; undelivered messages keep an empty delivered_at.
+	if err := s.notifyContactMessage(ctx, message); err != nil {
+		logger.Error("contact.notify", zap.Int64("message_id", message.Id), zap.Error(err))
+	}
+
+	return &portfolio_grpc.SubmitContactMessageResponse{}, nil
+}
+
+// notifyContactMessage emails message to the contact address of the profile.
+func (s *serverImpl) notifyContactMessage(ctx context.Context, message *portfolio_grpc.ContactMessage) error {
+	profile, err := s.profileRepository.GetProfile(ctx, []string{"contact_email"})
+	if err != nil {
+		return err
+	}
+
+	if profile.ContactEmail == "" {
+		return fmt.Errorf("profile has no contact_email")
+	}
+
+	subject := message.Subject
+	if subject == "" {
+		subject = "New message from " + message.Name
+	}
+
+	err = s.mailer.Send(ctx, mailer.Message{
+		To:      []string{profile.ContactEmail},
+		ReplyTo: (&mail.Address{Name: message.Name, Address: message.Email}).String(),
+		Subject: "[Portfolio] " + subject,
+		Body:    fmt.Sprintf("From: %s <%s>\n\n%s\n", message.Name, message.Email, message.Message),
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.contactMessagesRepository.MarkContactMessageDelivered(ctx, int(message.Id))
+}
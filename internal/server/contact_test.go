@@ -0,0 +1,280 @@
+package server
+
+import (
+	"context"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc"
+	"github.com/jorgejr568/portfolio-grpc/internal/client/mailer"
+	"github.com/jorgejr568/portfolio-grpc/internal/client/mailer/mailertest"
+	"github.com/jorgejr568/portfolio-grpc/internal/clientip"
+	"github.com/jorgejr568/portfolio-grpc/internal/repositories"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// fakeContactMessages keeps contact messages in memory, applying the rate
+// limit the way the database repository does.
+type fakeContactMessages struct {
+	mu        sync.Mutex
+	messages  []*portfolio_grpc.ContactMessage
+	createdAt []time.Time
+	delivered map[int64]bool
+}
+
+func (f *fakeContactMessages) CreateContactMessage(_ context.Context, message *portfolio_grpc.ContactMessage, limit int, since time.Time) (*portfolio_grpc.ContactMessage, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	count := 0
+	for i, m := range f.messages {
+		sameSender := (m.SenderIp != "" && m.SenderIp == message.SenderIp) || strings.EqualFold(m.Email, message.Email)
+		if sameSender && !f.createdAt[i].Before(since) {
+			count++
+		}
+	}
+
+	if count >= limit {
+		return nil, repositories.ErrContactRateLimited
+	}
+
+	created := &portfolio_grpc.ContactMessage{
+		Id:       int64(len(f.messages) + 1),
+		Name:     message.Name,
+		Email:    message.Email,
+		Subject:  message.Subject,
+		Message:  message.Message,
+		SenderIp: message.SenderIp,
+	}
+	f.messages = append(f.messages, created)
+	f.createdAt = append(f.createdAt, time.Now())
+
+	return created, nil
+}
+
+func (f *fakeContactMessages) MarkContactMessageDelivered(_ context.Context, id int) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.delivered == nil {
+		f.delivered = make(map[int64]bool)
+	}
+	f.delivered[int64(id)] = true
+
+	return nil
+}
+
+type fakeProfile struct {
+	repositories.ProfileRepository
+	contactEmail string
+}
+
+func (f *fakeProfile) GetProfile(context.Context, []string) (*portfolio_grpc.Profile, error) {
+	return &portfolio_grpc.Profile{ContactEmail: f.contactEmail}, nil
+}
+
+func newContactServer(t *testing.T, relay *mailertest.Server) (*serverImpl, *fakeContactMessages) {
+	t.Helper()
+
+	mailerClient, err := mailer.New(mailer.Config{
+		Host: relay.Host(),
+		Port: relay.Port(),
+		From: "portfolio@example.com",
+	})
+	if err != nil {
+		t.Fatalf("mailer.New() error = %v", err)
+	}
+
+	resolver, err := clientip.ParseTrustedProxies("")
+	if err != nil {
+		t.Fatalf("ParseTrustedProxies() error = %v", err)
+	}
+
+	messages := &fakeContactMessages{}
+	return &serverImpl{
+		contactMessagesRepository: messages,
+		profileRepository:         &fakeProfile{contactEmail: "owner@example.com"},
+		mailer:                    mailerClient,
+		clientIP:                  resolver,
+	}, messages
+}
+
+func contextFrom(ip string) context.Context {
+	return peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 54321},
+	})
+}
+
+func TestSubmitContactMessage(t *testing.T) {
+	tests := []struct {
+		name        string
+		request     *portfolio_grpc.SubmitContactMessageRequest
+		wantCode    codes.Code
+		wantStored  bool
+		wantSubject string
+		wantData    []string
+	}{
+		{
+			name:        "delivered",
+			request:     &portfolio_grpc.SubmitContactMessageRequest{Name: " Ann ", Email: "ann@example.com", Subject: "Hello", Message: "Hi there\nBye"},
+			wantStored:  true,
+			wantSubject: "Subject: [Portfolio] Hello\r\n",
+			wantData: []string{
+				"To: owner@example.com\r\n",
+				"Reply-To: \"Ann\" <ann@example.com>\r\n",
+				"\r\n\r\nFrom: Ann <ann@example.com>\r\n\r\nHi there\r\nBye",
+			},
+		},
+		{
+			name:        "default subject",
+			request:     &portfolio_grpc.SubmitContactMessageRequest{Name: "Ann", Email: "ann@example.com", Message: "Hi"},
+			wantStored:  true,
+			wantSubject: "Subject: [Portfolio] New message from Ann\r\n",
+		},
+		{
+			name:        "name with an address",
+			request:     &portfolio_grpc.SubmitContactMessageRequest{Name: "x@evil.example,", Email: "ann@example.com", Message: "Hi"},
+			wantStored:  true,
+			wantSubject: "Subject: [Portfolio] New message from x@evil.example,\r\n",
+			wantData:    []string{"Reply-To: \"x@evil.example,\" <ann@example.com>\r\n"},
+		},
+		{
+			name:        "email with a display name",
+			request:     &portfolio_grpc.SubmitContactMessageRequest{Name: "Ann", Email: "A <ann@example.com>", Message: "Hi"},
+			wantStored:  true,
+			wantSubject: "Subject: [Portfolio] New message from Ann\r\n",
+			wantData:    []string{"Reply-To: \"Ann\" <ann@example.com>\r\n"},
+		},
+		{
+			name:    "honeypot",
+			request: &portfolio_grpc.SubmitContactMessageRequest{Name: "Bot", Email: "bot@example.com", Message: "Buy", Website: "https://spam.example.com"},
+		},
+		{
+			name:     "invalid email",
+			request:  &portfolio_grpc.SubmitContactMessageRequest{Name: "Ann", Email: "ann", Message: "Hi"},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "missing message",
+			request:  &portfolio_grpc.SubmitContactMessageRequest{Name: "Ann", Email: "ann@example.com", Message: "  "},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			relay := mailertest.NewServer(t)
+			s, messages := newContactServer(t, relay)
+
+			_, err := s.SubmitContactMessage(contextFrom("203.0.113.7"), tt.request)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("SubmitContactMessage() code = %v, want %v (err = %v)", code, tt.wantCode, err)
+			}
+
+			if stored := len(messages.messages) == 1; stored != tt.wantStored {
+				t.Fatalf("stored %d messages, want stored = %v", len(messages.messages), tt.wantStored)
+			}
+
+			sent := relay.Messages()
+			if !tt.wantStored {
+				if len(sent) != 0 {
+					t.Errorf("relay received %d messages, want none", len(sent))
+				}
+				return
+			}
+
+			stored := messages.messages[0]
+			if stored.Name != strings.TrimSpace(tt.request.Name) || stored.SenderIp != "203.0.113.7" {
+				t.Errorf("stored name %q from %q, want %q from %q", stored.Name, stored.SenderIp, strings.TrimSpace(tt.request.Name), "203.0.113.7")
+			}
+			if stored.Email != "ann@example.com" {
+				t.Errorf("stored email %q, want %q", stored.Email, "ann@example.com")
+			}
+			if !messages.delivered[stored.Id] {
+				t.Error("message was not marked delivered")
+			}
+
+			if len(sent) != 1 {
+				t.Fatalf("relay received %d messages, want 1", len(sent))
+			}
+			if strings.Join(sent[0].To, ",") != "owner@example.com" {
+				t.Errorf("RCPT TO = %v, want [owner@example.com]", sent[0].To)
+			}
+			for _, want := range append([]string{tt.wantSubject}, tt.wantData...) {
+				if !strings.Contains(sent[0].Data, want) {
+					t.Errorf("DATA = %q, want it to contain %q", sent[0].Data, want)
+				}
+			}
+		})
+	}
+}
+
+func TestSubmitContactMessageRateLimit(t *testing.T) {
+	tests := []struct {
+		name string
+		// second is sent after contactRateLimit messages like first.
+		first, second *portfolio_grpc.SubmitContactMessageRequest
+		firstIP       string
+		secondIP      string
+		wantCode      codes.Code
+	}{
+		{
+			name:     "same ip",
+			first:    &portfolio_grpc.SubmitContactMessageRequest{Name: "Ann", Email: "ann@example.com", Message: "Hi"},
+			second:   &portfolio_grpc.SubmitContactMessageRequest{Name: "Bob", Email: "bob@example.com", Message: "Hi"},
+			firstIP:  "203.0.113.7",
+			secondIP: "203.0.113.7",
+			wantCode: codes.ResourceExhausted,
+		},
+		{
+			name:     "same email",
+			first:    &portfolio_grpc.SubmitContactMessageRequest{Name: "Ann", Email: "ann@example.com", Message: "Hi"},
+			second:   &portfolio_grpc.SubmitContactMessageRequest{Name: "Ann", Email: "ANN@example.com", Message: "Hi"},
+			firstIP:  "203.0.113.7",
+			secondIP: "198.51.100.1",
+			wantCode: codes.ResourceExhausted,
+		},
+		{
+			name:     "other sender",
+			first:    &portfolio_grpc.SubmitContactMessageRequest{Name: "Ann", Email: "ann@example.com", Message: "Hi"},
+			second:   &portfolio_grpc.SubmitContactMessageRequest{Name: "Bob", Email: "bob@example.com", Message: "Hi"},
+			firstIP:  "203.0.113.7",
+			secondIP: "198.51.100.1",
+			wantCode: codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			relay := mailertest.NewServer(t)
+			s, messages := newContactServer(t, relay)
+
+			for i := 0; i < contactRateLimit; i++ {
+				if _, err := s.SubmitContactMessage(contextFrom(tt.firstIP), tt.first); err != nil {
+					t.Fatalf("SubmitContactMessage() #%d error = %v", i+1, err)
+				}
+			}
+
+			_, err := s.SubmitContactMessage(contextFrom(tt.secondIP), tt.second)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("SubmitContactMessage() over the limit code = %v, want %v (err = %v)", code, tt.wantCode, err)
+			}
+
+			wantSent := contactRateLimit
+			if tt.wantCode == codes.OK {
+				wantSent++
+			}
+			if len(messages.messages) != wantSent {
+				t.Errorf("stored %d messages, want %d", len(messages.messages), wantSent)
+			}
+			if sent := len(relay.Messages()); sent != wantSent {
+				t.Errorf("relay received %d messages, want %d", sent, wantSent)
+			}
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc"
+	"github.com/jorgejr568/portfolio-grpc/internal/repositories"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// portfolioPageSize is the page size used to drain each section. It matches
+// the largest page the repositories serve.
+const portfolioPageSize = 1000
+
+// portfolioSectionResult carries a loaded section back to GetPortfolio. Apply
+// is only called from the request goroutine, so sections never write to the
+// response concurrently.
+type portfolioSectionResult struct {
+	section portfolio_grpc.GetPortfolioResponse_Section
+	apply   func(response *portfolio_grpc.GetPortfolioResponse)
+	err     error
+}
+
+type portfolioSection struct {
+	section portfolio_grpc.GetPortfolioResponse_Section
+	fetch   func(ctx context.Context) (func(response *portfolio_grpc.GetPortfolioResponse), error)
+}
+
+func (s *serverImpl) GetPortfolio(ctx context.Context, request *portfolio_grpc.GetPortfolioRequest) (*portfolio_grpc.GetPortfolioResponse, error) {
+	stat := s.statsd.Start("portfolio", "GetPortfolio")
+	defer stat.Finished()
+
+	sections := []portfolioSection{
+		{section: portfolio_grpc.GetPortfolioResponse_SECTION_SKILLS, fetch: s.fetchPortfolioSkills},
+		{section: portfolio_grpc.GetPortfolioResponse_SECTION_EXPERIENCES, fetch: s.fetchPortfolioExperiences},
+		{section: portfolio_grpc.GetPortfolioResponse_SECTION_EDUCATIONS, fetch: s.fetchPortfolioEducations},
+	}
+
+	// Buffered so sections finishing after the deadline never block.
+	results := make(chan portfolioSectionResult, len(sections))
+	pending := make(map[portfolio_grpc.GetPortfolioResponse_Section]bool, len(sections))
+	for _, section := range sections {
+		pending[section.section] = true
+		go func() {
+			apply, err := section.fetch(ctx)
+			results <- portfolioSectionResult{section: section.section, apply: apply, err: err}
+		}()
+	}
+
+	response := &portfolio_grpc.GetPortfolioResponse{}
+	var firstErr error
+	for len(pending) > 0 && ctx.Err() == nil {
+		select {
+		case result := <-results:
+			delete(pending, result.section)
+			if result.err != nil {
+				if firstErr == nil {
+					firstErr = result.err
+				}
+				response.Errors = append(response.Errors, portfolioSectionError(result.section, result.err))
+				continue
+			}
+			result.apply(response)
+		case <-ctx.Done():
+		}
+	}
+
+	// Whatever is still pending ran out of time.
+	for _, section := range sections {
+		if pending[section.section] {
+			err := status.FromContextError(ctx.Err()).Err()
+			if firstErr == nil {
+				firstErr = err
+			}
+			response.Errors = append(response.Errors, portfolioSectionError(section.section, err))
+		}
+	}
+
+	if firstErr != nil {
+		stat.FailedWithError(firstErr)
+	} else {
+		stat.Succeeded()
+	}
+
+	return response, nil
+}
+
+func (s *serverImpl) fetchPortfolioSkills(ctx context.Context) (func(*portfolio_grpc.GetPortfolioResponse), error) {
+	skills, err := collectPages(func(pageToken string) ([]*portfolio_grpc.Skill, string, error) {
+		return s.skillsRepository.ListSkills(ctx, repositories.ListSkillsParams{
+			PageSize:  portfolioPageSize,
+			PageToken: pageToken,
+		})
+	})
+
+	return func(response *portfolio_grpc.GetPortfolioResponse) {
+		response.Skills = skills
+	}, err
+}
+
+func (s *serverImpl) fetchPortfolioExperiences(ctx context.Context) (func(*portfolio_grpc.GetPortfolioResponse), error) {
+	experiences, err := collectPages(func(pageToken string) ([]*portfolio_grpc.Experience, string, error) {
+		return s.experiencesRepository.ListExperiences(ctx, repositories.ListExperiencesParams{
+			PageSize:  portfolioPageSize,
+			PageToken: pageToken,
+		})
+	})
+
+	return func(response *portfolio_grpc.GetPortfolioResponse) {
+		response.Experiences = experiences
+	}, err
+}
+
+func (s *serverImpl) fetchPortfolioEducations(ctx context.Context) (func(*portfolio_grpc.GetPortfolioResponse), error) {
+	educations, err := collectPages(func(pageToken string) ([]*portfolio_grpc.Education, string, error) {
+		return s.educationsRepository.ListEducations(ctx, repositories.ListEducationsParams{
+			PageSize:  portfolioPageSize,
+			PageToken: pageToken,
+		})
+	})
+
+	return func(response *portfolio_grpc.GetPortfolioResponse) {
+		response.Educations = educations
+	}, err
+}
+
+// collectPages calls list until the last page and returns every item.
+func collectPages[T any](list func(pageToken string) ([]T, string, error)) ([]T, error) {
+	var (
+		items     []T
+		pageToken string
+	)
+	for {
+		page, nextPageToken, err := list(pageToken)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, page...)
+		if nextPageToken == "" {
+			return items, nil
+		}
+		pageToken = nextPageToken
+	}
+}
+
+func portfolioSectionError(section portfolio_grpc.GetPortfolioResponse_Section, err error) *portfolio_grpc.GetPortfolioResponse_SectionError {
+	code := codes.Internal
+	if st, ok := status.FromError(err); ok {
+		code = st.Code()
+	} else if errors.Is(err, context.DeadlineExceeded) {
+		code = codes.DeadlineExceeded
+	} else if errors.Is(err, context.Canceled) {
+		code = codes.Canceled
+	}
+
+	return &portfolio_grpc.GetPortfolioResponse_SectionError{
+		Section: section,
+		Code:    int32(code),
+		Message: err.Error(),
+	}
+}
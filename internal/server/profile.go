@@ -0,0 +1,57 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc"
+	"github.com/jorgejr568/portfolio-grpc/internal/repositories"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *serverImpl) GetProfile(ctx context.Context, request *portfolio_grpc.GetProfileRequest) (*portfolio_grpc.GetProfileResponse, error) {
+	readMask, err := readMaskPaths(request.ReadMask, &portfolio_grpc.Profile{})
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := s.profileRepository.GetProfile(ctx, readMask)
+	if err != nil {
+		if errors.Is(err, repositories.ErrProfileNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	applyReadMask(profile, readMask)
+
+	return &portfolio_grpc.GetProfileResponse{
+		Profile: profile,
+	}, nil
+}
+
+func (s *serverImpl) UpdateProfile(ctx context.Context, request *portfolio_grpc.UpdateProfileRequest) (*portfolio_grpc.UpdateProfileResponse, error) {
+	paths := request.GetUpdateMask().GetPaths()
+	if err := validateProfile(request.Profile, paths); err != nil {
+		return nil, err
+	}
+
+	profile, err := s.profileRepository.UpdateProfile(ctx, request.Profile, paths)
+	if err != nil {
+		if errors.Is(err, repositories.ErrProfileNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+
+		if errors.Is(err, repositories.ErrInvalidUpdateMask) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &portfolio_grpc.UpdateProfileResponse{
+		Profile: profile,
+	}, nil
+}
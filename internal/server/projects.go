@@ -0,0 +1,68 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc"
+	"github.com/jorgejr568/portfolio-grpc/internal/repositories"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *serverImpl) GetAllProjects(ctx context.Context, request *portfolio_grpc.GetAllProjectsRequest) (*portfolio_grpc.GetAllProjectsResponse, error) {
+	if request.PageSize < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page_size must not be negative")
+	}
+
+	readMask, err := readMaskPaths(request.ReadMask, &portfolio_grpc.Project{})
+	if err != nil {
+		return nil, err
+	}
+
+	projects, nextPageToken, err := s.projectsRepository.ListProjects(ctx, repositories.ListProjectsParams{
+		Filter:    request.Filter,
+		OrderBy:   request.OrderBy,
+		PageSize:  int(request.PageSize),
+		PageToken: request.PageToken,
+		ReadMask:  readMask,
+	})
+	if err != nil {
+		if isInvalidListRequest(err) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	for _, project := range projects {
+		applyReadMask(project, readMask)
+	}
+
+	return &portfolio_grpc.GetAllProjectsResponse{
+		Projects:      projects,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (s *serverImpl) GetProject(ctx context.Context, request *portfolio_grpc.GetProjectRequest) (*portfolio_grpc.GetProjectResponse, error) {
+	readMask, err := readMaskPaths(request.ReadMask, &portfolio_grpc.Project{})
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := s.projectsRepository.GetProject(ctx, int(request.Id), readMask)
+	if err != nil {
+		if errors.Is(err, repositories.ErrProjectNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	applyReadMask(project, readMask)
+
+	return &portfolio_grpc.GetProjectResponse{
+		Project: project,
+	}, nil
+}
@@ -0,0 +1,68 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc"
+	"github.com/jorgejr568/portfolio-grpc/internal/repositories"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *serverImpl) GetAllPublications(ctx context.Context, request *portfolio_grpc.GetAllPublicationsRequest) (*portfolio_grpc.GetAllPublicationsResponse, error) {
+	if request.PageSize < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page_size must not be negative")
+	}
+
+	readMask, err := readMaskPaths(request.ReadMask, &portfolio_grpc.Publication{})
+	if err != nil {
+		return nil, err
+	}
+
+	publications, nextPageToken, err := s.publicationsRepository.ListPublications(ctx, repositories.ListPublicationsParams{
+		Filter:    request.Filter,
+		OrderBy:   request.OrderBy,
+		PageSize:  int(request.PageSize),
+		PageToken: request.PageToken,
+		ReadMask:  readMask,
+	})
+	if err != nil {
+		if isInvalidListRequest(err) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	for _, publication := range publications {
+		applyReadMask(publication, readMask)
+	}
+
+	return &portfolio_grpc.GetAllPublicationsResponse{
+		Publications:  publications,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (s *serverImpl) GetPublication(ctx context.Context, request *portfolio_grpc.GetPublicationRequest) (*portfolio_grpc.GetPublicationResponse, error) {
+	readMask, err := readMaskPaths(request.ReadMask, &portfolio_grpc.Publication{})
+	if err != nil {
+		return nil, err
+	}
+
+	publication, err := s.publicationsRepository.GetPublication(ctx, int(request.Id), readMask)
+	if err != nil {
+		if errors.Is(err, repositories.ErrPublicationNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	applyReadMask(publication, readMask)
+
+	return &portfolio_grpc.GetPublicationResponse{
+		Publication: publication,
+	}, nil
+}
@@ -0,0 +1,88 @@
+package server
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// readMaskPaths returns the paths of a read mask applying to messages of the
+// same type as message. A nil or empty mask returns no paths, which reads
+// every field.
+func readMaskPaths(mask *fieldmaskpb.FieldMask, message proto.Message) ([]string, error) {
+	if len(mask.GetPaths()) == 0 {
+		return nil, nil
+	}
+
+	if !mask.IsValid(message) {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid read_mask %q", strings.Join(mask.GetPaths(), ",")))
+	}
+
+	return mask.GetPaths(), nil
+}
+
+// applyReadMask clears every field of message that is not selected by paths.
+// Empty paths keep the whole message.
+func applyReadMask(message proto.Message, paths []string) {
+	if len(paths) == 0 || message == nil {
+		return
+	}
+
+	tree := make(readMaskTree)
+	for _, path := range paths {
+		tree.add(strings.Split(path, "."))
+	}
+
+	tree.prune(message.ProtoReflect())
+}
+
+// readMaskTree holds the selected fields of a message. A field mapped to an
+// empty tree is selected as a whole.
+type readMaskTree map[string]readMaskTree
+
+func (t readMaskTree) add(path []string) {
+	child, ok := t[path[0]]
+	if ok && len(child) == 0 {
+		// Already selected as a whole.
+		return
+	}
+
+	if len(path) == 1 {
+		t[path[0]] = readMaskTree{}
+		return
+	}
+
+	if !ok {
+		child = make(readMaskTree)
+		t[path[0]] = child
+	}
+	child.add(path[1:])
+}
+
+func (t readMaskTree) prune(message protoreflect.Message) {
+	var cleared []protoreflect.FieldDescriptor
+	message.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		child, ok := t[string(field.Name())]
+		switch {
+		case !ok:
+			cleared = append(cleared, field)
+		case len(child) > 0 && field.IsList() && field.Message() != nil:
+			list := value.List()
+			for i := 0; i < list.Len(); i++ {
+				child.prune(list.Get(i).Message())
+			}
+		case len(child) > 0 && field.Message() != nil && !field.IsMap():
+			child.prune(value.Message())
+		}
+		return true
+	})
+
+	for _, field := range cleared {
+		message.Clear(field)
+	}
+}
@@ -0,0 +1,132 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc"
+	"google.golang.org/genproto/googleapis/type/date"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func testExperience() *portfolio_grpc.Experience {
+	return &portfolio_grpc.Experience{
+		Id:          7,
+		Title:       "Engineer",
+		Description: "Built things",
+		Company: &portfolio_grpc.Experience_Company{
+			Name:    "Acme",
+			Url:     "https://acme.example.com",
+			LogoUrl: "https://acme.example.com/logo.png",
+		},
+		Technologies: []string{"Go", "gRPC"},
+		StartedAt:    &date.Date{Year: 2020, Month: 1, Day: 1},
+		TechnologyDetails: []*portfolio_grpc.Experience_Technology{
+			{Name: "Go", Kind: portfolio_grpc.Experience_Technology_KIND_LANGUAGE},
+			{Name: "gRPC", Kind: portfolio_grpc.Experience_Technology_KIND_FRAMEWORK},
+		},
+	}
+}
+
+func TestApplyReadMask(t *testing.T) {
+	tests := []struct {
+		name  string
+		paths []string
+		want  *portfolio_grpc.Experience
+	}{
+		{name: "no paths", paths: nil, want: testExperience()},
+		{
+			name:  "top level fields",
+			paths: []string{"id", "title"},
+			want:  &portfolio_grpc.Experience{Id: 7, Title: "Engineer"},
+		},
+		{
+			name:  "whole message field",
+			paths: []string{"company"},
+			want:  &portfolio_grpc.Experience{Company: testExperience().Company},
+		},
+		{
+			name:  "subfield",
+			paths: []string{"id", "company.name"},
+			want:  &portfolio_grpc.Experience{Id: 7, Company: &portfolio_grpc.Experience_Company{Name: "Acme"}},
+		},
+		{
+			name:  "subfield after whole field",
+			paths: []string{"company", "company.name"},
+			want:  &portfolio_grpc.Experience{Company: testExperience().Company},
+		},
+		{
+			name:  "whole field after subfield",
+			paths: []string{"company.name", "company"},
+			want:  &portfolio_grpc.Experience{Company: testExperience().Company},
+		},
+		{
+			name:  "subfield of repeated message",
+			paths: []string{"technology_details.name"},
+			want: &portfolio_grpc.Experience{TechnologyDetails: []*portfolio_grpc.Experience_Technology{
+				{Name: "Go"},
+				{Name: "gRPC"},
+			}},
+		},
+		{
+			name:  "repeated scalar and date",
+			paths: []string{"technologies", "started_at"},
+			want:  &portfolio_grpc.Experience{Technologies: []string{"Go", "gRPC"}, StartedAt: &date.Date{Year: 2020, Month: 1, Day: 1}},
+		},
+		{
+			name:  "unset field",
+			paths: []string{"ended_at"},
+			want:  &portfolio_grpc.Experience{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := testExperience()
+			applyReadMask(got, tt.paths)
+			if !proto.Equal(got, tt.want) {
+				t.Errorf("applyReadMask(%v) = %v, want %v", tt.paths, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyReadMaskNil(t *testing.T) {
+	var experience *portfolio_grpc.Experience
+	applyReadMask(nil, []string{"id"})
+	applyReadMask(experience, []string{"id"})
+}
+
+func TestReadMaskPaths(t *testing.T) {
+	tests := []struct {
+		name     string
+		mask     *fieldmaskpb.FieldMask
+		want     []string
+		wantCode codes.Code
+	}{
+		{name: "nil", mask: nil, want: nil},
+		{name: "empty", mask: &fieldmaskpb.FieldMask{}, want: nil},
+		{name: "valid", mask: &fieldmaskpb.FieldMask{Paths: []string{"id", "company.name"}}, want: []string{"id", "company.name"}},
+		{name: "unknown field", mask: &fieldmaskpb.FieldMask{Paths: []string{"id", "salary"}}, wantCode: codes.InvalidArgument},
+		{name: "unknown subfield", mask: &fieldmaskpb.FieldMask{Paths: []string{"company.size"}}, wantCode: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := readMaskPaths(tt.mask, &portfolio_grpc.Experience{})
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("readMaskPaths() code = %v, want %v (err = %v)", code, tt.wantCode, err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("readMaskPaths() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("readMaskPaths() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc"
+	"github.com/jorgejr568/portfolio-grpc/internal/repositories"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *serverImpl) ListSkillRevisions(ctx context.Context, request *portfolio_grpc.ListSkillRevisionsRequest) (*portfolio_grpc.ListSkillRevisionsResponse, error) {
+	if request.PageSize < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page_size must not be negative")
+	}
+
+	revisions, nextPageToken, err := s.skillsRepository.ListSkillRevisions(ctx, int(request.SkillId), repositories.ListRevisionsParams{
+		PageSize:  int(request.PageSize),
+		PageToken: request.PageToken,
+	})
+	if err != nil {
+		if isInvalidListRequest(err) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &portfolio_grpc.ListSkillRevisionsResponse{
+		Revisions:     revisions,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (s *serverImpl) RestoreSkillRevision(ctx context.Context, request *portfolio_grpc.RestoreSkillRevisionRequest) (*portfolio_grpc.RestoreSkillRevisionResponse, error) {
+	skill, err := s.skillsRepository.RestoreSkillRevision(ctx, int(request.SkillId), request.RevisionId)
+	if err != nil {
+		if errors.Is(err, repositories.ErrSkillNotFound) || errors.Is(err, repositories.ErrRevisionNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+
+		if errors.Is(err, repositories.ErrSkillCategoryNotFound) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &portfolio_grpc.RestoreSkillRevisionResponse{
+		Skill: skill,
+	}, nil
+}
+
+func (s *serverImpl) ListExperienceRevisions(ctx context.Context, request *portfolio_grpc.ListExperienceRevisionsRequest) (*portfolio_grpc.ListExperienceRevisionsResponse, error) {
+	if request.PageSize < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page_size must not be negative")
+	}
+
+	revisions, nextPageToken, err := s.experiencesRepository.ListExperienceRevisions(ctx, int(request.ExperienceId), repositories.ListRevisionsParams{
+		PageSize:  int(request.PageSize),
+		PageToken: request.PageToken,
+	})
+	if err != nil {
+		if isInvalidListRequest(err) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &portfolio_grpc.ListExperienceRevisionsResponse{
+		Revisions:     revisions,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (s *serverImpl) RestoreExperienceRevision(ctx context.Context, request *portfolio_grpc.RestoreExperienceRevisionRequest) (*portfolio_grpc.RestoreExperienceRevisionResponse, error) {
+	experience, err := s.experiencesRepository.RestoreExperienceRevision(ctx, int(request.ExperienceId), request.RevisionId)
+	if err != nil {
+		if errors.Is(err, repositories.ErrExperienceNotFound) || errors.Is(err, repositories.ErrRevisionNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+
+		if errors.Is(err, repositories.ErrInvalidDateRange) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &portfolio_grpc.RestoreExperienceRevisionResponse{
+		Experience: experience,
+	}, nil
+}
+
+func (s *serverImpl) ListEducationRevisions(ctx context.Context, request *portfolio_grpc.ListEducationRevisionsRequest) (*portfolio_grpc.ListEducationRevisionsResponse, error) {
+	if request.PageSize < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page_size must not be negative")
+	}
+
+	revisions, nextPageToken, err := s.educationsRepository.ListEducationRevisions(ctx, int(request.EducationId), repositories.ListRevisionsParams{
+		PageSize:  int(request.PageSize),
+		PageToken: request.PageToken,
+	})
+	if err != nil {
+		if isInvalidListRequest(err) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &portfolio_grpc.ListEducationRevisionsResponse{
+		Revisions:     revisions,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (s *serverImpl) RestoreEducationRevision(ctx context.Context, request *portfolio_grpc.RestoreEducationRevisionRequest) (*portfolio_grpc.RestoreEducationRevisionResponse, error) {
+	education, err := s.educationsRepository.RestoreEducationRevision(ctx, int(request.EducationId), request.RevisionId)
+	if err != nil {
+		if errors.Is(err, repositories.ErrEducationNotFound) || errors.Is(err, repositories.ErrRevisionNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+
+		if errors.Is(err, repositories.ErrInvalidDateRange) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &portfolio_grpc.RestoreEducationRevisionResponse{
+		Education: education,
+	}, nil
+}
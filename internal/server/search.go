@@ -0,0 +1,41 @@
+package server
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultSearchPageSize = 20
+	maxSearchPageSize     = 100
+)
+
+func (s *serverImpl) SearchPortfolio(ctx context.Context, request *portfolio_grpc.SearchPortfolioRequest) (*portfolio_grpc.SearchPortfolioResponse, error) {
+	query := strings.TrimSpace(request.Q)
+	if query == "" {
+		return nil, status.Error(codes.InvalidArgument, "q is required")
+	}
+
+	if request.PageSize < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page_size must not be negative")
+	}
+
+	limit := int(request.PageSize)
+	if limit == 0 {
+		limit = defaultSearchPageSize
+	}
+	limit = min(limit, maxSearchPageSize)
+
+	hits, err := s.searchRepository.Search(ctx, query, limit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &portfolio_grpc.SearchPortfolioResponse{
+		Hits: hits,
+	}, nil
+}
@@ -5,6 +5,10 @@ import (
 	"errors"
 
 	"github.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc"
+	"github.com/jorgejr568/portfolio-grpc/internal/changefeed"
+	"github.com/jorgejr568/portfolio-grpc/internal/client/mailer"
+	"github.com/jorgejr568/portfolio-grpc/internal/client/statsd"
+	"github.com/jorgejr568/portfolio-grpc/internal/clientip"
 	"github.com/jorgejr568/portfolio-grpc/internal/repositories"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -14,39 +18,145 @@ type Server interface {
 	portfolio_grpc.PortfolioServiceServer
 }
 
+// AdminMethods lists the RPCs only authenticated admins may call.
+var AdminMethods = []string{
+	portfolio_grpc.PortfolioService_CreateSkill_FullMethodName,
+	portfolio_grpc.PortfolioService_UpdateSkill_FullMethodName,
+	portfolio_grpc.PortfolioService_DeleteSkill_FullMethodName,
+	portfolio_grpc.PortfolioService_CreateExperience_FullMethodName,
+	portfolio_grpc.PortfolioService_UpdateExperience_FullMethodName,
+	portfolio_grpc.PortfolioService_DeleteExperience_FullMethodName,
+	portfolio_grpc.PortfolioService_CreateEducation_FullMethodName,
+	portfolio_grpc.PortfolioService_UpdateEducation_FullMethodName,
+	portfolio_grpc.PortfolioService_DeleteEducation_FullMethodName,
+	portfolio_grpc.PortfolioService_UpdateProfile_FullMethodName,
+	portfolio_grpc.PortfolioService_ApproveTestimonial_FullMethodName,
+	portfolio_grpc.PortfolioService_RejectTestimonial_FullMethodName,
+	portfolio_grpc.PortfolioService_UndeleteSkill_FullMethodName,
+	portfolio_grpc.PortfolioService_UndeleteExperience_FullMethodName,
+	portfolio_grpc.PortfolioService_UndeleteEducation_FullMethodName,
+	portfolio_grpc.PortfolioService_ListSkillRevisions_FullMethodName,
+	portfolio_grpc.PortfolioService_RestoreSkillRevision_FullMethodName,
+	portfolio_grpc.PortfolioService_ListExperienceRevisions_FullMethodName,
+	portfolio_grpc.PortfolioService_RestoreExperienceRevision_FullMethodName,
+	portfolio_grpc.PortfolioService_ListEducationRevisions_FullMethodName,
+	portfolio_grpc.PortfolioService_RestoreEducationRevision_FullMethodName,
+}
+
 func NewServer(
 	skillsRepository repositories.SkillsRepository,
 	experiencesRepository repositories.ExperiencesRepository,
 	educationsRepository repositories.EducationsRepository,
+	projectsRepository repositories.ProjectsRepository,
+	certificationsRepository repositories.CertificationsRepository,
+	publicationsRepository repositories.PublicationsRepository,
+	profileRepository repositories.ProfileRepository,
+	testimonialsRepository repositories.TestimonialsRepository,
+	contactMessagesRepository repositories.ContactMessagesRepository,
+	searchRepository repositories.SearchRepository,
+	statsdClient statsd.Client,
+	mailerClient mailer.Client,
+	changeFeed *changefeed.Feed,
+	clientIP *clientip.Resolver,
 ) Server {
 	return &serverImpl{
-		skillsRepository:      skillsRepository,
-		experiencesRepository: experiencesRepository,
-		educationsRepository:  educationsRepository,
+		skillsRepository:          skillsRepository,
+		experiencesRepository:     experiencesRepository,
+		educationsRepository:      educationsRepository,
+		projectsRepository:        projectsRepository,
+		certificationsRepository:  certificationsRepository,
+		publicationsRepository:    publicationsRepository,
+		profileRepository:         profileRepository,
+		testimonialsRepository:    testimonialsRepository,
+		contactMessagesRepository: contactMessagesRepository,
+		searchRepository:          searchRepository,
+		statsd:                    statsdClient,
+		mailer:                    mailerClient,
+		changeFeed:                changeFeed,
+		clientIP:                  clientIP,
 	}
 }
 
 type serverImpl struct {
 	portfolio_grpc.UnimplementedPortfolioServiceServer
 
-	skillsRepository      repositories.SkillsRepository
-	experiencesRepository repositories.ExperiencesRepository
-	educationsRepository  repositories.EducationsRepository
+	skillsRepository          repositories.SkillsRepository
+	experiencesRepository     repositories.ExperiencesRepository
+	educationsRepository      repositories.EducationsRepository
+	projectsRepository        repositories.ProjectsRepository
+	certificationsRepository  repositories.CertificationsRepository
+	publicationsRepository    repositories.PublicationsRepository
+	profileRepository         repositories.ProfileRepository
+	testimonialsRepository    repositories.TestimonialsRepository
+	contactMessagesRepository repositories.ContactMessagesRepository
+	searchRepository          repositories.SearchRepository
+	statsd                    statsd.Client
+	mailer                    mailer.Client
+	changeFeed                *changefeed.Feed
+	clientIP                  *clientip.Resolver
 }
 
 func (s *serverImpl) GetAllSkills(ctx context.Context, request *portfolio_grpc.GetAllSkillsRequest) (*portfolio_grpc.GetAllSkillsResponse, error) {
-	skills, err := s.skillsRepository.ListSkills(ctx)
+	if request.PageSize < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page_size must not be negative")
+	}
+
+	if err := checkShowDeleted(ctx, request.ShowDeleted); err != nil {
+		return nil, err
+	}
+
+	if err := checkShowUnpublished(ctx, request.ShowUnpublished); err != nil {
+		return nil, err
+	}
+
+	readMask, err := readMaskPaths(request.ReadMask, &portfolio_grpc.Skill{})
+	if err != nil {
+		return nil, err
+	}
+
+	skills, nextPageToken, err := s.skillsRepository.ListSkills(ctx, repositories.ListSkillsParams{
+		CategoryID:      request.CategoryId,
+		Filter:          request.Filter,
+		OrderBy:         request.OrderBy,
+		PageSize:        int(request.PageSize),
+		PageToken:       request.PageToken,
+		ReadMask:        readMask,
+		ShowDeleted:     request.ShowDeleted,
+		ShowUnpublished: request.ShowUnpublished,
+	})
 	if err != nil {
+		if isInvalidListRequest(err) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	for _, skill := range skills {
+		applyReadMask(skill, readMask)
+	}
+
 	return &portfolio_grpc.GetAllSkillsResponse{
-		Skills: skills,
+		Skills:        skills,
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
 func (s *serverImpl) GetSkill(ctx context.Context, request *portfolio_grpc.GetSkillRequest) (*portfolio_grpc.GetSkillResponse, error) {
-	skill, err := s.skillsRepository.GetSkill(ctx, int(request.Id))
+	if err := checkShowDeleted(ctx, request.ShowDeleted); err != nil {
+		return nil, err
+	}
+
+	if err := checkShowUnpublished(ctx, request.ShowUnpublished); err != nil {
+		return nil, err
+	}
+
+	readMask, err := readMaskPaths(request.ReadMask, &portfolio_grpc.Skill{})
+	if err != nil {
+		return nil, err
+	}
+
+	skill, err := s.skillsRepository.GetSkill(ctx, int(request.Id), readMask, request.ShowDeleted, request.ShowUnpublished)
 	if err != nil {
 		if errors.Is(err, repositories.ErrSkillNotFound) {
 			return nil, status.Error(codes.NotFound, err.Error())
@@ -55,24 +165,212 @@ func (s *serverImpl) GetSkill(ctx context.Context, request *portfolio_grpc.GetSk
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	applyReadMask(skill, readMask)
+
+	// A read mask selects fields of the skill only, so the related
+	// experiences are left out rather than looked up for nothing.
+	if len(readMask) > 0 {
+		return &portfolio_grpc.GetSkillResponse{Skill: skill}, nil
+	}
+
+	experiences, err := s.experiencesRepository.ListExperiencesBySkill(ctx, int(request.Id), request.ShowUnpublished)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	return &portfolio_grpc.GetSkillResponse{
+		Skill:       skill,
+		Experiences: skillExperiences(experiences),
+	}, nil
+}
+
+// skillExperiences summarizes the experiences a skill was used in.
+func skillExperiences(experiences []*portfolio_grpc.Experience) []*portfolio_grpc.GetSkillResponse_Experience {
+	summaries := make([]*portfolio_grpc.GetSkillResponse_Experience, len(experiences))
+	for i, experience := range experiences {
+		summaries[i] = &portfolio_grpc.GetSkillResponse_Experience{
+			Id:          experience.Id,
+			Title:       experience.Title,
+			CompanyName: experience.GetCompany().GetName(),
+			StartedAt:   experience.StartedAt,
+			EndedAt:     experience.EndedAt,
+		}
+	}
+
+	return summaries
+}
+
+func (s *serverImpl) BatchGetSkills(ctx context.Context, request *portfolio_grpc.BatchGetSkillsRequest) (*portfolio_grpc.BatchGetSkillsResponse, error) {
+	if err := validateBatchGetIDs(request.Ids); err != nil {
+		return nil, err
+	}
+
+	readMask, err := readMaskPaths(request.ReadMask, &portfolio_grpc.Skill{})
+	if err != nil {
+		return nil, err
+	}
+
+	skills, missingIDs, err := s.skillsRepository.BatchGetSkills(ctx, request.Ids, readMask)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	for _, skill := range skills {
+		applyReadMask(skill, readMask)
+	}
+
+	return &portfolio_grpc.BatchGetSkillsResponse{
+		Skills:     skills,
+		MissingIds: missingIDs,
+	}, nil
+}
+
+func (s *serverImpl) CreateSkill(ctx context.Context, request *portfolio_grpc.CreateSkillRequest) (*portfolio_grpc.CreateSkillResponse, error) {
+	if err := validateSkill(request.Skill, nil); err != nil {
+		return nil, err
+	}
+
+	skill, err := s.skillsRepository.CreateSkill(ctx, request.Skill)
+	if err != nil {
+		if errors.Is(err, repositories.ErrSkillCategoryNotFound) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &portfolio_grpc.CreateSkillResponse{
 		Skill: skill,
 	}, nil
 }
 
+func (s *serverImpl) UpdateSkill(ctx context.Context, request *portfolio_grpc.UpdateSkillRequest) (*portfolio_grpc.UpdateSkillResponse, error) {
+	paths := request.GetUpdateMask().GetPaths()
+	if err := validateSkill(request.Skill, paths); err != nil {
+		return nil, err
+	}
+
+	skill, err := s.skillsRepository.UpdateSkill(ctx, request.Skill, paths)
+	if err != nil {
+		if errors.Is(err, repositories.ErrSkillNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+
+		if errors.Is(err, repositories.ErrInvalidUpdateMask) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		if errors.Is(err, repositories.ErrSkillCategoryNotFound) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &portfolio_grpc.UpdateSkillResponse{
+		Skill: skill,
+	}, nil
+}
+
+func (s *serverImpl) DeleteSkill(ctx context.Context, request *portfolio_grpc.DeleteSkillRequest) (*portfolio_grpc.DeleteSkillResponse, error) {
+	err := s.skillsRepository.DeleteSkill(ctx, int(request.Id))
+	if err != nil {
+		if errors.Is(err, repositories.ErrSkillNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &portfolio_grpc.DeleteSkillResponse{}, nil
+}
+
+func (s *serverImpl) UndeleteSkill(ctx context.Context, request *portfolio_grpc.UndeleteSkillRequest) (*portfolio_grpc.UndeleteSkillResponse, error) {
+	skill, err := s.skillsRepository.UndeleteSkill(ctx, int(request.Id))
+	if err != nil {
+		if errors.Is(err, repositories.ErrSkillNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &portfolio_grpc.UndeleteSkillResponse{
+		Skill: skill,
+	}, nil
+}
+
+func (s *serverImpl) ListSkillCategories(ctx context.Context, request *portfolio_grpc.ListSkillCategoriesRequest) (*portfolio_grpc.ListSkillCategoriesResponse, error) {
+	categories, err := s.skillsRepository.ListSkillCategories(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &portfolio_grpc.ListSkillCategoriesResponse{
+		Categories: categories,
+	}, nil
+}
+
 func (s *serverImpl) GetAllExperiences(ctx context.Context, request *portfolio_grpc.GetAllExperiencesRequest) (*portfolio_grpc.GetAllExperiencesResponse, error) {
-	experiences, err := s.experiencesRepository.ListExperiences(ctx)
+	if request.PageSize < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page_size must not be negative")
+	}
+
+	if err := checkShowDeleted(ctx, request.ShowDeleted); err != nil {
+		return nil, err
+	}
+
+	if err := checkShowUnpublished(ctx, request.ShowUnpublished); err != nil {
+		return nil, err
+	}
+
+	readMask, err := readMaskPaths(request.ReadMask, &portfolio_grpc.Experience{})
 	if err != nil {
+		return nil, err
+	}
+
+	experiences, nextPageToken, err := s.experiencesRepository.ListExperiences(ctx, repositories.ListExperiencesParams{
+		Filter:          request.Filter,
+		OrderBy:         request.OrderBy,
+		PageSize:        int(request.PageSize),
+		PageToken:       request.PageToken,
+		ReadMask:        readMask,
+		ShowDeleted:     request.ShowDeleted,
+		ShowUnpublished: request.ShowUnpublished,
+	})
+	if err != nil {
+		if isInvalidListRequest(err) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	for _, experience := range experiences {
+		applyReadMask(experience, readMask)
+	}
+
 	return &portfolio_grpc.GetAllExperiencesResponse{
-		Experiences: experiences,
+		Experiences:   experiences,
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
 func (s *serverImpl) GetExperience(ctx context.Context, request *portfolio_grpc.GetExperienceRequest) (*portfolio_grpc.GetExperienceResponse, error) {
-	experience, err := s.experiencesRepository.GetExperience(ctx, int(request.Id))
+	if err := checkShowDeleted(ctx, request.ShowDeleted); err != nil {
+		return nil, err
+	}
+
+	if err := checkShowUnpublished(ctx, request.ShowUnpublished); err != nil {
+		return nil, err
+	}
+
+	readMask, err := readMaskPaths(request.ReadMask, &portfolio_grpc.Experience{})
+	if err != nil {
+		return nil, err
+	}
+
+	experience, err := s.experiencesRepository.GetExperience(ctx, int(request.Id), readMask, request.ShowDeleted, request.ShowUnpublished)
 	if err != nil {
 		if errors.Is(err, repositories.ErrExperienceNotFound) {
 			return nil, status.Error(codes.NotFound, err.Error())
@@ -81,24 +379,181 @@ func (s *serverImpl) GetExperience(ctx context.Context, request *portfolio_grpc.
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	applyReadMask(experience, readMask)
+
+	// A read mask selects fields of the experience only, so the related
+	// skills are left out rather than looked up for nothing.
+	if len(readMask) > 0 {
+		return &portfolio_grpc.GetExperienceResponse{Experience: experience}, nil
+	}
+
+	skills, err := s.skillsRepository.ListSkillsByExperience(ctx, int(request.Id), request.ShowUnpublished)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	return &portfolio_grpc.GetExperienceResponse{
 		Experience: experience,
+		Skills:     skills,
+	}, nil
+}
+
+func (s *serverImpl) BatchGetExperiences(ctx context.Context, request *portfolio_grpc.BatchGetExperiencesRequest) (*portfolio_grpc.BatchGetExperiencesResponse, error) {
+	if err := validateBatchGetIDs(request.Ids); err != nil {
+		return nil, err
+	}
+
+	readMask, err := readMaskPaths(request.ReadMask, &portfolio_grpc.Experience{})
+	if err != nil {
+		return nil, err
+	}
+
+	experiences, missingIDs, err := s.experiencesRepository.BatchGetExperiences(ctx, request.Ids, readMask)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	for _, experience := range experiences {
+		applyReadMask(experience, readMask)
+	}
+
+	return &portfolio_grpc.BatchGetExperiencesResponse{
+		Experiences: experiences,
+		MissingIds:  missingIDs,
+	}, nil
+}
+
+func (s *serverImpl) CreateExperience(ctx context.Context, request *portfolio_grpc.CreateExperienceRequest) (*portfolio_grpc.CreateExperienceResponse, error) {
+	if err := validateExperience(request.Experience, nil); err != nil {
+		return nil, err
+	}
+
+	experience, err := s.experiencesRepository.CreateExperience(ctx, request.Experience)
+	if err != nil {
+		if errors.Is(err, repositories.ErrInvalidDateRange) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &portfolio_grpc.CreateExperienceResponse{
+		Experience: experience,
+	}, nil
+}
+
+func (s *serverImpl) UpdateExperience(ctx context.Context, request *portfolio_grpc.UpdateExperienceRequest) (*portfolio_grpc.UpdateExperienceResponse, error) {
+	paths := request.GetUpdateMask().GetPaths()
+	if err := validateExperience(request.Experience, paths); err != nil {
+		return nil, err
+	}
+
+	experience, err := s.experiencesRepository.UpdateExperience(ctx, request.Experience, paths)
+	if err != nil {
+		if errors.Is(err, repositories.ErrExperienceNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+
+		if errors.Is(err, repositories.ErrInvalidUpdateMask) || errors.Is(err, repositories.ErrInvalidDateRange) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &portfolio_grpc.UpdateExperienceResponse{
+		Experience: experience,
+	}, nil
+}
+
+func (s *serverImpl) DeleteExperience(ctx context.Context, request *portfolio_grpc.DeleteExperienceRequest) (*portfolio_grpc.DeleteExperienceResponse, error) {
+	err := s.experiencesRepository.DeleteExperience(ctx, int(request.Id))
+	if err != nil {
+		if errors.Is(err, repositories.ErrExperienceNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &portfolio_grpc.DeleteExperienceResponse{}, nil
+}
+
+func (s *serverImpl) UndeleteExperience(ctx context.Context, request *portfolio_grpc.UndeleteExperienceRequest) (*portfolio_grpc.UndeleteExperienceResponse, error) {
+	experience, err := s.experiencesRepository.UndeleteExperience(ctx, int(request.Id))
+	if err != nil {
+		if errors.Is(err, repositories.ErrExperienceNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &portfolio_grpc.UndeleteExperienceResponse{
+		Experience: experience,
 	}, nil
 }
 
 func (s *serverImpl) GetAllEducations(ctx context.Context, request *portfolio_grpc.GetAllEducationsRequest) (*portfolio_grpc.GetAllEducationsResponse, error) {
-	educations, err := s.educationsRepository.ListEducations(ctx)
+	if request.PageSize < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page_size must not be negative")
+	}
+
+	if err := checkShowDeleted(ctx, request.ShowDeleted); err != nil {
+		return nil, err
+	}
+
+	if err := checkShowUnpublished(ctx, request.ShowUnpublished); err != nil {
+		return nil, err
+	}
+
+	readMask, err := readMaskPaths(request.ReadMask, &portfolio_grpc.Education{})
+	if err != nil {
+		return nil, err
+	}
+
+	educations, nextPageToken, err := s.educationsRepository.ListEducations(ctx, repositories.ListEducationsParams{
+		Filter:          request.Filter,
+		OrderBy:         request.OrderBy,
+		PageSize:        int(request.PageSize),
+		PageToken:       request.PageToken,
+		ReadMask:        readMask,
+		ShowDeleted:     request.ShowDeleted,
+		ShowUnpublished: request.ShowUnpublished,
+	})
 	if err != nil {
+		if isInvalidListRequest(err) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	for _, education := range educations {
+		applyReadMask(education, readMask)
+	}
+
 	return &portfolio_grpc.GetAllEducationsResponse{
-		Educations: educations,
+		Educations:    educations,
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
 func (s *serverImpl) GetEducation(ctx context.Context, request *portfolio_grpc.GetEducationRequest) (*portfolio_grpc.GetEducationResponse, error) {
-	education, err := s.educationsRepository.GetEducation(ctx, int(request.Id))
+	if err := checkShowDeleted(ctx, request.ShowDeleted); err != nil {
+		return nil, err
+	}
+
+	if err := checkShowUnpublished(ctx, request.ShowUnpublished); err != nil {
+		return nil, err
+	}
+
+	readMask, err := readMaskPaths(request.ReadMask, &portfolio_grpc.Education{})
+	if err != nil {
+		return nil, err
+	}
+
+	education, err := s.educationsRepository.GetEducation(ctx, int(request.Id), readMask, request.ShowDeleted, request.ShowUnpublished)
 	if err != nil {
 		if errors.Is(err, repositories.ErrEducationNotFound) {
 			return nil, status.Error(codes.NotFound, err.Error())
@@ -107,7 +562,105 @@ func (s *serverImpl) GetEducation(ctx context.Context, request *portfolio_grpc.G
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	applyReadMask(education, readMask)
+
 	return &portfolio_grpc.GetEducationResponse{
 		Education: education,
 	}, nil
 }
+
+func (s *serverImpl) BatchGetEducations(ctx context.Context, request *portfolio_grpc.BatchGetEducationsRequest) (*portfolio_grpc.BatchGetEducationsResponse, error) {
+	if err := validateBatchGetIDs(request.Ids); err != nil {
+		return nil, err
+	}
+
+	readMask, err := readMaskPaths(request.ReadMask, &portfolio_grpc.Education{})
+	if err != nil {
+		return nil, err
+	}
+
+	educations, missingIDs, err := s.educationsRepository.BatchGetEducations(ctx, request.Ids, readMask)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	for _, education := range educations {
+		applyReadMask(education, readMask)
+	}
+
+	return &portfolio_grpc.BatchGetEducationsResponse{
+		Educations: educations,
+		MissingIds: missingIDs,
+	}, nil
+}
+
+func (s *serverImpl) CreateEducation(ctx context.Context, request *portfolio_grpc.CreateEducationRequest) (*portfolio_grpc.CreateEducationResponse, error) {
+	if err := validateEducation(request.Education, nil); err != nil {
+		return nil, err
+	}
+
+	education, err := s.educationsRepository.CreateEducation(ctx, request.Education)
+	if err != nil {
+		if errors.Is(err, repositories.ErrInvalidDateRange) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &portfolio_grpc.CreateEducationResponse{
+		Education: education,
+	}, nil
+}
+
+func (s *serverImpl) UpdateEducation(ctx context.Context, request *portfolio_grpc.UpdateEducationRequest) (*portfolio_grpc.UpdateEducationResponse, error) {
+	paths := request.GetUpdateMask().GetPaths()
+	if err := validateEducation(request.Education, paths); err != nil {
+		return nil, err
+	}
+
+	education, err := s.educationsRepository.UpdateEducation(ctx, request.Education, paths)
+	if err != nil {
+		if errors.Is(err, repositories.ErrEducationNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+
+		if errors.Is(err, repositories.ErrInvalidUpdateMask) || errors.Is(err, repositories.ErrInvalidDateRange) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &portfolio_grpc.UpdateEducationResponse{
+		Education: education,
+	}, nil
+}
+
+func (s *serverImpl) DeleteEducation(ctx context.Context, request *portfolio_grpc.DeleteEducationRequest) (*portfolio_grpc.DeleteEducationResponse, error) {
+	err := s.educationsRepository.DeleteEducation(ctx, int(request.Id))
+	if err != nil {
+		if errors.Is(err, repositories.ErrEducationNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &portfolio_grpc.DeleteEducationResponse{}, nil
+}
+
+func (s *serverImpl) UndeleteEducation(ctx context.Context, request *portfolio_grpc.UndeleteEducationRequest) (*portfolio_grpc.UndeleteEducationResponse, error) {
+	education, err := s.educationsRepository.UndeleteEducation(ctx, int(request.Id))
+	if err != nil {
+		if errors.Is(err, repositories.ErrEducationNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &portfolio_grpc.UndeleteEducationResponse{
+		Education: education,
+	}, nil
+}
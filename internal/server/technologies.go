@@ -0,0 +1,164 @@
+package server
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc"
+	"github.com/jorgejr568/portfolio-grpc/internal/repositories"
+	"github.com/jorgejr568/portfolio-grpc/internal/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const daysPerYear = 365.25
+
+// technologyStatsReadMask limits the experiences read to the fields the
+// stats are computed from.
+var technologyStatsReadMask = []string{"technology_details", "started_at", "ended_at"}
+
+func (s *serverImpl) GetTechnologyStats(ctx context.Context, request *portfolio_grpc.GetTechnologyStatsRequest) (*portfolio_grpc.GetTechnologyStatsResponse, error) {
+	experiences, err := collectPages(func(pageToken string) ([]*portfolio_grpc.Experience, string, error) {
+		return s.experiencesRepository.ListExperiences(ctx, repositories.ListExperiencesParams{
+			PageSize:  portfolioPageSize,
+			PageToken: pageToken,
+			ReadMask:  technologyStatsReadMask,
+		})
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &portfolio_grpc.GetTechnologyStatsResponse{
+		Technologies: technologyStats(experiences, today()),
+	}, nil
+}
+
+// dateRange is a range of calendar days, both ends included.
+type dateRange struct {
+	start time.Time
+	end   time.Time
+}
+
+func (r dateRange) days() int {
+	return int(r.end.Sub(r.start)/(24*time.Hour)) + 1
+}
+
+// experienceRange returns the days of experience up to today. Experiences
+// without an end date last until today. It returns false for experiences
+// without a start date or starting after today.
+func experienceRange(experience *portfolio_grpc.Experience, today time.Time) (dateRange, bool) {
+	if experience.StartedAt == nil {
+		return dateRange{}, false
+	}
+
+	r := dateRange{start: *utils.ProtoDateToTime(experience.StartedAt), end: today}
+	if experience.EndedAt != nil {
+		if ended := *utils.ProtoDateToTime(experience.EndedAt); ended.Before(today) {
+			r.end = ended
+		}
+	}
+
+	return r, !r.start.After(r.end)
+}
+
+// mergeDateRanges sorts ranges by start and merges the ones that overlap or
+// are adjacent.
+func mergeDateRanges(ranges []dateRange) []dateRange {
+	sorted := slices.Clone(ranges)
+	slices.SortFunc(sorted, func(a, b dateRange) int {
+		return a.start.Compare(b.start)
+	})
+
+	merged := make([]dateRange, 0, len(sorted))
+	for _, r := range sorted {
+		if len(merged) > 0 {
+			last := &merged[len(merged)-1]
+			if !r.start.After(last.end.AddDate(0, 0, 1)) {
+				if r.end.After(last.end) {
+					last.end = r.end
+				}
+				continue
+			}
+		}
+		merged = append(merged, r)
+	}
+
+	return merged
+}
+
+// technologyStats sums up the days each technology was used, merging the
+// ranges of experiences that overlap so no day is counted twice.
+func technologyStats(experiences []*portfolio_grpc.Experience, today time.Time) []*portfolio_grpc.TechnologyStats {
+	type usage struct {
+		name string
+		// latest is the start of the experience name was taken from.
+		latest time.Time
+		ranges []dateRange
+	}
+
+	usages := make(map[string]*usage)
+	for _, experience := range experiences {
+		r, ok := experienceRange(experience, today)
+		if !ok {
+			continue
+		}
+
+		seen := make(map[string]bool)
+		for _, technology := range experience.TechnologyDetails {
+			name := strings.TrimSpace(technology.Name)
+			key := strings.ToLower(name)
+			if key == "" || seen[key] {
+				continue
+			}
+			seen[key] = true
+
+			u, ok := usages[key]
+			if !ok {
+				u = &usage{name: name, latest: r.start}
+				usages[key] = u
+			} else if r.start.After(u.latest) {
+				u.name, u.latest = name, r.start
+			}
+			u.ranges = append(u.ranges, r)
+		}
+	}
+
+	stats := make([]*portfolio_grpc.TechnologyStats, 0, len(usages))
+	for _, u := range usages {
+		merged := mergeDateRanges(u.ranges)
+
+		var days int
+		for _, r := range merged {
+			days += r.days()
+		}
+
+		stats = append(stats, &portfolio_grpc.TechnologyStats{
+			Name:            u.name,
+			Days:            int32(days),
+			Years:           float64(days) / daysPerYear,
+			FirstUsed:       utils.TimeToProtoDate(&merged[0].start),
+			LastUsed:        utils.TimeToProtoDate(&merged[len(merged)-1].end),
+			ExperienceCount: int32(len(u.ranges)),
+		})
+	}
+
+	slices.SortFunc(stats, func(a, b *portfolio_grpc.TechnologyStats) int {
+		if c := cmp.Compare(b.Days, a.Days); c != 0 {
+			return c
+		}
+		return cmp.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name))
+	})
+
+	return stats
+}
+
+// today returns the current calendar day in UTC, the time zone experience
+// dates are stored in.
+func today() time.Time {
+	now := time.Now().UTC()
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,258 @@
+package server
+
+import (
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc"
+	"google.golang.org/genproto/googleapis/type/date"
+	"google.golang.org/protobuf/proto"
+)
+
+func day(year int, month time.Month, d int) time.Time {
+	return time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+}
+
+func protoDate(year int, month time.Month, d int) *date.Date {
+	return &date.Date{Year: int32(year), Month: int32(month), Day: int32(d)}
+}
+
+func TestDateRangeDays(t *testing.T) {
+	tests := []struct {
+		name string
+		r    dateRange
+		want int
+	}{
+		{name: "single day", r: dateRange{start: day(2020, 1, 1), end: day(2020, 1, 1)}, want: 1},
+		{name: "month", r: dateRange{start: day(2020, 1, 1), end: day(2020, 1, 31)}, want: 31},
+		{name: "leap year", r: dateRange{start: day(2020, 1, 1), end: day(2020, 12, 31)}, want: 366},
+		{name: "common year", r: dateRange{start: day(2021, 1, 1), end: day(2021, 12, 31)}, want: 365},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.r.days(); got != tt.want {
+				t.Errorf("days() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExperienceRange(t *testing.T) {
+	today := day(2024, 6, 15)
+
+	tests := []struct {
+		name       string
+		experience *portfolio_grpc.Experience
+		want       dateRange
+		wantOK     bool
+	}{
+		{name: "no start", experience: &portfolio_grpc.Experience{EndedAt: protoDate(2020, 1, 1)}},
+		{
+			name:       "ended",
+			experience: &portfolio_grpc.Experience{StartedAt: protoDate(2020, 1, 1), EndedAt: protoDate(2021, 1, 1)},
+			want:       dateRange{start: day(2020, 1, 1), end: day(2021, 1, 1)},
+			wantOK:     true,
+		},
+		{
+			name:       "ongoing",
+			experience: &portfolio_grpc.Experience{StartedAt: protoDate(2023, 1, 1)},
+			want:       dateRange{start: day(2023, 1, 1), end: today},
+			wantOK:     true,
+		},
+		{
+			name:       "ends in the future",
+			experience: &portfolio_grpc.Experience{StartedAt: protoDate(2023, 1, 1), EndedAt: protoDate(2025, 1, 1)},
+			want:       dateRange{start: day(2023, 1, 1), end: today},
+			wantOK:     true,
+		},
+		{
+			name:       "starts today",
+			experience: &portfolio_grpc.Experience{StartedAt: protoDate(2024, 6, 15)},
+			want:       dateRange{start: today, end: today},
+			wantOK:     true,
+		},
+		{name: "starts in the future", experience: &portfolio_grpc.Experience{StartedAt: protoDate(2024, 7, 1)}},
+		{name: "ends before it starts", experience: &portfolio_grpc.Experience{StartedAt: protoDate(2021, 1, 1), EndedAt: protoDate(2020, 1, 1)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := experienceRange(tt.experience, today)
+			if ok != tt.wantOK {
+				t.Fatalf("experienceRange() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if ok && got != tt.want {
+				t.Errorf("experienceRange() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergeDateRanges(t *testing.T) {
+	tests := []struct {
+		name   string
+		ranges []dateRange
+		want   []dateRange
+	}{
+		{name: "empty", ranges: nil, want: []dateRange{}},
+		{
+			name:   "single",
+			ranges: []dateRange{{day(2020, 1, 1), day(2020, 6, 30)}},
+			want:   []dateRange{{day(2020, 1, 1), day(2020, 6, 30)}},
+		},
+		{
+			name:   "disjoint and unsorted",
+			ranges: []dateRange{{day(2021, 1, 1), day(2021, 3, 1)}, {day(2020, 1, 1), day(2020, 3, 1)}},
+			want:   []dateRange{{day(2020, 1, 1), day(2020, 3, 1)}, {day(2021, 1, 1), day(2021, 3, 1)}},
+		},
+		{
+			name:   "overlapping",
+			ranges: []dateRange{{day(2020, 1, 1), day(2020, 6, 30)}, {day(2020, 3, 1), day(2020, 12, 31)}},
+			want:   []dateRange{{day(2020, 1, 1), day(2020, 12, 31)}},
+		},
+		{
+			name:   "adjacent",
+			ranges: []dateRange{{day(2020, 1, 1), day(2020, 1, 31)}, {day(2020, 2, 1), day(2020, 2, 29)}},
+			want:   []dateRange{{day(2020, 1, 1), day(2020, 2, 29)}},
+		},
+		{
+			name:   "one day gap",
+			ranges: []dateRange{{day(2020, 1, 1), day(2020, 1, 31)}, {day(2020, 2, 2), day(2020, 2, 29)}},
+			want:   []dateRange{{day(2020, 1, 1), day(2020, 1, 31)}, {day(2020, 2, 2), day(2020, 2, 29)}},
+		},
+		{
+			name:   "contained",
+			ranges: []dateRange{{day(2020, 1, 1), day(2020, 12, 31)}, {day(2020, 3, 1), day(2020, 4, 1)}},
+			want:   []dateRange{{day(2020, 1, 1), day(2020, 12, 31)}},
+		},
+		{
+			name: "chain",
+			ranges: []dateRange{
+				{day(2020, 5, 1), day(2020, 9, 1)},
+				{day(2020, 1, 1), day(2020, 3, 1)},
+				{day(2020, 2, 1), day(2020, 5, 15)},
+				{day(2021, 1, 1), day(2021, 1, 1)},
+			},
+			want: []dateRange{{day(2020, 1, 1), day(2020, 9, 1)}, {day(2021, 1, 1), day(2021, 1, 1)}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := append([]dateRange(nil), tt.ranges...)
+			got := mergeDateRanges(tt.ranges)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("mergeDateRanges() = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(tt.ranges, original) {
+				t.Errorf("mergeDateRanges() modified its input to %v", tt.ranges)
+			}
+		})
+	}
+}
+
+func technologies(names ...string) []*portfolio_grpc.Experience_Technology {
+	details := make([]*portfolio_grpc.Experience_Technology, len(names))
+	for i, name := range names {
+		details[i] = &portfolio_grpc.Experience_Technology{Name: name}
+	}
+
+	return details
+}
+
+func TestTechnologyStats(t *testing.T) {
+	today := day(2024, 12, 31)
+
+	tests := []struct {
+		name        string
+		experiences []*portfolio_grpc.Experience
+		want        []*portfolio_grpc.TechnologyStats
+	}{
+		{name: "no experiences", want: []*portfolio_grpc.TechnologyStats{}},
+		{
+			name: "single experience",
+			experiences: []*portfolio_grpc.Experience{
+				{StartedAt: protoDate(2020, 1, 1), EndedAt: protoDate(2020, 12, 31), TechnologyDetails: technologies("Go")},
+			},
+			want: []*portfolio_grpc.TechnologyStats{
+				{Name: "Go", Days: 366, Years: 366 / daysPerYear, FirstUsed: protoDate(2020, 1, 1), LastUsed: protoDate(2020, 12, 31), ExperienceCount: 1},
+			},
+		},
+		{
+			name: "overlapping experiences count days once",
+			experiences: []*portfolio_grpc.Experience{
+				{StartedAt: protoDate(2021, 1, 1), EndedAt: protoDate(2021, 12, 31), TechnologyDetails: technologies("Go")},
+				{StartedAt: protoDate(2021, 7, 1), EndedAt: protoDate(2022, 6, 30), TechnologyDetails: technologies("go")},
+			},
+			want: []*portfolio_grpc.TechnologyStats{
+				{Name: "go", Days: 546, Years: 546 / daysPerYear, FirstUsed: protoDate(2021, 1, 1), LastUsed: protoDate(2022, 6, 30), ExperienceCount: 2},
+			},
+		},
+		{
+			name: "gaps are not counted",
+			experiences: []*portfolio_grpc.Experience{
+				{StartedAt: protoDate(2021, 1, 1), EndedAt: protoDate(2021, 1, 10), TechnologyDetails: technologies("Go")},
+				{StartedAt: protoDate(2021, 3, 1), EndedAt: protoDate(2021, 3, 10), TechnologyDetails: technologies("Go")},
+			},
+			want: []*portfolio_grpc.TechnologyStats{
+				{Name: "Go", Days: 20, Years: 20 / daysPerYear, FirstUsed: protoDate(2021, 1, 1), LastUsed: protoDate(2021, 3, 10), ExperienceCount: 2},
+			},
+		},
+		{
+			name: "ongoing experience ends today",
+			experiences: []*portfolio_grpc.Experience{
+				{StartedAt: protoDate(2024, 12, 1), TechnologyDetails: technologies("Rust")},
+			},
+			want: []*portfolio_grpc.TechnologyStats{
+				{Name: "Rust", Days: 31, Years: 31 / daysPerYear, FirstUsed: protoDate(2024, 12, 1), LastUsed: protoDate(2024, 12, 31), ExperienceCount: 1},
+			},
+		},
+		{
+			name: "sorted by days then name",
+			experiences: []*portfolio_grpc.Experience{
+				{StartedAt: protoDate(2024, 1, 1), EndedAt: protoDate(2024, 1, 10), TechnologyDetails: technologies("b", "A", "  ", "a")},
+				{StartedAt: protoDate(2024, 2, 1), EndedAt: protoDate(2024, 2, 20), TechnologyDetails: technologies("C")},
+				{StartedAt: protoDate(2025, 1, 1), TechnologyDetails: technologies("Future")},
+				{TechnologyDetails: technologies("Undated")},
+			},
+			want: []*portfolio_grpc.TechnologyStats{
+				{Name: "C", Days: 20, Years: 20 / daysPerYear, FirstUsed: protoDate(2024, 2, 1), LastUsed: protoDate(2024, 2, 20), ExperienceCount: 1},
+				{Name: "A", Days: 10, Years: 10 / daysPerYear, FirstUsed: protoDate(2024, 1, 1), LastUsed: protoDate(2024, 1, 10), ExperienceCount: 1},
+				{Name: "b", Days: 10, Years: 10 / daysPerYear, FirstUsed: protoDate(2024, 1, 1), LastUsed: protoDate(2024, 1, 10), ExperienceCount: 1},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := technologyStats(tt.experiences, today)
+			if len(got) != len(tt.want) {
+				t.Fatalf("technologyStats() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if !proto.Equal(got[i], tt.want[i]) {
+					t.Errorf("technologyStats()[%d] = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestTechnologyStatsYears(t *testing.T) {
+	experiences := []*portfolio_grpc.Experience{
+		{StartedAt: protoDate(2020, 1, 1), EndedAt: protoDate(2023, 12, 31), TechnologyDetails: technologies("Go")},
+	}
+
+	stats := technologyStats(experiences, day(2024, 12, 31))
+	if len(stats) != 1 {
+		t.Fatalf("technologyStats() returned %d stats, want 1", len(stats))
+	}
+
+	// Four calendar years, one of them leap, are exactly four years long.
+	if math.Abs(stats[0].Years-4) > 1e-9 {
+		t.Errorf("Years = %v, want 4", stats[0].Years)
+	}
+}
@@ -0,0 +1,117 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc"
+	"github.com/jorgejr568/portfolio-grpc/internal/repositories"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// testimonialRateLimit is how many testimonials a client IP address may
+	// submit within testimonialRateWindow.
+	testimonialRateLimit  = 3
+	testimonialRateWindow = time.Hour
+)
+
+func (s *serverImpl) GetAllTestimonials(ctx context.Context, request *portfolio_grpc.GetAllTestimonialsRequest) (*portfolio_grpc.GetAllTestimonialsResponse, error) {
+	if request.PageSize < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page_size must not be negative")
+	}
+
+	state, err := testimonialListState(ctx, request.State)
+	if err != nil {
+		return nil, err
+	}
+
+	readMask, err := readMaskPaths(request.ReadMask, &portfolio_grpc.Testimonial{})
+	if err != nil {
+		return nil, err
+	}
+
+	testimonials, nextPageToken, err := s.testimonialsRepository.ListTestimonials(ctx, repositories.ListTestimonialsParams{
+		State:     state,
+		PageSize:  int(request.PageSize),
+		PageToken: request.PageToken,
+		ReadMask:  readMask,
+	})
+	if err != nil {
+		if isInvalidListRequest(err) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	for _, testimonial := range testimonials {
+		applyReadMask(testimonial, readMask)
+	}
+
+	return &portfolio_grpc.GetAllTestimonialsResponse{
+		Testimonials:  testimonials,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (s *serverImpl) SubmitTestimonial(ctx context.Context, request *portfolio_grpc.SubmitTestimonialRequest) (*portfolio_grpc.SubmitTestimonialResponse, error) {
+	if err := validateTestimonial(request.Testimonial); err != nil {
+		return nil, err
+	}
+
+	testimonial, err := s.testimonialsRepository.CreateTestimonial(ctx, request.Testimonial,
+		s.clientIP.Resolve(ctx), testimonialRateLimit, time.Now().Add(-testimonialRateWindow))
+	if err != nil {
+		if errors.Is(err, repositories.ErrTestimonialRateLimited) {
+			return nil, status.Error(codes.ResourceExhausted, "too many testimonials, try again later")
+		}
+
+		if errors.Is(err, repositories.ErrTestimonialExperienceNotFound) {
+			return nil, status.Error(codes.InvalidArgument, "testimonial experience_id does not refer to a published experience")
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &portfolio_grpc.SubmitTestimonialResponse{
+		Testimonial: testimonial,
+	}, nil
+}
+
+func (s *serverImpl) ApproveTestimonial(ctx context.Context, request *portfolio_grpc.ApproveTestimonialRequest) (*portfolio_grpc.ApproveTestimonialResponse, error) {
+	testimonial, err := s.moderateTestimonial(ctx, request.Id, portfolio_grpc.Testimonial_STATE_APPROVED)
+	if err != nil {
+		return nil, err
+	}
+
+	return &portfolio_grpc.ApproveTestimonialResponse{
+		Testimonial: testimonial,
+	}, nil
+}
+
+func (s *serverImpl) RejectTestimonial(ctx context.Context, request *portfolio_grpc.RejectTestimonialRequest) (*portfolio_grpc.RejectTestimonialResponse, error) {
+	testimonial, err := s.moderateTestimonial(ctx, request.Id, portfolio_grpc.Testimonial_STATE_REJECTED)
+	if err != nil {
+		return nil, err
+	}
+
+	return &portfolio_grpc.RejectTestimonialResponse{
+		Testimonial: testimonial,
+	}, nil
+}
+
+func (s *serverImpl) moderateTestimonial(ctx context.Context, id int64, state portfolio_grpc.Testimonial_State) (*portfolio_grpc.Testimonial, error) {
+	testimonial, err := s.testimonialsRepository.SetTestimonialState(ctx, int(id), state)
+	if err != nil {
+		if errors.Is(err, repositories.ErrTestimonialNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return testimonial, nil
+}
@@ -0,0 +1,83 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc"
+	"github.com/jorgejr568/portfolio-grpc/internal/clientip"
+	"github.com/jorgejr568/portfolio-grpc/internal/repositories"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeTestimonials records the testimonials submitted to it and fails with
+// err when set.
+type fakeTestimonials struct {
+	repositories.TestimonialsRepository
+	err      error
+	senderIP string
+	limit    int
+	since    time.Time
+}
+
+func (f *fakeTestimonials) CreateTestimonial(_ context.Context, testimonial *portfolio_grpc.Testimonial, senderIP string, limit int, since time.Time) (*portfolio_grpc.Testimonial, error) {
+	f.senderIP, f.limit, f.since = senderIP, limit, since
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	return &portfolio_grpc.Testimonial{
+		Id:         1,
+		AuthorName: testimonial.AuthorName,
+		Content:    testimonial.Content,
+		State:      portfolio_grpc.Testimonial_STATE_PENDING,
+	}, nil
+}
+
+func TestSubmitTestimonial(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		wantCode codes.Code
+	}{
+		{name: "stored"},
+		{name: "rate limited", err: repositories.ErrTestimonialRateLimited, wantCode: codes.ResourceExhausted},
+		{name: "experience not public", err: repositories.ErrTestimonialExperienceNotFound, wantCode: codes.InvalidArgument},
+	}
+
+	resolver, err := clientip.ParseTrustedProxies("")
+	if err != nil {
+		t.Fatalf("ParseTrustedProxies() error = %v", err)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testimonials := &fakeTestimonials{err: tt.err}
+			s := &serverImpl{testimonialsRepository: testimonials, clientIP: resolver}
+
+			before := time.Now()
+			response, err := s.SubmitTestimonial(contextFrom("203.0.113.7"), &portfolio_grpc.SubmitTestimonialRequest{
+				Testimonial: &portfolio_grpc.Testimonial{AuthorName: "Ann", Content: "Great to work with", ExperienceId: 4},
+			})
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("SubmitTestimonial() code = %v, want %v (err = %v)", code, tt.wantCode, err)
+			}
+
+			if testimonials.senderIP != "203.0.113.7" {
+				t.Errorf("sender IP = %q, want %q", testimonials.senderIP, "203.0.113.7")
+			}
+			if testimonials.limit != testimonialRateLimit {
+				t.Errorf("limit = %d, want %d", testimonials.limit, testimonialRateLimit)
+			}
+			if want := before.Add(-testimonialRateWindow); testimonials.since.Before(want) {
+				t.Errorf("since = %v, want at or after %v", testimonials.since, want)
+			}
+
+			if tt.wantCode == codes.OK && response.GetTestimonial().GetState() != portfolio_grpc.Testimonial_STATE_PENDING {
+				t.Errorf("SubmitTestimonial() = %v, want a pending testimonial", response)
+			}
+		})
+	}
+}
@@ -0,0 +1,333 @@
+package server
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc"
+	"github.com/jorgejr568/portfolio-grpc/internal/repositories"
+	"github.com/jorgejr568/portfolio-grpc/internal/utils"
+	"google.golang.org/genproto/googleapis/type/date"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// timelineSource loads the entries of one type of the timeline.
+type timelineSource struct {
+	entryType portfolio_grpc.TimelineEntry_Type
+	load      func(ctx context.Context) ([]*portfolio_grpc.TimelineEntry, error)
+}
+
+func (s *serverImpl) GetTimeline(ctx context.Context, request *portfolio_grpc.GetTimelineRequest) (*portfolio_grpc.GetTimelineResponse, error) {
+	types := make(map[portfolio_grpc.TimelineEntry_Type]bool, len(request.Types))
+	for _, entryType := range request.Types {
+		if entryType == portfolio_grpc.TimelineEntry_TYPE_UNSPECIFIED {
+			return nil, status.Error(codes.InvalidArgument, "types must not contain TYPE_UNSPECIFIED")
+		}
+
+		if _, ok := portfolio_grpc.TimelineEntry_Type_name[int32(entryType)]; !ok {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("type %d is unknown", entryType))
+		}
+		types[entryType] = true
+	}
+
+	sources := []timelineSource{
+		{entryType: portfolio_grpc.TimelineEntry_TYPE_EXPERIENCE, load: s.loadTimelineExperiences},
+		{entryType: portfolio_grpc.TimelineEntry_TYPE_EDUCATION, load: s.loadTimelineEducations},
+		{entryType: portfolio_grpc.TimelineEntry_TYPE_PROJECT, load: s.loadTimelineProjects},
+		{entryType: portfolio_grpc.TimelineEntry_TYPE_CERTIFICATION, load: s.loadTimelineCertifications},
+		{entryType: portfolio_grpc.TimelineEntry_TYPE_PUBLICATION, load: s.loadTimelinePublications},
+	}
+
+	var entries []*portfolio_grpc.TimelineEntry
+	for _, source := range sources {
+		if len(types) > 0 && !types[source.entryType] {
+			continue
+		}
+
+		loaded, err := source.load(ctx)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		entries = append(entries, loaded...)
+	}
+
+	// Undated entries have no place on the timeline.
+	entries = slices.DeleteFunc(entries, func(entry *portfolio_grpc.TimelineEntry) bool {
+		return entry.StartedAt == nil
+	})
+
+	flagTimelineOverlaps(entries, today())
+	sortTimeline(entries, request.NewestFirst)
+
+	if request.GroupByYear {
+		return &portfolio_grpc.GetTimelineResponse{
+			Years: groupTimelineByYear(entries),
+		}, nil
+	}
+
+	return &portfolio_grpc.GetTimelineResponse{
+		Entries: entries,
+	}, nil
+}
+
+func (s *serverImpl) loadTimelineExperiences(ctx context.Context) ([]*portfolio_grpc.TimelineEntry, error) {
+	experiences, err := collectPages(func(pageToken string) ([]*portfolio_grpc.Experience, string, error) {
+		return s.experiencesRepository.ListExperiences(ctx, repositories.ListExperiencesParams{
+			PageSize:  portfolioPageSize,
+			PageToken: pageToken,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*portfolio_grpc.TimelineEntry, len(experiences))
+	for i, experience := range experiences {
+		entries[i] = &portfolio_grpc.TimelineEntry{
+			Type:      portfolio_grpc.TimelineEntry_TYPE_EXPERIENCE,
+			Id:        experience.Id,
+			Title:     experience.Title,
+			StartedAt: experience.StartedAt,
+			EndedAt:   experience.EndedAt,
+			Item:      &portfolio_grpc.TimelineEntry_Experience{Experience: experience},
+		}
+	}
+
+	return entries, nil
+}
+
+func (s *serverImpl) loadTimelineEducations(ctx context.Context) ([]*portfolio_grpc.TimelineEntry, error) {
+	educations, err := collectPages(func(pageToken string) ([]*portfolio_grpc.Education, string, error) {
+		return s.educationsRepository.ListEducations(ctx, repositories.ListEducationsParams{
+			PageSize:  portfolioPageSize,
+			PageToken: pageToken,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*portfolio_grpc.TimelineEntry, len(educations))
+	for i, education := range educations {
+		entries[i] = &portfolio_grpc.TimelineEntry{
+			Type:      portfolio_grpc.TimelineEntry_TYPE_EDUCATION,
+			Id:        education.Id,
+			Title:     education.Title,
+			StartedAt: education.StartedAt,
+			EndedAt:   education.EndedAt,
+			Item:      &portfolio_grpc.TimelineEntry_Education{Education: education},
+		}
+	}
+
+	return entries, nil
+}
+
+func (s *serverImpl) loadTimelineProjects(ctx context.Context) ([]*portfolio_grpc.TimelineEntry, error) {
+	projects, err := collectPages(func(pageToken string) ([]*portfolio_grpc.Project, string, error) {
+		return s.projectsRepository.ListProjects(ctx, repositories.ListProjectsParams{
+			PageSize:  portfolioPageSize,
+			PageToken: pageToken,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*portfolio_grpc.TimelineEntry, len(projects))
+	for i, project := range projects {
+		entries[i] = &portfolio_grpc.TimelineEntry{
+			Type:      portfolio_grpc.TimelineEntry_TYPE_PROJECT,
+			Id:        project.Id,
+			Title:     project.Title,
+			StartedAt: project.StartedAt,
+			EndedAt:   project.EndedAt,
+			Item:      &portfolio_grpc.TimelineEntry_Project{Project: project},
+		}
+	}
+
+	return entries, nil
+}
+
+func (s *serverImpl) loadTimelineCertifications(ctx context.Context) ([]*portfolio_grpc.TimelineEntry, error) {
+	certifications, err := collectPages(func(pageToken string) ([]*portfolio_grpc.Certification, string, error) {
+		return s.certificationsRepository.ListCertifications(ctx, repositories.ListCertificationsParams{
+			PageSize:  portfolioPageSize,
+			PageToken: pageToken,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*portfolio_grpc.TimelineEntry, len(certifications))
+	for i, certification := range certifications {
+		entries[i] = &portfolio_grpc.TimelineEntry{
+			Type:      portfolio_grpc.TimelineEntry_TYPE_CERTIFICATION,
+			Id:        certification.Id,
+			Title:     certification.Title,
+			StartedAt: certification.IssuedAt,
+			Item:      &portfolio_grpc.TimelineEntry_Certification{Certification: certification},
+		}
+	}
+
+	return entries, nil
+}
+
+func (s *serverImpl) loadTimelinePublications(ctx context.Context) ([]*portfolio_grpc.TimelineEntry, error) {
+	publications, err := collectPages(func(pageToken string) ([]*portfolio_grpc.Publication, string, error) {
+		return s.publicationsRepository.ListPublications(ctx, repositories.ListPublicationsParams{
+			PageSize:  portfolioPageSize,
+			PageToken: pageToken,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*portfolio_grpc.TimelineEntry, len(publications))
+	for i, publication := range publications {
+		entries[i] = &portfolio_grpc.TimelineEntry{
+			Type:      portfolio_grpc.TimelineEntry_TYPE_PUBLICATION,
+			Id:        publication.Id,
+			Title:     publication.Title,
+			StartedAt: publication.PublishedAt,
+			Item:      &portfolio_grpc.TimelineEntry_Publication{Publication: publication},
+		}
+	}
+
+	return entries, nil
+}
+
+// hasPeriod reports whether entries of entryType span a period that may
+// overlap others, rather than marking a single day.
+func hasPeriod(entryType portfolio_grpc.TimelineEntry_Type) bool {
+	switch entryType {
+	case portfolio_grpc.TimelineEntry_TYPE_EXPERIENCE,
+		portfolio_grpc.TimelineEntry_TYPE_EDUCATION,
+		portfolio_grpc.TimelineEntry_TYPE_PROJECT:
+		return true
+	default:
+		return false
+	}
+}
+
+// flagTimelineOverlaps records in each entry with a period the entries whose
+// period shares at least a day with it. Ongoing entries last until today, or
+// their start when that is later.
+func flagTimelineOverlaps(entries []*portfolio_grpc.TimelineEntry, today time.Time) {
+	type period struct {
+		entry *portfolio_grpc.TimelineEntry
+		dateRange
+	}
+
+	periods := make([]period, 0, len(entries))
+	for _, entry := range entries {
+		if !hasPeriod(entry.Type) {
+			continue
+		}
+
+		p := period{entry: entry, dateRange: dateRange{start: *utils.ProtoDateToTime(entry.StartedAt), end: today}}
+		if entry.EndedAt != nil {
+			p.end = *utils.ProtoDateToTime(entry.EndedAt)
+		}
+		if p.end.Before(p.start) {
+			p.end = p.start
+		}
+		periods = append(periods, p)
+	}
+
+	slices.SortFunc(periods, func(a, b period) int {
+		return a.start.Compare(b.start)
+	})
+
+	// Sorted by start, so the periods overlapping periods[i] that start after
+	// it are the ones following it that start before it ends.
+	for i, p := range periods {
+		for _, other := range periods[i+1:] {
+			if other.start.After(p.end) {
+				break
+			}
+
+			p.entry.Overlaps = append(p.entry.Overlaps, timelineRef(other.entry))
+			other.entry.Overlaps = append(other.entry.Overlaps, timelineRef(p.entry))
+		}
+	}
+}
+
+func timelineRef(entry *portfolio_grpc.TimelineEntry) *portfolio_grpc.TimelineEntry_Ref {
+	return &portfolio_grpc.TimelineEntry_Ref{
+		Type: entry.Type,
+		Id:   entry.Id,
+	}
+}
+
+// sortTimeline sorts entries by start, then end, with ongoing entries after
+// the ones that ended. Entries starting and ending on the same days are
+// ordered by type and id so the order is stable across requests.
+func sortTimeline(entries []*portfolio_grpc.TimelineEntry, newestFirst bool) {
+	slices.SortFunc(entries, func(a, b *portfolio_grpc.TimelineEntry) int {
+		if c := compareDates(a.StartedAt, b.StartedAt); c != 0 {
+			return c
+		}
+
+		if c := compareEndDates(a.EndedAt, b.EndedAt); c != 0 {
+			return c
+		}
+
+		if c := cmp.Compare(a.Type, b.Type); c != 0 {
+			return c
+		}
+
+		return cmp.Compare(a.Id, b.Id)
+	})
+
+	if newestFirst {
+		slices.Reverse(entries)
+	}
+}
+
+func compareDates(a, b *date.Date) int {
+	if c := cmp.Compare(a.Year, b.Year); c != 0 {
+		return c
+	}
+
+	if c := cmp.Compare(a.Month, b.Month); c != 0 {
+		return c
+	}
+
+	return cmp.Compare(a.Day, b.Day)
+}
+
+// compareEndDates compares end dates, where an unset end sorts last.
+func compareEndDates(a, b *date.Date) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return 1
+	case b == nil:
+		return -1
+	default:
+		return compareDates(a, b)
+	}
+}
+
+// groupTimelineByYear groups sorted entries by the year they started in,
+// keeping their order.
+func groupTimelineByYear(entries []*portfolio_grpc.TimelineEntry) []*portfolio_grpc.GetTimelineResponse_Year {
+	var years []*portfolio_grpc.GetTimelineResponse_Year
+	for _, entry := range entries {
+		if len(years) == 0 || years[len(years)-1].Year != entry.StartedAt.Year {
+			years = append(years, &portfolio_grpc.GetTimelineResponse_Year{Year: entry.StartedAt.Year})
+		}
+
+		year := years[len(years)-1]
+		year.Entries = append(year.Entries, entry)
+	}
+
+	return years
+}
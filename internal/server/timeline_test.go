@@ -0,0 +1,229 @@
+package server
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc"
+	"google.golang.org/genproto/googleapis/type/date"
+)
+
+const (
+	typeExperience    = portfolio_grpc.TimelineEntry_TYPE_EXPERIENCE
+	typeEducation     = portfolio_grpc.TimelineEntry_TYPE_EDUCATION
+	typeProject       = portfolio_grpc.TimelineEntry_TYPE_PROJECT
+	typeCertification = portfolio_grpc.TimelineEntry_TYPE_CERTIFICATION
+	typePublication   = portfolio_grpc.TimelineEntry_TYPE_PUBLICATION
+)
+
+func timelineEntry(entryType portfolio_grpc.TimelineEntry_Type, id int64, started, ended *date.Date) *portfolio_grpc.TimelineEntry {
+	return &portfolio_grpc.TimelineEntry{Type: entryType, Id: id, StartedAt: started, EndedAt: ended}
+}
+
+func timelineKey(entryType portfolio_grpc.TimelineEntry_Type, id int64) string {
+	return fmt.Sprintf("%s/%d", entryType, id)
+}
+
+// overlapsByEntry returns the sorted overlaps of every entry that has any,
+// keyed like timelineKey.
+func overlapsByEntry(entries []*portfolio_grpc.TimelineEntry) map[string][]string {
+	overlaps := make(map[string][]string)
+	for _, entry := range entries {
+		for _, ref := range entry.Overlaps {
+			key := timelineKey(entry.Type, entry.Id)
+			overlaps[key] = append(overlaps[key], timelineKey(ref.Type, ref.Id))
+		}
+	}
+
+	for _, refs := range overlaps {
+		slices.Sort(refs)
+	}
+
+	return overlaps
+}
+
+func TestFlagTimelineOverlaps(t *testing.T) {
+	today := day(2024, 6, 30)
+
+	tests := []struct {
+		name    string
+		entries []*portfolio_grpc.TimelineEntry
+		want    map[string][]string
+	}{
+		{name: "empty", entries: nil, want: map[string][]string{}},
+		{
+			name: "disjoint",
+			entries: []*portfolio_grpc.TimelineEntry{
+				timelineEntry(typeExperience, 1, protoDate(2018, 1, 1), protoDate(2018, 12, 31)),
+				timelineEntry(typeExperience, 2, protoDate(2019, 1, 1), protoDate(2019, 12, 31)),
+			},
+			want: map[string][]string{},
+		},
+		{
+			name: "overlapping",
+			entries: []*portfolio_grpc.TimelineEntry{
+				timelineEntry(typeExperience, 1, protoDate(2018, 1, 1), protoDate(2019, 6, 30)),
+				timelineEntry(typeEducation, 1, protoDate(2019, 1, 1), protoDate(2020, 12, 31)),
+			},
+			want: map[string][]string{
+				timelineKey(typeExperience, 1): {timelineKey(typeEducation, 1)},
+				timelineKey(typeEducation, 1):  {timelineKey(typeExperience, 1)},
+			},
+		},
+		{
+			name: "sharing a single day",
+			entries: []*portfolio_grpc.TimelineEntry{
+				timelineEntry(typeExperience, 1, protoDate(2018, 1, 1), protoDate(2018, 12, 31)),
+				timelineEntry(typeProject, 1, protoDate(2018, 12, 31), protoDate(2019, 3, 1)),
+			},
+			want: map[string][]string{
+				timelineKey(typeExperience, 1): {timelineKey(typeProject, 1)},
+				timelineKey(typeProject, 1):    {timelineKey(typeExperience, 1)},
+			},
+		},
+		{
+			name: "ongoing lasts until today",
+			entries: []*portfolio_grpc.TimelineEntry{
+				timelineEntry(typeExperience, 1, protoDate(2020, 1, 1), nil),
+				timelineEntry(typeProject, 1, protoDate(2024, 6, 30), protoDate(2024, 8, 1)),
+				timelineEntry(typeProject, 2, protoDate(2024, 7, 1), protoDate(2024, 8, 1)),
+			},
+			want: map[string][]string{
+				timelineKey(typeExperience, 1): {timelineKey(typeProject, 1)},
+				timelineKey(typeProject, 1):    {timelineKey(typeExperience, 1), timelineKey(typeProject, 2)},
+				timelineKey(typeProject, 2):    {timelineKey(typeProject, 1)},
+			},
+		},
+		{
+			name: "ongoing starting after today",
+			entries: []*portfolio_grpc.TimelineEntry{
+				timelineEntry(typeEducation, 1, protoDate(2024, 9, 1), nil),
+				timelineEntry(typeProject, 1, protoDate(2024, 9, 1), protoDate(2024, 9, 1)),
+				timelineEntry(typeProject, 2, protoDate(2024, 9, 2), protoDate(2024, 10, 1)),
+			},
+			want: map[string][]string{
+				timelineKey(typeEducation, 1): {timelineKey(typeProject, 1)},
+				timelineKey(typeProject, 1):   {timelineKey(typeEducation, 1)},
+			},
+		},
+		{
+			name: "contained in a longer period",
+			entries: []*portfolio_grpc.TimelineEntry{
+				timelineEntry(typeProject, 1, protoDate(2019, 3, 1), protoDate(2019, 4, 1)),
+				timelineEntry(typeExperience, 1, protoDate(2018, 1, 1), protoDate(2020, 12, 31)),
+				timelineEntry(typeProject, 2, protoDate(2020, 6, 1), protoDate(2020, 7, 1)),
+			},
+			want: map[string][]string{
+				timelineKey(typeExperience, 1): {timelineKey(typeProject, 1), timelineKey(typeProject, 2)},
+				timelineKey(typeProject, 1):    {timelineKey(typeExperience, 1)},
+				timelineKey(typeProject, 2):    {timelineKey(typeExperience, 1)},
+			},
+		},
+		{
+			name: "single day entries never overlap",
+			entries: []*portfolio_grpc.TimelineEntry{
+				timelineEntry(typeExperience, 1, protoDate(2018, 1, 1), protoDate(2020, 12, 31)),
+				timelineEntry(typeCertification, 1, protoDate(2019, 1, 1), nil),
+				timelineEntry(typePublication, 1, protoDate(2019, 1, 1), nil),
+			},
+			want: map[string][]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flagTimelineOverlaps(tt.entries, today)
+			if got := overlapsByEntry(tt.entries); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("overlaps = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSortTimeline(t *testing.T) {
+	entries := func() []*portfolio_grpc.TimelineEntry {
+		return []*portfolio_grpc.TimelineEntry{
+			timelineEntry(typeProject, 2, protoDate(2020, 1, 1), protoDate(2020, 6, 1)),
+			timelineEntry(typeExperience, 1, protoDate(2020, 1, 1), nil),
+			timelineEntry(typeEducation, 1, protoDate(2019, 9, 1), protoDate(2023, 6, 30)),
+			timelineEntry(typeProject, 1, protoDate(2020, 1, 1), protoDate(2020, 6, 1)),
+			timelineEntry(typeExperience, 2, protoDate(2020, 1, 1), protoDate(2020, 6, 1)),
+			timelineEntry(typeCertification, 1, protoDate(2021, 2, 3), nil),
+		}
+	}
+
+	oldestFirst := []string{
+		timelineKey(typeEducation, 1),
+		timelineKey(typeExperience, 2),
+		timelineKey(typeProject, 1),
+		timelineKey(typeProject, 2),
+		timelineKey(typeExperience, 1),
+		timelineKey(typeCertification, 1),
+	}
+
+	newestFirst := slices.Clone(oldestFirst)
+	slices.Reverse(newestFirst)
+
+	tests := []struct {
+		name        string
+		newestFirst bool
+		want        []string
+	}{
+		{name: "oldest first", want: oldestFirst},
+		{name: "newest first", newestFirst: true, want: newestFirst},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := entries()
+			sortTimeline(got, tt.newestFirst)
+
+			keys := make([]string, len(got))
+			for i, entry := range got {
+				keys[i] = timelineKey(entry.Type, entry.Id)
+			}
+			if !reflect.DeepEqual(keys, tt.want) {
+				t.Errorf("sortTimeline() = %v, want %v", keys, tt.want)
+			}
+		})
+	}
+}
+
+func TestGroupTimelineByYear(t *testing.T) {
+	entries := []*portfolio_grpc.TimelineEntry{
+		timelineEntry(typeEducation, 1, protoDate(2019, 9, 1), nil),
+		timelineEntry(typeExperience, 1, protoDate(2020, 1, 1), nil),
+		timelineEntry(typeProject, 1, protoDate(2020, 5, 1), nil),
+		timelineEntry(typeCertification, 1, protoDate(2022, 2, 3), nil),
+	}
+
+	years := groupTimelineByYear(entries)
+
+	got := make(map[int32][]string)
+	var order []int32
+	for _, year := range years {
+		order = append(order, year.Year)
+		for _, entry := range year.Entries {
+			got[year.Year] = append(got[year.Year], timelineKey(entry.Type, entry.Id))
+		}
+	}
+
+	if want := []int32{2019, 2020, 2022}; !reflect.DeepEqual(order, want) {
+		t.Errorf("groupTimelineByYear() years = %v, want %v", order, want)
+	}
+
+	want := map[int32][]string{
+		2019: {timelineKey(typeEducation, 1)},
+		2020: {timelineKey(typeExperience, 1), timelineKey(typeProject, 1)},
+		2022: {timelineKey(typeCertification, 1)},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("groupTimelineByYear() = %v, want %v", got, want)
+	}
+
+	if years := groupTimelineByYear(nil); len(years) != 0 {
+		t.Errorf("groupTimelineByYear(nil) = %v, want none", years)
+	}
+}
@@ -0,0 +1,279 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc"
+	"github.com/jorgejr568/portfolio-grpc/internal/auth"
+	"github.com/jorgejr568/portfolio-grpc/internal/repositories"
+	"google.golang.org/genproto/googleapis/type/date"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	maxTestimonialLength    = 2000
+	maxContactMessageLength = 5000
+	maxContactSubjectLength = 200
+	maxBatchGetIDs          = 1000
+)
+
+// inMask reports whether path is written by an update with the given mask.
+// An empty mask writes every field.
+func inMask(paths []string, path string) bool {
+	return len(paths) == 0 || slices.Contains(paths, path)
+}
+
+// isInvalidListRequest reports whether a list failed because of malformed
+// pagination, filter or ordering parameters.
+func isInvalidListRequest(err error) bool {
+	return errors.Is(err, repositories.ErrInvalidPageToken) ||
+		errors.Is(err, repositories.ErrInvalidFilter) ||
+		errors.Is(err, repositories.ErrInvalidOrderBy)
+}
+
+// checkShowDeleted rejects show_deleted unless the caller is an admin.
+func checkShowDeleted(ctx context.Context, showDeleted bool) error {
+	if showDeleted && !auth.IsAdmin(ctx) {
+		return status.Error(codes.PermissionDenied, "show_deleted requires an admin token")
+	}
+
+	return nil
+}
+
+// testimonialListState returns the state of the testimonials to list, which
+// defaults to approved. Other states are only listed to admins.
+func testimonialListState(ctx context.Context, state portfolio_grpc.Testimonial_State) (portfolio_grpc.Testimonial_State, error) {
+	if state == portfolio_grpc.Testimonial_STATE_UNSPECIFIED {
+		return portfolio_grpc.Testimonial_STATE_APPROVED, nil
+	}
+
+	if _, ok := portfolio_grpc.Testimonial_State_name[int32(state)]; !ok {
+		return 0, status.Error(codes.InvalidArgument, fmt.Sprintf("state %d is unknown", state))
+	}
+
+	if state != portfolio_grpc.Testimonial_STATE_APPROVED && !auth.IsAdmin(ctx) {
+		return 0, status.Error(codes.PermissionDenied, "listing testimonials that are not approved requires an admin token")
+	}
+
+	return state, nil
+}
+
+// checkShowUnpublished rejects show_unpublished unless the caller is an admin.
+func checkShowUnpublished(ctx context.Context, showUnpublished bool) error {
+	if showUnpublished && !auth.IsAdmin(ctx) {
+		return status.Error(codes.PermissionDenied, "show_unpublished requires an admin token")
+	}
+
+	return nil
+}
+
+func validateBatchGetIDs(ids []int64) error {
+	if len(ids) == 0 {
+		return status.Error(codes.InvalidArgument, "ids are required")
+	}
+
+	if len(ids) > maxBatchGetIDs {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("ids must not have more than %d entries", maxBatchGetIDs))
+	}
+
+	return nil
+}
+
+func validateSkill(skill *portfolio_grpc.Skill, paths []string) error {
+	if skill == nil {
+		return status.Error(codes.InvalidArgument, "skill is required")
+	}
+
+	if inMask(paths, "title") && strings.TrimSpace(skill.Title) == "" {
+		return status.Error(codes.InvalidArgument, "skill title is required")
+	}
+
+	if inMask(paths, "level") && skill.Level < 0 {
+		return status.Error(codes.InvalidArgument, "skill level must not be negative")
+	}
+
+	return validateVisibility(skill.Visibility, skill.PublishAt)
+}
+
+func validateExperience(experience *portfolio_grpc.Experience, paths []string) error {
+	if experience == nil {
+		return status.Error(codes.InvalidArgument, "experience is required")
+	}
+
+	if inMask(paths, "title") && strings.TrimSpace(experience.Title) == "" {
+		return status.Error(codes.InvalidArgument, "experience title is required")
+	}
+
+	if inMask(paths, "started_at") && experience.StartedAt == nil {
+		return status.Error(codes.InvalidArgument, "experience started_at is required")
+	}
+
+	for _, technology := range experience.TechnologyDetails {
+		if strings.TrimSpace(technology.Name) == "" {
+			return status.Error(codes.InvalidArgument, "experience technology name is required")
+		}
+
+		if technology.Kind == portfolio_grpc.Experience_Technology_KIND_UNSPECIFIED {
+			return status.Error(codes.InvalidArgument, fmt.Sprintf("experience technology %q has no kind", technology.Name))
+		}
+	}
+
+	if err := validateDate("started_at", experience.StartedAt); err != nil {
+		return err
+	}
+
+	if err := validateDate("ended_at", experience.EndedAt); err != nil {
+		return err
+	}
+
+	return validateVisibility(experience.Visibility, experience.PublishAt)
+}
+
+func validateEducation(education *portfolio_grpc.Education, paths []string) error {
+	if education == nil {
+		return status.Error(codes.InvalidArgument, "education is required")
+	}
+
+	if inMask(paths, "title") && strings.TrimSpace(education.Title) == "" {
+		return status.Error(codes.InvalidArgument, "education title is required")
+	}
+
+	if (inMask(paths, "institution") || inMask(paths, "institution.name")) &&
+		strings.TrimSpace(education.GetInstitution().GetName()) == "" {
+		return status.Error(codes.InvalidArgument, "education institution name is required")
+	}
+
+	if inMask(paths, "started_at") && education.StartedAt == nil {
+		return status.Error(codes.InvalidArgument, "education started_at is required")
+	}
+
+	if err := validateDate("started_at", education.StartedAt); err != nil {
+		return err
+	}
+
+	if err := validateDate("ended_at", education.EndedAt); err != nil {
+		return err
+	}
+
+	return validateVisibility(education.Visibility, education.PublishAt)
+}
+
+func validateProfile(profile *portfolio_grpc.Profile, paths []string) error {
+	if profile == nil {
+		return status.Error(codes.InvalidArgument, "profile is required")
+	}
+
+	if inMask(paths, "name") && strings.TrimSpace(profile.Name) == "" {
+		return status.Error(codes.InvalidArgument, "profile name is required")
+	}
+
+	if inMask(paths, "contact_email") && profile.ContactEmail != "" {
+		if _, err := mail.ParseAddress(profile.ContactEmail); err != nil {
+			return status.Error(codes.InvalidArgument, "profile contact_email is not a valid email address")
+		}
+	}
+
+	for _, link := range profile.SocialLinks {
+		if link.Kind == portfolio_grpc.Profile_SocialLink_KIND_UNSPECIFIED {
+			return status.Error(codes.InvalidArgument, fmt.Sprintf("profile social link %q has no kind", link.Url))
+		}
+
+		if u, err := url.Parse(link.Url); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return status.Error(codes.InvalidArgument, fmt.Sprintf("profile social link %q is not a valid URL", link.Url))
+		}
+	}
+
+	return nil
+}
+
+func validateTestimonial(testimonial *portfolio_grpc.Testimonial) error {
+	if testimonial == nil {
+		return status.Error(codes.InvalidArgument, "testimonial is required")
+	}
+
+	if strings.TrimSpace(testimonial.AuthorName) == "" {
+		return status.Error(codes.InvalidArgument, "testimonial author_name is required")
+	}
+
+	if strings.TrimSpace(testimonial.Content) == "" {
+		return status.Error(codes.InvalidArgument, "testimonial content is required")
+	}
+
+	if utf8.RuneCountInString(testimonial.Content) > maxTestimonialLength {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("testimonial content must be at most %d characters", maxTestimonialLength))
+	}
+
+	if testimonial.ExperienceId < 0 {
+		return status.Error(codes.InvalidArgument, "testimonial experience_id must not be negative")
+	}
+
+	return nil
+}
+
+// validateContactMessage validates request and returns the bare address of
+// its email, without any display name the sender included.
+func validateContactMessage(request *portfolio_grpc.SubmitContactMessageRequest) (string, error) {
+	if strings.TrimSpace(request.Name) == "" {
+		return "", status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	email, err := mail.ParseAddress(request.Email)
+	if err != nil {
+		return "", status.Error(codes.InvalidArgument, "email is not a valid email address")
+	}
+
+	if strings.TrimSpace(request.Message) == "" {
+		return "", status.Error(codes.InvalidArgument, "message is required")
+	}
+
+	if utf8.RuneCountInString(request.Message) > maxContactMessageLength {
+		return "", status.Error(codes.InvalidArgument, fmt.Sprintf("message must be at most %d characters", maxContactMessageLength))
+	}
+
+	if utf8.RuneCountInString(request.Subject) > maxContactSubjectLength {
+		return "", status.Error(codes.InvalidArgument, fmt.Sprintf("subject must be at most %d characters", maxContactSubjectLength))
+	}
+
+	return email.Address, nil
+}
+
+// validateVisibility rejects unknown visibilities and malformed publish
+// times. An unspecified visibility is valid and stored as published.
+func validateVisibility(visibility portfolio_grpc.Visibility, publishAt *timestamppb.Timestamp) error {
+	if _, ok := portfolio_grpc.Visibility_name[int32(visibility)]; !ok {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("visibility %d is unknown", visibility))
+	}
+
+	if publishAt != nil {
+		if err := publishAt.CheckValid(); err != nil {
+			return status.Error(codes.InvalidArgument, "publish_at is not a valid timestamp")
+		}
+	}
+
+	return nil
+}
+
+// validateDate rejects dates that are not full calendar dates. A nil date is
+// valid and means the field is unset.
+func validateDate(field string, d *date.Date) error {
+	if d == nil {
+		return nil
+	}
+
+	t := time.Date(int(d.Year), time.Month(d.Month), int(d.Day), 0, 0, 0, 0, time.UTC)
+	if d.Year < 1 || d.Year > 9999 || t.Month() != time.Month(d.Month) || t.Day() != int(d.Day) {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("%s is not a valid date", field))
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc"
+	"github.com/jorgejr568/portfolio-grpc/internal/changefeed"
+	"github.com/jorgejr568/portfolio-grpc/internal/repositories"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// changeEntityTypes maps the entity_type published by the change triggers to
+// its proto enum.
+var changeEntityTypes = map[string]portfolio_grpc.PortfolioChange_EntityType{
+	"skill":      portfolio_grpc.PortfolioChange_ENTITY_TYPE_SKILL,
+	"experience": portfolio_grpc.PortfolioChange_ENTITY_TYPE_EXPERIENCE,
+	"education":  portfolio_grpc.PortfolioChange_ENTITY_TYPE_EDUCATION,
+}
+
+// changeOperations maps the operation published by the change triggers to
+// its proto enum.
+var changeOperations = map[string]portfolio_grpc.PortfolioChange_Operation{
+	"insert": portfolio_grpc.PortfolioChange_OPERATION_INSERT,
+	"update": portfolio_grpc.PortfolioChange_OPERATION_UPDATE,
+	"delete": portfolio_grpc.PortfolioChange_OPERATION_DELETE,
+}
+
+func (s *serverImpl) WatchPortfolio(request *portfolio_grpc.WatchPortfolioRequest, stream grpc.ServerStreamingServer[portfolio_grpc.WatchPortfolioResponse]) error {
+	ctx := stream.Context()
+
+	watched := make(map[portfolio_grpc.PortfolioChange_EntityType]bool, len(request.EntityTypes))
+	for _, entityType := range request.EntityTypes {
+		if entityType == portfolio_grpc.PortfolioChange_ENTITY_TYPE_UNSPECIFIED {
+			return status.Error(codes.InvalidArgument, "entity_types must not contain ENTITY_TYPE_UNSPECIFIED")
+		}
+		watched[entityType] = true
+	}
+
+	subscription := s.changeFeed.Subscribe()
+	defer subscription.Close()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case event, ok := <-subscription.Events():
+			if !ok {
+				if errors.Is(subscription.Err(), changefeed.ErrLagging) {
+					return status.Error(codes.ResourceExhausted, subscription.Err().Error())
+				}
+
+				return status.Error(codes.Unavailable, changefeed.ErrClosed.Error())
+			}
+
+			entityType, ok := changeEntityTypes[event.EntityType]
+			if !ok || (len(watched) > 0 && !watched[entityType]) {
+				continue
+			}
+
+			change, err := s.portfolioChange(ctx, entityType, event)
+			if err != nil {
+				return status.Error(codes.Internal, err.Error())
+			}
+
+			// The entity was deleted or unpublished before it could be
+			// read; its delete event follows.
+			if change == nil {
+				continue
+			}
+
+			if err := stream.Send(&portfolio_grpc.WatchPortfolioResponse{Change: change}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// portfolioChange builds the change of event, reading the current value of
+// inserted and updated entities. It returns nil when the entity no longer
+// exists.
+func (s *serverImpl) portfolioChange(ctx context.Context, entityType portfolio_grpc.PortfolioChange_EntityType, event changefeed.Event) (*portfolio_grpc.PortfolioChange, error) {
+	change := &portfolio_grpc.PortfolioChange{
+		EntityType: entityType,
+		Id:         event.ID,
+		Operation:  changeOperations[event.Operation],
+	}
+
+	if change.Operation == portfolio_grpc.PortfolioChange_OPERATION_DELETE {
+		return change, nil
+	}
+
+	var err error
+	switch entityType {
+	case portfolio_grpc.PortfolioChange_ENTITY_TYPE_SKILL:
+		var skill *portfolio_grpc.Skill
+		skill, err = s.skillsRepository.GetSkill(ctx, int(event.ID), nil, false, false)
+		change.Value = &portfolio_grpc.PortfolioChange_Skill{Skill: skill}
+	case portfolio_grpc.PortfolioChange_ENTITY_TYPE_EXPERIENCE:
+		var experience *portfolio_grpc.Experience
+		experience, err = s.experiencesRepository.GetExperience(ctx, int(event.ID), nil, false, false)
+		change.Value = &portfolio_grpc.PortfolioChange_Experience{Experience: experience}
+	case portfolio_grpc.PortfolioChange_ENTITY_TYPE_EDUCATION:
+		var education *portfolio_grpc.Education
+		education, err = s.educationsRepository.GetEducation(ctx, int(event.ID), nil, false, false)
+		change.Value = &portfolio_grpc.PortfolioChange_Education{Education: education}
+	}
+
+	if errors.Is(err, repositories.ErrSkillNotFound) ||
+		errors.Is(err, repositories.ErrExperienceNotFound) ||
+		errors.Is(err, repositories.ErrEducationNotFound) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return change, nil
+}
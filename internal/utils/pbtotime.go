@@ -0,0 +1,26 @@
+package utils
+
+import (
+	"time"
+
+	"google.golang.org/genproto/googleapis/type/date"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ProtoDateToTime(d *date.Date) *time.Time {
+	if d == nil {
+		return nil
+	}
+
+	t := time.Date(int(d.Year), time.Month(d.Month), int(d.Day), 0, 0, 0, 0, time.UTC)
+	return &t
+}
+
+func ProtoTimestampToTime(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+
+	t := ts.AsTime()
+	return &t
+}
@@ -17,9 +17,16 @@ import (
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/jorgejr568/portfolio-grpc/gen/go/jorgejr568/portfolio_grpc"
+	"github.com/jorgejr568/portfolio-grpc/internal/auth"
+	"github.com/jorgejr568/portfolio-grpc/internal/changefeed"
+	"github.com/jorgejr568/portfolio-grpc/internal/client/mailer"
 	"github.com/jorgejr568/portfolio-grpc/internal/client/statsd"
+	"github.com/jorgejr568/portfolio-grpc/internal/clientip"
 	"github.com/jorgejr568/portfolio-grpc/internal/env"
 	"github.com/jorgejr568/portfolio-grpc/internal/interceptors"
+	"github.com/jorgejr568/portfolio-grpc/internal/locale"
+	"github.com/jorgejr568/portfolio-grpc/internal/publisher"
+	"github.com/jorgejr568/portfolio-grpc/internal/purge"
 	"github.com/jorgejr568/portfolio-grpc/internal/repositories"
 	"github.com/jorgejr568/portfolio-grpc/internal/server"
 	_ "github.com/lib/pq"
@@ -35,11 +42,21 @@ import (
 )
 
 const (
-	statsdPrefix   = "portfolio_grpc"
-	statsdEnv      = "STATSD_ADDRESS"
-	databaseURLEnv = "DATABASE_URL"
-	grpcPort       = ":50051"
-	httpPort       = ":8080"
+	statsdPrefix     = "portfolio_grpc"
+	statsdEnv        = "STATSD_ADDRESS"
+	databaseURLEnv   = "DATABASE_URL"
+	adminTokensEnv   = "ADMIN_TOKENS"
+	proxiesEnv       = "TRUSTED_PROXIES"
+	smtpAddressEnv   = "SMTP_ADDRESS"
+	smtpUserEnv      = "SMTP_USERNAME"
+	smtpPassEnv      = "SMTP_PASSWORD"
+	smtpFromEnv      = "SMTP_FROM"
+	localeEnv        = "DEFAULT_LOCALE"
+	defaultLocale    = "en"
+	retentionEnv     = "SOFT_DELETE_RETENTION"
+	defaultRetention = 30 * 24 * time.Hour
+	grpcPort         = ":50051"
+	httpPort         = ":8080"
 )
 
 func main() {
@@ -119,21 +136,116 @@ func main() {
 		})
 	})
 
+	err = di.Provide(func() (mailer.Client, error) {
+		smtpAddress := os.Getenv(smtpAddressEnv)
+		if smtpAddress == "" {
+			return mailer.Nop(), nil
+		}
+
+		host, portStr, err := net.SplitHostPort(smtpAddress)
+		if err != nil {
+			return nil, errors.New("invalid smtp_address format")
+		}
+
+		port, err := strconv.Atoi(portStr)
+		if err != nil {
+			return nil, errors.New("invalid smtp_address port")
+		}
+
+		return mailer.New(mailer.Config{
+			Host:     host,
+			Port:     port,
+			Username: os.Getenv(smtpUserEnv),
+			Password: os.Getenv(smtpPassEnv),
+			From:     os.Getenv(smtpFromEnv),
+		})
+	})
+	if err != nil {
+		log.Fatalf("failed to provide mailer to DI container: %v", err)
+	}
+
+	err = di.Provide(func() (*auth.Tokens, error) {
+		return auth.ParseTokens(os.Getenv(adminTokensEnv))
+	})
+	if err != nil {
+		log.Fatalf("failed to provide admin tokens to DI container: %v", err)
+	}
+
+	err = di.Provide(func() (*clientip.Resolver, error) {
+		return clientip.ParseTrustedProxies(os.Getenv(proxiesEnv))
+	})
+	if err != nil {
+		log.Fatalf("failed to provide client IP resolver to DI container: %v", err)
+	}
+
+	err = di.Provide(func() *locale.Negotiator {
+		if l := os.Getenv(localeEnv); l != "" {
+			return locale.New(l)
+		}
+
+		return locale.New(defaultLocale)
+	})
+	if err != nil {
+		log.Fatalf("failed to provide locale negotiator to DI container: %v", err)
+	}
+
+	err = di.Provide(func(logger *zap.Logger) *changefeed.Feed {
+		return changefeed.New(os.Getenv(databaseURLEnv), logger)
+	})
+	if err != nil {
+		log.Fatalf("failed to provide change feed to DI container: %v", err)
+	}
+
 	err = registerRepositories(di)
 	if err != nil {
 		log.Fatalf("failed to register repositories in DI container: %v", err)
 	}
 
+	err = di.Provide(func(
+		skills repositories.SkillsRepository,
+		experiences repositories.ExperiencesRepository,
+		educations repositories.EducationsRepository,
+		logger *zap.Logger,
+	) (*purge.Purger, error) {
+		retention := defaultRetention
+		if raw := os.Getenv(retentionEnv); raw != "" {
+			parsed, err := time.ParseDuration(raw)
+			if err != nil || parsed <= 0 {
+				return nil, errors.New("invalid soft_delete_retention")
+			}
+			retention = parsed
+		}
+
+		return purge.New(skills, experiences, educations, logger, retention), nil
+	})
+	if err != nil {
+		log.Fatalf("failed to provide purger to DI container: %v", err)
+	}
+
+	err = di.Provide(publisher.New)
+	if err != nil {
+		log.Fatalf("failed to provide publisher to DI container: %v", err)
+	}
+
 	err = di.Provide(server.NewServer)
 	if err != nil {
 		log.Fatalf("failed to provide Server to DI container: %v", err)
 	}
 
-	err = di.Invoke(func(srv server.Server, logger *zap.Logger, st statsd.Client) error {
+	err = di.Invoke(func(srv server.Server, logger *zap.Logger, st statsd.Client, tokens *auth.Tokens, feed *changefeed.Feed, purger *purge.Purger, pub *publisher.Publisher) error {
 		// Create context that listens for interrupt signals
 		ctx, cancel := context.WithCancel(ctx)
 		defer cancel()
 
+		go func() {
+			if err := feed.Run(ctx); err != nil {
+				logger.Error("change feed error", zap.Error(err))
+			}
+		}()
+
+		go purger.Run(ctx)
+		go pub.Run(ctx)
+
 		// Setup signal handling
 		sigChan := make(chan os.Signal, 1)
 		signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM, syscall.SIGINT)
@@ -143,9 +255,11 @@ func main() {
 			grpc.ChainUnaryInterceptor(
 				interceptors.UnaryLoggerInterceptor(logger),
 				interceptors.StatsDInterceptor(st),
+				interceptors.UnaryAuthInterceptor(tokens, server.AdminMethods),
 			),
 			grpc.ChainStreamInterceptor(
 				interceptors.StreamLoggerInterceptor(logger),
+				interceptors.StreamAuthInterceptor(tokens, server.AdminMethods),
 			),
 		)
 
@@ -167,6 +281,9 @@ func main() {
 		<-sigChan
 		logger.Info("⚠️  Shutdown signal received, stopping servers...")
 
+		// End WatchPortfolio streams so graceful shutdown does not wait on them
+		feed.Close()
+
 		// Create shutdown context with timeout
 		shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
 		defer shutdownCancel()
@@ -225,7 +342,9 @@ func startGRPCServer(ctx context.Context, grpcServer *grpc.Server, srv server.Se
 
 func startHTTPGateway(ctx context.Context, httpServer *http.Server, srv server.Server, logger *zap.Logger) error {
 	// Create gRPC-Gateway mux
-	mux := runtime.NewServeMux()
+	mux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher),
+	)
 
 	conn, err := grpc.NewClient(
 		"127.0.0.1"+grpcPort,
@@ -258,15 +377,66 @@ func startHTTPGateway(ctx context.Context, httpServer *http.Server, srv server.S
 	logger.Info("REST API Endpoints:", zap.Strings("endpoints", []string{
 		"GET  http://localhost:8080/v1/skills",
 		"GET  http://localhost:8080/v1/skills/{id}",
+		"GET  http://localhost:8080/v1/skills:batchGet?ids={id}&ids={id}",
+		"POST http://localhost:8080/v1/skills",
+		"PATCH http://localhost:8080/v1/skills/{id}",
+		"DELETE http://localhost:8080/v1/skills/{id}",
+		"POST http://localhost:8080/v1/skills/{id}:undelete",
+		"GET  http://localhost:8080/v1/skill-categories",
 		"GET  http://localhost:8080/v1/experiences",
 		"GET  http://localhost:8080/v1/experiences/{id}",
+		"GET  http://localhost:8080/v1/experiences:batchGet?ids={id}&ids={id}",
+		"POST http://localhost:8080/v1/experiences",
+		"PATCH http://localhost:8080/v1/experiences/{id}",
+		"DELETE http://localhost:8080/v1/experiences/{id}",
+		"POST http://localhost:8080/v1/experiences/{id}:undelete",
 		"GET  http://localhost:8080/v1/educations",
 		"GET  http://localhost:8080/v1/educations/{id}",
+		"GET  http://localhost:8080/v1/educations:batchGet?ids={id}&ids={id}",
+		"POST http://localhost:8080/v1/educations",
+		"PATCH http://localhost:8080/v1/educations/{id}",
+		"DELETE http://localhost:8080/v1/educations/{id}",
+		"POST http://localhost:8080/v1/educations/{id}:undelete",
+		"GET  http://localhost:8080/v1/search?q={query}",
+		"GET  http://localhost:8080/v1/portfolio",
+		"GET  http://localhost:8080/v1/portfolio:watch",
+		"GET  http://localhost:8080/v1/projects",
+		"GET  http://localhost:8080/v1/projects/{id}",
+		"GET  http://localhost:8080/v1/certifications",
+		"GET  http://localhost:8080/v1/certifications/{id}",
+		"GET  http://localhost:8080/v1/publications",
+		"GET  http://localhost:8080/v1/publications/{id}",
+		"GET  http://localhost:8080/v1/profile",
+		"PATCH http://localhost:8080/v1/profile",
+		"GET  http://localhost:8080/v1/testimonials",
+		"POST http://localhost:8080/v1/testimonials",
+		"POST http://localhost:8080/v1/testimonials/{id}:approve",
+		"POST http://localhost:8080/v1/testimonials/{id}:reject",
+		"POST http://localhost:8080/v1/contact",
+		"GET  http://localhost:8080/v1/skills/{skill_id}/revisions",
+		"POST http://localhost:8080/v1/skills/{skill_id}/revisions/{revision_id}:restore",
+		"GET  http://localhost:8080/v1/experiences/{experience_id}/revisions",
+		"POST http://localhost:8080/v1/experiences/{experience_id}/revisions/{revision_id}:restore",
+		"GET  http://localhost:8080/v1/educations/{education_id}/revisions",
+		"POST http://localhost:8080/v1/educations/{education_id}/revisions/{revision_id}:restore",
+		"GET  http://localhost:8080/v1/technology-stats",
+		"GET  http://localhost:8080/v1/timeline",
 	}))
 
 	return httpServer.ListenAndServe()
 }
 
+// incomingHeaderMatcher forwards Accept-Language under its own name, so the
+// repositories can negotiate the locale the same way for gRPC and REST
+// callers. Other headers keep the gateway defaults.
+func incomingHeaderMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, "Accept-Language") {
+		return locale.MetadataKey, true
+	}
+
+	return runtime.DefaultHeaderMatcher(key)
+}
+
 func corsMiddleware(h http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		allowedOrigin := os.Getenv("ALLOWED_ORIGIN")
@@ -275,8 +445,8 @@ func corsMiddleware(h http.Handler) http.Handler {
 		}
 
 		w.Header().Set("Access-Control-Allow-Origin", allowedOrigin)
-		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, Accept-Language")
 		w.Header().Set("Content-Type", "application/json")
 
 		if r.Method == "OPTIONS" {
@@ -301,5 +471,33 @@ func registerRepositories(di *dig.Container) error {
 		return err
 	}
 
+	if err := di.Provide(repositories.NewProjectsRepository); err != nil {
+		return err
+	}
+
+	if err := di.Provide(repositories.NewCertificationsRepository); err != nil {
+		return err
+	}
+
+	if err := di.Provide(repositories.NewPublicationsRepository); err != nil {
+		return err
+	}
+
+	if err := di.Provide(repositories.NewProfileRepository); err != nil {
+		return err
+	}
+
+	if err := di.Provide(repositories.NewTestimonialsRepository); err != nil {
+		return err
+	}
+
+	if err := di.Provide(repositories.NewContactMessagesRepository); err != nil {
+		return err
+	}
+
+	if err := di.Provide(repositories.NewSearchRepository); err != nil {
+		return err
+	}
+
 	return nil
 }
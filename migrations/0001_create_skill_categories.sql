@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS skill_categories (
+    id         BIGSERIAL PRIMARY KEY,
+    title      TEXT        NOT NULL UNIQUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+ALTER TABLE skills
+    ADD COLUMN IF NOT EXISTS category_id BIGINT REFERENCES skill_categories (id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS skills_category_id_idx ON skills (category_id);
@@ -0,0 +1,2 @@
+ALTER TABLE experiences
+    ADD COLUMN IF NOT EXISTS tools JSONB NOT NULL DEFAULT '[]';
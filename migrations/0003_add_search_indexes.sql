@@ -0,0 +1,18 @@
+-- Full-text search documents used by SearchPortfolio. The expressions must
+-- match the ones in internal/repositories/search_db.go for the indexes to be
+-- used. The 'simple' configuration is language agnostic, which suits mixed
+-- English and Portuguese content.
+CREATE INDEX IF NOT EXISTS experiences_search_idx ON experiences USING GIN ((
+    setweight(to_tsvector('simple', coalesce(title, '')), 'A') ||
+    setweight(to_tsvector('simple', coalesce(company_name, '')), 'A') ||
+    setweight(to_tsvector('simple', coalesce(description, '')), 'B')
+));
+
+CREATE INDEX IF NOT EXISTS education_search_idx ON education USING GIN ((
+    setweight(to_tsvector('simple', coalesce(title, '')), 'A') ||
+    setweight(to_tsvector('simple', coalesce(institution_name, '')), 'B')
+));
+
+CREATE INDEX IF NOT EXISTS skills_search_idx ON skills USING GIN ((
+    setweight(to_tsvector('simple', coalesce(title, '')), 'A')
+));
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS projects (
+    id              BIGSERIAL PRIMARY KEY,
+    title           TEXT        NOT NULL,
+    description     TEXT        NOT NULL DEFAULT '',
+    repo_url        TEXT        NOT NULL DEFAULT '',
+    demo_url        TEXT        NOT NULL DEFAULT '',
+    cover_image_url TEXT        NOT NULL DEFAULT '',
+    technologies    JSONB       NOT NULL DEFAULT '[]',
+    started_at      DATE,
+    finished_at     DATE,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK (finished_at IS NULL OR started_at IS NULL OR finished_at >= started_at)
+);
+
+CREATE INDEX IF NOT EXISTS projects_started_at_idx ON projects (started_at DESC, id DESC);
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS certifications (
+    id               BIGSERIAL PRIMARY KEY,
+    title            TEXT        NOT NULL,
+    issuer           TEXT        NOT NULL,
+    credential_id    TEXT        NOT NULL DEFAULT '',
+    verification_url TEXT        NOT NULL DEFAULT '',
+    issued_at        DATE        NOT NULL,
+    expires_at       DATE,
+    created_at       TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at       TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK (expires_at IS NULL OR expires_at >= issued_at)
+);
+
+CREATE INDEX IF NOT EXISTS certifications_issued_at_idx ON certifications (issued_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS certifications_expires_at_idx ON certifications (expires_at);
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS publications (
+    id           BIGSERIAL PRIMARY KEY,
+    kind         TEXT        NOT NULL CHECK (kind IN ('talk', 'article', 'paper')),
+    title        TEXT        NOT NULL,
+    description  TEXT        NOT NULL DEFAULT '',
+    venue        TEXT        NOT NULL DEFAULT '',
+    published_at DATE        NOT NULL,
+    slides_url   TEXT        NOT NULL DEFAULT '',
+    video_url    TEXT        NOT NULL DEFAULT '',
+    co_authors   JSONB       NOT NULL DEFAULT '[]',
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at   TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS publications_published_at_idx ON publications (published_at DESC, id DESC);
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS profile (
+    id            SMALLINT PRIMARY KEY DEFAULT 1 CHECK (id = 1),
+    name          TEXT        NOT NULL DEFAULT '',
+    headline      TEXT        NOT NULL DEFAULT '',
+    bio           TEXT        NOT NULL DEFAULT '',
+    avatar_url    TEXT        NOT NULL DEFAULT '',
+    location      TEXT        NOT NULL DEFAULT '',
+    contact_email TEXT        NOT NULL DEFAULT '',
+    social_links  JSONB       NOT NULL DEFAULT '[]',
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at    TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO profile (id) VALUES (1) ON CONFLICT (id) DO NOTHING;
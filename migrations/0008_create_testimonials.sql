@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS testimonials (
+    id            BIGSERIAL PRIMARY KEY,
+    author_name   TEXT        NOT NULL,
+    author_role   TEXT        NOT NULL DEFAULT '',
+    relationship  TEXT        NOT NULL DEFAULT '',
+    content       TEXT        NOT NULL,
+    experience_id BIGINT REFERENCES experiences (id) ON DELETE SET NULL,
+    state         TEXT        NOT NULL DEFAULT 'pending' CHECK (state IN ('pending', 'approved', 'rejected')),
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at    TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS testimonials_state_created_at_idx ON testimonials (state, created_at DESC, id DESC);
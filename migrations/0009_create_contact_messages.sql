@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS contact_messages (
+    id           BIGSERIAL PRIMARY KEY,
+    name         TEXT        NOT NULL,
+    email        TEXT        NOT NULL,
+    subject      TEXT        NOT NULL DEFAULT '',
+    message      TEXT        NOT NULL,
+    sender_ip    TEXT        NOT NULL DEFAULT '',
+    delivered_at TIMESTAMPTZ,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS contact_messages_sender_ip_created_at_idx ON contact_messages (sender_ip, created_at);
+CREATE INDEX IF NOT EXISTS contact_messages_email_created_at_idx ON contact_messages (lower(email), created_at);
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS translations (
+    entity_type TEXT        NOT NULL CHECK (entity_type IN ('skill', 'experience', 'education')),
+    entity_id   BIGINT      NOT NULL,
+    field       TEXT        NOT NULL,
+    locale      TEXT        NOT NULL,
+    value       TEXT        NOT NULL,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (entity_type, entity_id, field, locale)
+);
@@ -0,0 +1,39 @@
+-- Publishes a JSON payload such as
+-- {"entity_type": "skill", "id": 1, "operation": "update"} on the
+-- portfolio_changes channel for every changed row. The payload carries no
+-- values, as notifications are limited to 8000 bytes; listeners read the row
+-- themselves.
+CREATE OR REPLACE FUNCTION notify_portfolio_change() RETURNS TRIGGER AS $$
+DECLARE
+    row_id BIGINT;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        row_id := OLD.id;
+    ELSE
+        row_id := NEW.id;
+    END IF;
+
+    PERFORM pg_notify('portfolio_changes', json_build_object(
+        'entity_type', TG_ARGV[0],
+        'id', row_id,
+        'operation', lower(TG_OP)
+    )::text);
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS skills_notify_change ON skills;
+CREATE TRIGGER skills_notify_change
+    AFTER INSERT OR UPDATE OR DELETE ON skills
+    FOR EACH ROW EXECUTE FUNCTION notify_portfolio_change('skill');
+
+DROP TRIGGER IF EXISTS experiences_notify_change ON experiences;
+CREATE TRIGGER experiences_notify_change
+    AFTER INSERT OR UPDATE OR DELETE ON experiences
+    FOR EACH ROW EXECUTE FUNCTION notify_portfolio_change('experience');
+
+DROP TRIGGER IF EXISTS educations_notify_change ON education;
+CREATE TRIGGER educations_notify_change
+    AFTER INSERT OR UPDATE OR DELETE ON education
+    FOR EACH ROW EXECUTE FUNCTION notify_portfolio_change('education');
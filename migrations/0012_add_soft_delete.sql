@@ -0,0 +1,45 @@
+ALTER TABLE skills ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+ALTER TABLE experiences ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+ALTER TABLE education ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+
+-- Speeds up the purge of rows deleted past the retention window.
+CREATE INDEX IF NOT EXISTS skills_deleted_at_idx ON skills (deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS experiences_deleted_at_idx ON experiences (deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS education_deleted_at_idx ON education (deleted_at) WHERE deleted_at IS NOT NULL;
+
+-- Deleting now sets deleted_at, so change notifications report setting it as
+-- a delete and clearing it as an insert. Updates to deleted rows and purges
+-- of rows already reported deleted are not published.
+CREATE OR REPLACE FUNCTION notify_portfolio_change() RETURNS TRIGGER AS $$
+DECLARE
+    row_id    BIGINT;
+    operation TEXT := lower(TG_OP);
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        IF OLD.deleted_at IS NOT NULL THEN
+            RETURN NULL;
+        END IF;
+        row_id := OLD.id;
+    ELSE
+        row_id := NEW.id;
+    END IF;
+
+    IF TG_OP = 'UPDATE' THEN
+        IF OLD.deleted_at IS NULL AND NEW.deleted_at IS NOT NULL THEN
+            operation := 'delete';
+        ELSIF OLD.deleted_at IS NOT NULL AND NEW.deleted_at IS NULL THEN
+            operation := 'insert';
+        ELSIF NEW.deleted_at IS NOT NULL THEN
+            RETURN NULL;
+        END IF;
+    END IF;
+
+    PERFORM pg_notify('portfolio_changes', json_build_object(
+        'entity_type', TG_ARGV[0],
+        'id', row_id,
+        'operation', operation
+    )::text);
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
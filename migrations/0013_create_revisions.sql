@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS revisions (
+    id          BIGSERIAL PRIMARY KEY,
+    entity_type TEXT        NOT NULL CHECK (entity_type IN ('skill', 'experience', 'education')),
+    entity_id   BIGINT      NOT NULL,
+    operation   TEXT        NOT NULL CHECK (operation IN ('update', 'delete', 'undelete', 'restore')),
+    actor       TEXT        NOT NULL DEFAULT '',
+    -- The entity before the change, as proto JSON.
+    snapshot    JSONB       NOT NULL,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS revisions_entity_idx ON revisions (entity_type, entity_id, id DESC);
+
+-- Revisions are an audit trail and are never changed once written.
+CREATE OR REPLACE FUNCTION reject_revision_change() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'revisions are immutable';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS revisions_immutable ON revisions;
+CREATE TRIGGER revisions_immutable
+    BEFORE UPDATE OR DELETE ON revisions
+    FOR EACH ROW EXECUTE FUNCTION reject_revision_change();
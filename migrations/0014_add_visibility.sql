@@ -0,0 +1,57 @@
+ALTER TABLE skills
+    ADD COLUMN IF NOT EXISTS visibility TEXT NOT NULL DEFAULT 'published' CHECK (visibility IN ('draft', 'published', 'archived')),
+    ADD COLUMN IF NOT EXISTS publish_at TIMESTAMPTZ;
+ALTER TABLE experiences
+    ADD COLUMN IF NOT EXISTS visibility TEXT NOT NULL DEFAULT 'published' CHECK (visibility IN ('draft', 'published', 'archived')),
+    ADD COLUMN IF NOT EXISTS publish_at TIMESTAMPTZ;
+ALTER TABLE education
+    ADD COLUMN IF NOT EXISTS visibility TEXT NOT NULL DEFAULT 'published' CHECK (visibility IN ('draft', 'published', 'archived')),
+    ADD COLUMN IF NOT EXISTS publish_at TIMESTAMPTZ;
+
+-- Change notifications only cover rows the public can see: a row becoming
+-- visible is reported as an insert and one leaving as a delete. Rows going
+-- live when their publish_at passes are not reported, as nothing writes them.
+CREATE OR REPLACE FUNCTION notify_portfolio_change() RETURNS TRIGGER AS $$
+DECLARE
+    row_id     BIGINT;
+    operation  TEXT := lower(TG_OP);
+    was_public BOOLEAN := FALSE;
+    is_public  BOOLEAN := FALSE;
+BEGIN
+    IF TG_OP IN ('UPDATE', 'DELETE') THEN
+        was_public := OLD.deleted_at IS NULL AND OLD.visibility = 'published'
+            AND (OLD.publish_at IS NULL OR OLD.publish_at <= NOW());
+    END IF;
+
+    IF TG_OP IN ('INSERT', 'UPDATE') THEN
+        is_public := NEW.deleted_at IS NULL AND NEW.visibility = 'published'
+            AND (NEW.publish_at IS NULL OR NEW.publish_at <= NOW());
+    END IF;
+
+    IF NOT was_public AND NOT is_public THEN
+        RETURN NULL;
+    END IF;
+
+    IF TG_OP = 'DELETE' THEN
+        row_id := OLD.id;
+    ELSE
+        row_id := NEW.id;
+    END IF;
+
+    IF TG_OP = 'UPDATE' THEN
+        IF was_public AND NOT is_public THEN
+            operation := 'delete';
+        ELSIF is_public AND NOT was_public THEN
+            operation := 'insert';
+        END IF;
+    END IF;
+
+    PERFORM pg_notify('portfolio_changes', json_build_object(
+        'entity_type', TG_ARGV[0],
+        'id', row_id,
+        'operation', operation
+    )::text);
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
@@ -0,0 +1,61 @@
+-- Links each experience to the skills among its technologies. Rows are
+-- derived from the languages, frameworks and tools of experiences, matching
+-- skill titles case-insensitively, and kept up to date by the triggers below.
+CREATE TABLE IF NOT EXISTS experience_skills (
+    experience_id BIGINT NOT NULL REFERENCES experiences (id) ON DELETE CASCADE,
+    skill_id      BIGINT NOT NULL REFERENCES skills (id) ON DELETE CASCADE,
+    PRIMARY KEY (experience_id, skill_id)
+);
+
+CREATE INDEX IF NOT EXISTS experience_skills_skill_id_idx ON experience_skills (skill_id);
+
+INSERT INTO experience_skills (experience_id, skill_id)
+SELECT e.id, s.id
+FROM experiences e
+JOIN skills s ON lower(s.title) IN (
+    SELECT lower(technology)
+    FROM jsonb_array_elements_text(e.languages::jsonb || e.frameworks::jsonb || e.tools::jsonb) technology
+)
+ON CONFLICT DO NOTHING;
+
+CREATE OR REPLACE FUNCTION link_experience_skills() RETURNS TRIGGER AS $$
+BEGIN
+    DELETE FROM experience_skills WHERE experience_id = NEW.id;
+
+    INSERT INTO experience_skills (experience_id, skill_id)
+    SELECT NEW.id, s.id
+    FROM skills s
+    WHERE lower(s.title) IN (
+        SELECT lower(technology)
+        FROM jsonb_array_elements_text(NEW.languages::jsonb || NEW.frameworks::jsonb || NEW.tools::jsonb) technology
+    );
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION link_skill_experiences() RETURNS TRIGGER AS $$
+BEGIN
+    DELETE FROM experience_skills WHERE skill_id = NEW.id;
+
+    INSERT INTO experience_skills (experience_id, skill_id)
+    SELECT e.id, NEW.id
+    FROM experiences e
+    WHERE lower(NEW.title) IN (
+        SELECT lower(technology)
+        FROM jsonb_array_elements_text(e.languages::jsonb || e.frameworks::jsonb || e.tools::jsonb) technology
+    );
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS experiences_link_skills ON experiences;
+CREATE TRIGGER experiences_link_skills
+    AFTER INSERT OR UPDATE OF languages, frameworks, tools ON experiences
+    FOR EACH ROW EXECUTE FUNCTION link_experience_skills();
+
+DROP TRIGGER IF EXISTS skills_link_experiences ON skills;
+CREATE TRIGGER skills_link_experiences
+    AFTER INSERT OR UPDATE OF title ON skills
+    FOR EACH ROW EXECUTE FUNCTION link_skill_experiences();
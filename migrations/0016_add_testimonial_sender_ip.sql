@@ -0,0 +1,5 @@
+-- Testimonials are submitted anonymously, so they are rate limited per
+-- client IP like contact messages.
+ALTER TABLE testimonials ADD COLUMN IF NOT EXISTS sender_ip TEXT NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS testimonials_sender_ip_created_at_idx ON testimonials (sender_ip, created_at);